@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"awstk/internal/aws"
+	"awstk/internal/cli"
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// maxAccountWorkers は複数アカウント実行時の同時実行数
+const maxAccountWorkers = 5
+
+var (
+	profilesFlag     string
+	profilesFromFlag string
+)
+
+// accountResult はアカウントごとの実行結果
+type accountResult struct {
+	Profile  string
+	Account  string
+	Alias    string
+	ExitCode int
+	Duration string
+	Error    string
+	Output   any `json:",omitempty"` // 機械可読形式の場合のみ、各アカウントの出力を格納する
+
+	err error // 終了コードの判定に使う失敗理由（出力には含めない）
+}
+
+// isMultiAccount は --profiles / --profiles-from による複数アカウント実行モードかどうかを返す
+func isMultiAccount() bool {
+	return profilesFlag != "" || profilesFromFlag != ""
+}
+
+// resolveTargetProfiles は --profiles / --profiles-from から対象プロファイル一覧を決定する
+// グロブパターン（例: prod-*）は ~/.aws/config のプロファイル名に展開する
+func resolveTargetProfiles() ([]string, error) {
+	var patterns []string
+	for _, p := range strings.Split(profilesFlag, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	if profilesFromFlag != "" {
+		fromFile, err := aws.ReadProfilesFile(profilesFromFlag)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, fromFile...)
+	}
+
+	profiles, err := aws.MatchProfiles(patterns)
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, common.InvalidInputf("対象のプロファイルが指定されていません")
+	}
+	return profiles, nil
+}
+
+// runMultiAccount はサブコマンドをプロファイルごとに別プロセスで並列実行し、結果を集計して表示する
+func runMultiAccount(cmd *cobra.Command) error {
+	profiles, err := resolveTargetProfiles()
+	if err != nil {
+		return fmt.Errorf("❌ エラー: %w", err)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("❌ エラー: 実行ファイルのパス取得に失敗: %w", err)
+	}
+	args := stripProfileArgs(os.Args[1:])
+
+	common.Progressf("👥 %d個のプロファイルで並列実行します: %s\n", len(profiles), strings.Join(profiles, ", "))
+
+	// 失敗はプロファイルごとの結果として集計するため、Run側ではリトライしない
+	var mu sync.Mutex
+	runResults := common.Run(cmd.Context(), profiles, func(ctx context.Context, p string) (accountResult, error) {
+		return runForProfile(ctx, exe, args, p, &mu), nil
+	}, &common.RunOptions{Concurrency: maxAccountWorkers, MaxAttempts: 1})
+
+	results := make([]accountResult, len(profiles))
+	for i, r := range runResults {
+		results[i] = r.Value
+		if r.Err != nil {
+			results[i] = accountResult{Profile: profiles[i], ExitCode: -1, Error: r.Err.Error()}
+		}
+	}
+	return displayAccountResults(results)
+}
+
+// runForProfile は1つのプロファイルでサブコマンドを実行する
+func runForProfile(ctx context.Context, exe string, args []string, profileName string, mu *sync.Mutex) (result accountResult) {
+	start := time.Now()
+	result = accountResult{Profile: profileName, ExitCode: -1}
+	defer func() {
+		result.Duration = time.Since(start).Round(time.Millisecond).String()
+	}()
+
+	cfg, err := aws.LoadAwsConfig(ctx, awsContext(profileName, region, endpointURL))
+	if err != nil {
+		result.err = fmt.Errorf("aws設定の読み込みエラー: %w", err)
+		result.Error = result.err.Error()
+		return result
+	}
+	identity, err := aws.GetIdentity(ctx, cfg)
+	if err != nil {
+		result.err = err
+		result.Error = err.Error()
+		return result
+	}
+	result.Account = identity.AccountId
+	result.Alias = identity.Alias
+
+	execForAccount(ctx, exe, append([]string{"-P", profileName}, args...), fmt.Sprintf("[%s] ", identity.Label()), mu, &result)
+	return result
+}
+
+// execForAccount はサブコマンドを子プロセスとして実行し、終了コード・失敗理由・出力を result に記録する
+func execForAccount(ctx context.Context, exe string, args []string, prefix string, mu *sync.Mutex, result *accountResult) {
+	// 機械可読形式の場合は標準出力を集計結果に含めるため、接頭辞を付けずにバッファに溜める
+	var stdout io.Writer = os.Stdout
+	var buf bytes.Buffer
+	if common.IsMachineReadable() {
+		stdout = &buf
+	}
+
+	exitCode, err := cli.RunPrefixed(ctx, exe, args, cli.PrefixedOptions{
+		Prefix:    prefix,
+		Stdout:    stdout,
+		Stderr:    os.Stderr,
+		Mu:        mu,
+		RawStdout: common.IsMachineReadable(),
+	})
+	result.ExitCode = exitCode
+	// 子プロセスが0以外で終了した場合、RunPrefixed はエラーを返さず終了コードのみを返す
+	if err == nil && exitCode != 0 {
+		err = fmt.Errorf("exit status %d", exitCode)
+	}
+	if err != nil {
+		result.err = exitCodeError(exitCode, err)
+		result.Error = err.Error()
+	}
+	if common.IsMachineReadable() {
+		result.Output = machineOutput(buf.Bytes())
+	}
+}
+
+// machineOutput は子プロセスの出力がJSONであればそのまま埋め込み、そうでなければ文字列として返す
+func machineOutput(out []byte) any {
+	trimmed := bytes.TrimSpace(out)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed)
+	}
+	return string(trimmed)
+}
+
+// displayAccountResults はアカウントごとの終了ステータスを集計表示し、失敗があればエラーを返す
+func displayAccountResults(results []accountResult) error {
+	failed := 0
+	itemResults := make([]common.ItemResult, len(results))
+	for i, r := range results {
+		itemResults[i] = common.ItemResult{Item: r.Profile}
+		if r.ExitCode != 0 {
+			failed++
+			itemResults[i].Err = r.err
+		}
+	}
+
+	err := common.DisplayList(
+		results,
+		i18n.T("accounts.title"),
+		func(items []accountResult) ([]common.TableColumn, [][]string) {
+			columns := []common.TableColumn{
+				{Header: i18n.T("header.profile")},
+				{Header: i18n.T("header.account")},
+				{Header: i18n.T("header.result")},
+				{Header: i18n.T("header.exit_code")},
+				{Header: i18n.T("header.duration")},
+				{Header: i18n.T("header.error")},
+			}
+			data := make([][]string, len(items))
+			for i, r := range items {
+				account := aws.Identity{AccountId: r.Account, Alias: r.Alias}.Label()
+				status := common.SuccessIcon + " " + i18n.T("accounts.succeeded")
+				if r.ExitCode != 0 {
+					status = common.ErrorIcon + " " + i18n.T("accounts.failed")
+				}
+				data[i] = []string{r.Profile, account, status, fmt.Sprintf("%d", r.ExitCode), r.Duration, r.Error}
+			}
+			return columns, data
+		},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return &common.PartialFailureError{Operation: "コマンドの実行", Results: itemResults}
+	}
+	common.Progressf("%s 全%dアカウントで成功しました\n", common.SuccessIcon, len(results))
+	return nil
+}
+
+// exitCodeError は子プロセスの終了コードを同じ種類の型付きエラーに戻す
+// 全アカウントが同じ理由で失敗した場合に、親プロセスも同じ終了コードで終了できるようにする
+func exitCodeError(code int, err error) error {
+	switch code {
+	case common.ExitInvalidInput:
+		return &common.InvalidInputError{Err: err}
+	case common.ExitNotFound:
+		return &common.NotFoundError{Err: err}
+	case common.ExitAccessDenied:
+		return &common.AccessDeniedError{Err: err}
+	case common.ExitTimeout:
+		return &common.TimeoutError{Err: err}
+	case common.ExitUserAborted:
+		return &common.UserAbortedError{}
+	}
+	return err
+}
+
+// stripProfileArgs は子プロセスに渡す引数から --profiles / --profiles-from / -P を取り除く
+func stripProfileArgs(args []string) []string {
+	withValue := map[string]bool{"--profiles": true, "--profiles-from": true, "--profile": true, "-P": true}
+	var out []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			out = append(out, args[i:]...)
+			break
+		}
+		if withValue[arg] {
+			i++ // 値も読み飛ばす
+			continue
+		}
+		name, _, hasValue := strings.Cut(arg, "=")
+		if hasValue && withValue[name] {
+			continue
+		}
+		if strings.HasPrefix(arg, "-P") && !strings.HasPrefix(arg, "--") {
+			continue // -Pmy-profile 形式
+		}
+		out = append(out, arg)
+	}
+	return out
+}
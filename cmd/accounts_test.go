@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os/exec"
+	"sync"
+	"testing"
+
+	"awstk/internal/service/common"
+)
+
+func TestExecForAccount(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh が見つかりません")
+	}
+
+	tests := []struct {
+		name      string
+		script    string
+		wantCode  int
+		wantError string
+		wantExit  int // displayAccountResults が返すエラーの終了コード
+	}{
+		{name: "成功", script: "exit 0", wantCode: 0, wantExit: common.ExitOK},
+		{name: "0以外で終了", script: "echo failed >&2; exit 1", wantCode: 1, wantError: "exit status 1", wantExit: common.ExitError},
+		{name: "終了コードを引き継ぐ", script: "exit 3", wantCode: 3, wantError: "exit status 3", wantExit: common.ExitNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := accountResult{Profile: "dev", ExitCode: -1}
+			execForAccount(t.Context(), "sh", []string{"-c", tt.script}, "[dev] ", &sync.Mutex{}, &result)
+
+			if result.ExitCode != tt.wantCode {
+				t.Errorf("ExitCode = %d, want %d", result.ExitCode, tt.wantCode)
+			}
+			if result.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", result.Error, tt.wantError)
+			}
+			if (result.err != nil) != (tt.wantCode != 0) {
+				t.Errorf("err = %v, want non-nil: %v", result.err, tt.wantCode != 0)
+			}
+
+			// 子プロセスの終了コードが集計結果の終了コードに反映される
+			if got := common.ExitCode(displayAccountResults([]accountResult{result})); got != tt.wantExit {
+				t.Errorf("displayAccountResults() の終了コード = %d, want %d", got, tt.wantExit)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"awstk/internal/service/common"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyPlan *common.Plan
+	applyYes  bool
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply <plan.json>",
+	Short: "保存した実行計画を実行するコマンド",
+	Long: `--plan-out で保存した実行計画ファイルを読み込み、記録されたアクションをそのまま実行します。
+-P / -R を指定しない場合は、計画作成時のプロファイル・リージョンを使用します。
+
+例:
+  ` + AppName + ` cleanup all -f test --plan-out plan.json
+  ` + AppName + ` apply plan.json`,
+	Args: cobra.ExactArgs(1),
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		plan, err := common.LoadPlan(args[0])
+		if err != nil {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("❌ %w", err)
+		}
+		applyPlan = plan
+
+		// 計画作成時のプロファイル・リージョンを既定値として使う
+		for name, value := range map[string]string{"profile": plan.Profile, "region": plan.Region} {
+			if value == "" || cmd.Flags().Changed(name) {
+				continue
+			}
+			if err := cmd.Flags().Set(name, value); err != nil {
+				return err
+			}
+		}
+
+		return RootCmd.PersistentPreRunE(cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if applyPlan.Profile != "" && applyPlan.Profile != profile {
+			common.Warnf("%s  計画はプロファイル '%s' で作成されています（現在: '%s'）\n", common.WarningIcon, applyPlan.Profile, profile)
+		}
+		if applyPlan.Region != "" && applyPlan.Region != region {
+			common.Warnf("%s  計画はリージョン '%s' で作成されています（現在: '%s'）\n", common.WarningIcon, applyPlan.Region, region)
+		}
+		common.Progressf("📄 %s (%s) で作成した実行計画を実行します\n", applyPlan.Command, applyPlan.CreatedAt.Format("2006-01-02 15:04:05"))
+		printAwsContext()
+
+		if err := applyPlan.Render(); err != nil {
+			return err
+		}
+		if applyPlan.IsEmpty() {
+			return nil
+		}
+		if !applyYes && !common.ConfirmPlan(applyPlan) {
+			return &common.UserAbortedError{}
+		}
+
+		if err := executePlan(cmd.Context(), applyPlan); err != nil {
+			return fmt.Errorf("❌ 実行計画の実行でエラー: %w", err)
+		}
+
+		common.Progressln("✅ 実行計画の実行が完了しました")
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().BoolVarP(&applyYes, "yes", "y", false, "確認なしで実行")
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"awstk/internal/audit"
+	"awstk/internal/aws"
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditSince        string
+	auditResourceType string
+	auditOperation    string
+	auditFailedOnly   bool
+	auditLimit        int
+)
+
+// AuditCmd represents the audit command
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "監査ログ操作コマンド",
+	Long: `awstk が実行した変更系API（起動・停止・削除・登録・有効化・無効化・キャッシュ削除など）の監査ログを参照するコマンド群です。
+
+監査ログはJSONL形式で以下の優先順位で決まるファイルに追記されます:
+  1. 環境変数 ` + audit.PathEnvName + `
+  2. 設定ファイルの audit.path
+  3. ~/.local/state/awstk/audit.jsonl（XDG_STATE_HOME が設定されていればその配下）
+
+設定ファイルで audit.disabled: true を指定すると記録しません。`,
+}
+
+var auditLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "監査ログを一覧表示するコマンド",
+	Long: `監査ログを記録順に一覧表示します。
+
+例:
+  ` + AppName + ` audit ls --since 24h
+  ` + AppName + ` audit ls --since 7d --resource-type rds --operation Stop
+  ` + AppName + ` audit ls --failed --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := auditLogPath()
+		if err != nil {
+			return err
+		}
+
+		since, err := audit.ParseSince(auditSince, time.Now())
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+
+		entries, err := audit.Read(path, audit.Filter{
+			Since:        since,
+			ResourceType: auditResourceType,
+			Operation:    auditOperation,
+			FailedOnly:   auditFailedOnly,
+		})
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+		if auditLimit > 0 && len(entries) > auditLimit {
+			entries = entries[len(entries)-auditLimit:]
+		}
+
+		common.Progressf("📄 監査ログ: %s\n", path)
+		return common.DisplayList(entries, common.GenerateFilteredTitle(i18n.T("resource.audit_log")), auditEntriesToTableData, &common.DisplayOptions{
+			ShowCount:      true,
+			EmptyMessage:   common.FormatNoMatchMessage(i18n.T("resource.audit_log")),
+			FilterMessages: auditFilterMessages(),
+		})
+	},
+	SilenceUsage: true,
+}
+
+// auditLogPath は監査ログのパスを 環境変数 > 設定ファイル > デフォルト の順に解決する
+func auditLogPath() (string, error) {
+	if path := os.Getenv(audit.PathEnvName); path != "" {
+		return path, nil
+	}
+	if configFile != nil && configFile.Audit.Path != "" {
+		return configFile.Audit.Path, nil
+	}
+	return audit.DefaultPath()
+}
+
+// attachAuditRecorder は awsCfg に監査ミドルウェアを追加する
+// 以降 awsCfg から生成したクライアントの変更系API呼び出しがすべて記録される
+func attachAuditRecorder() {
+	if configFile != nil && configFile.Audit.Disabled {
+		return
+	}
+	path, err := auditLogPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s  監査ログを記録できません: %v\n", common.WarningIcon, err)
+		return
+	}
+
+	// アカウントIDの解決には監査ミドルウェアを含まない設定を使う
+	baseCfg := awsCfg.Copy()
+	recorder := &audit.Recorder{
+		Path:    path,
+		Profile: profile,
+		Command: strings.Join(append([]string{AppName}, os.Args[1:]...), " "),
+		AccountId: func(ctx context.Context) string {
+			accountId, err := aws.GetAccountId(ctx, baseCfg)
+			if err != nil {
+				return ""
+			}
+			return accountId
+		},
+	}
+	recorder.Attach(&awsCfg)
+}
+
+// auditFilterMessages は一覧タイトルに表示する検索条件を返す
+func auditFilterMessages() []string {
+	var messages []string
+	if auditSince != "" {
+		messages = append(messages, auditSince+"以内の")
+	}
+	if auditResourceType != "" {
+		messages = append(messages, auditResourceType+"の")
+	}
+	if auditOperation != "" {
+		messages = append(messages, auditOperation+"操作の")
+	}
+	if auditFailedOnly {
+		messages = append(messages, "失敗した")
+	}
+	return messages
+}
+
+// auditEntriesToTableData は監査ログを表形式のデータに変換する
+func auditEntriesToTableData(entries []audit.Entry) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.time")},
+		{Header: i18n.T("header.account")},
+		{Header: i18n.T("header.region")},
+		{Header: i18n.T("header.profile")},
+		{Header: i18n.T("header.operation")},
+		{Header: i18n.T("header.resource")},
+		{Header: i18n.T("header.result")},
+		{Header: i18n.T("header.command")},
+	}
+	data := make([][]string, len(entries))
+	for i, e := range entries {
+		result := common.SuccessIcon + " " + e.Result
+		if e.Result == audit.ResultFailure {
+			result = common.ErrorIcon + " " + e.Result
+		}
+		data[i] = []string{
+			e.Time.Local().Format("2006-01-02 15:04:05"),
+			e.Account,
+			e.Region,
+			e.Profile,
+			e.Service + ":" + e.Operation,
+			e.Resource,
+			result,
+			e.Command,
+		}
+	}
+	return columns, data
+}
+
+func init() {
+	RootCmd.AddCommand(AuditCmd)
+	AuditCmd.AddCommand(auditLsCmd)
+	auditLsCmd.Flags().StringVar(&auditSince, "since", "", "指定期間内のログのみ表示 (例: 24h, 7d, 2006-01-02)")
+	auditLsCmd.Flags().StringVarP(&auditResourceType, "resource-type", "t", "", "サービス名で絞り込み (例: s3, rds, ecs, logs)")
+	auditLsCmd.Flags().StringVar(&auditOperation, "operation", "", "オペレーション名で絞り込み（部分一致、例: Stop, Delete）")
+	auditLsCmd.Flags().BoolVar(&auditFailedOnly, "failed", false, "失敗した操作のみ表示")
+	auditLsCmd.Flags().IntVarP(&auditLimit, "limit", "n", 0, "新しい順に指定件数のみ表示（0は全件）")
+}
@@ -1,13 +1,18 @@
 package cmd
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/aurora"
 	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/spf13/cobra"
 )
 
@@ -35,32 +40,43 @@ var auroraStartCmd = &cobra.Command{
 	Short: "Aurora DBクラスターを起動するコマンド",
 	Long: `Aurora DBクラスターを起動します。
 CloudFormationスタック名を指定するか、クラスター名を直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのクラスターを起動します。
 
 例:
   ` + AppName + ` aurora start -P my-profile -S my-stack
-  ` + AppName + ` aurora start -P my-profile -c my-cluster`,
+  ` + AppName + ` aurora start -P my-profile -c my-cluster
+  ` + AppName + ` aurora start -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
+		if len(tagExprs) > 0 {
+			return forEachTagged(cmd, tagging.ResourceRdsCluster, "Aurora DBクラスターの起動", nil, func(ctx context.Context, name string) error {
+				if err := aurora.StartAuroraCluster(ctx, rdsClient, name); err != nil {
+					return err
+				}
+				common.Progressf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", name)
+				return nil
+			})
+		}
 		clusterName, _ := cmd.Flags().GetString("cluster")
+		resolveStackNameUnless(clusterName != "")
 		var err error
 
 		if stackName != "" {
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+			clusterName, err = cfn.GetAuroraFromStack(cmd.Context(), cfnClient, stackName)
 			if err != nil {
 				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
+			common.Progressf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
 		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return common.InvalidInputf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
 		}
 
-		fmt.Printf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterName)
-		err = aurora.StartAuroraCluster(rdsClient, clusterName)
+		common.Progressf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterName)
+		err = aurora.StartAuroraCluster(cmd.Context(), rdsClient, clusterName)
 		if err != nil {
 			return fmt.Errorf("❌ Aurora DBクラスター起動エラー: %w", err)
 		}
 
-		fmt.Printf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterName)
+		common.Progressf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterName)
 		return nil
 	},
 	SilenceUsage: true,
@@ -71,32 +87,43 @@ var auroraStopCmd = &cobra.Command{
 	Short: "Aurora DBクラスターを停止するコマンド",
 	Long: `Aurora DBクラスターを停止します。
 CloudFormationスタック名を指定するか、クラスター名を直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのクラスターを停止します。
 
 例:
   ` + AppName + ` aurora stop -P my-profile -S my-stack
-  ` + AppName + ` aurora stop -P my-profile -c my-cluster`,
+  ` + AppName + ` aurora stop -P my-profile -c my-cluster
+  ` + AppName + ` aurora stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
+		if len(tagExprs) > 0 {
+			return forEachTagged(cmd, tagging.ResourceRdsCluster, "Aurora DBクラスターの停止", nil, func(ctx context.Context, name string) error {
+				if err := aurora.StopAuroraCluster(ctx, rdsClient, name); err != nil {
+					return err
+				}
+				common.Progressf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", name)
+				return nil
+			})
+		}
 		clusterName, _ := cmd.Flags().GetString("cluster")
+		resolveStackNameUnless(clusterName != "")
 		var err error
 
 		if stackName != "" {
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+			clusterName, err = cfn.GetAuroraFromStack(cmd.Context(), cfnClient, stackName)
 			if err != nil {
 				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
+			common.Progressf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
 		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return common.InvalidInputf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
 		}
 
-		fmt.Printf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterName)
-		err = aurora.StopAuroraCluster(rdsClient, clusterName)
+		common.Progressf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterName)
+		err = aurora.StopAuroraCluster(cmd.Context(), rdsClient, clusterName)
 		if err != nil {
 			return fmt.Errorf("❌ Aurora DBクラスター停止エラー: %w", err)
 		}
 
-		fmt.Printf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterName)
+		common.Progressf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterName)
 		return nil
 	},
 	SilenceUsage: true,
@@ -105,11 +132,38 @@ CloudFormationスタック名を指定するか、クラスター名を直接指
 var auroraLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "Auroraクラスター一覧を表示するコマンド",
-	Long:  `Auroraクラスター一覧を表示します。`,
+	Long: `Auroraクラスター一覧を表示します。
+-S でスタック、--tag でタグの条件に一致するクラスターに絞り込めます。
+
+例:
+  ` + AppName + ` aurora ls --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		resolveStackName()
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		opts := aurora.ListOptions{StackName: stackName, Tags: tags}
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return aurora.ListAuroraClustersInRegions(cmd.Context(), regions, func(r string) aurora.ClientSet {
+				cfg := regionConfig(r)
+				return aurora.ClientSet{
+					RdsClient: rds.NewFromConfig(cfg),
+					CfnClient: cloudformation.NewFromConfig(cfg),
+					TagClient: resourcegroupstaggingapi.NewFromConfig(cfg),
+				}
+			}, opts)
+		}
 		// service層の統合関数を呼び出すだけ
-		return aurora.ListAuroraClusters(rdsClient, cfnClient, stackName)
+		return aurora.ListAuroraClusters(cmd.Context(), aurora.ClientSet{
+			RdsClient: rdsClient,
+			CfnClient: cfnClient,
+			TagClient: resourceGroupsTaggingClient(),
+		}, opts)
 	},
 	SilenceUsage: true,
 }
@@ -124,21 +178,21 @@ var auroraAcuCmd = &cobra.Command{
   ` + AppName + ` aurora acu -P my-profile -c my-cluster
   ` + AppName + ` aurora acu -P my-profile --all`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
 		clusterName, _ := cmd.Flags().GetString("cluster")
+		resolveStackNameUnless(clusterName != "")
 		showAll, _ := cmd.Flags().GetBool("all")
 
 		cwClient := cloudwatch.NewFromConfig(awsCfg)
 
 		if showAll {
 			// 全Serverless v2クラスターのAcu情報を表示
-			capacityInfos, err := aurora.ListAuroraCapacityInfo(rdsClient, cwClient)
+			capacityInfos, err := aurora.ListAuroraCapacityInfo(cmd.Context(), rdsClient, cwClient)
 			if err != nil {
 				return fmt.Errorf("❌ Acu情報取得でエラー: %w", err)
 			}
 
 			if len(capacityInfos) == 0 {
-				fmt.Println("Aurora Serverless v2クラスターが見つかりませんでした")
+				fmt.Println(common.FormatEmptyMessage(i18n.T("resource.serverless_v2_cluster")))
 				return nil
 			}
 
@@ -153,23 +207,23 @@ var auroraAcuCmd = &cobra.Command{
 		// 単一クラスターの処理
 		if stackName != "" {
 			var err error
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+			clusterName, err = cfn.GetAuroraFromStack(cmd.Context(), cfnClient, stackName)
 			if err != nil {
 				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n\n", stackName, clusterName)
+			common.Progressf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n\n", stackName, clusterName)
 		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return common.InvalidInputf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
 		}
 
 		// Acu情報を取得
-		info, err := aurora.GetAuroraCapacityInfo(rdsClient, cwClient, clusterName)
+		info, err := aurora.GetAuroraCapacityInfo(cmd.Context(), rdsClient, cwClient, clusterName)
 		if err != nil {
 			return fmt.Errorf("❌ ACU情報取得でエラー: %w", err)
 		}
 
 		if !info.IsServerless {
-			fmt.Printf("ℹ️ クラスター '%s' はServerless v2ではありません\n", clusterName)
+			common.Progressf("ℹ️ クラスター '%s' はServerless v2ではありません\n", clusterName)
 			return nil
 		}
 
@@ -196,9 +250,15 @@ func init() {
 	// stack と cluster は同時指定不可
 	auroraStopCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
 	auroraLsCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	addTagFlag(auroraStartCmd, auroraStopCmd, auroraLsCmd)
+	// tag は stack・cluster と同時指定不可
+	auroraStartCmd.MarkFlagsMutuallyExclusive("stack", "cluster", "tag")
+	auroraStopCmd.MarkFlagsMutuallyExclusive("stack", "cluster", "tag")
+	addRegionsFlag(auroraLsCmd)
 	auroraAcuCmd.Flags().StringP("cluster", "c", "", "Aurora DBクラスター名")
 	auroraAcuCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
 	auroraAcuCmd.Flags().BoolP("all", "a", false, "全てのServerless v2クラスターを表示")
 	// all / stack / cluster は同時指定不可（どれか1つ）
 	auroraAcuCmd.MarkFlagsMutuallyExclusive("all", "stack", "cluster")
+	registerStackCompletion(auroraStartCmd, auroraStopCmd, auroraLsCmd, auroraAcuCmd)
 }
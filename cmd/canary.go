@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"awstk/internal/service/canary"
+	"awstk/internal/service/common"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
@@ -14,7 +15,6 @@ var (
 	canaryFilters    []string
 	canaryAll        bool
 	canaryYes        bool
-	canaryDryRun     bool
 	syntheticsClient *synthetics.Client
 )
 
@@ -47,7 +47,16 @@ var canaryLsCmd = &cobra.Command{
 	Short: "Canary一覧を表示するコマンド",
 	Long:  `AWS Synthetics Canaryの一覧を表示します。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return canary.ListCanaries(syntheticsClient)
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return canary.ListCanariesInRegions(cmd.Context(), regions, func(r string) canary.API {
+				return synthetics.NewFromConfig(regionConfig(r))
+			})
+		}
+		return canary.ListCanaries(cmd.Context(), syntheticsClient)
 	},
 	SilenceUsage: true,
 }
@@ -59,13 +68,13 @@ var canaryEnableCmd = &cobra.Command{
     --name, --filter, --all のいずれかを指定してください。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if canaryAll {
-			return canary.EnableAllCanaries(syntheticsClient, canaryYes)
+			return canary.EnableAllCanaries(cmd.Context(), syntheticsClient, canaryYes)
 		}
 		if canaryFilter != "" {
-			return canary.EnableCanariesByFilter(syntheticsClient, canaryFilter, canaryYes)
+			return canary.EnableCanariesByFilter(cmd.Context(), syntheticsClient, canaryFilter, canaryYes)
 		}
 		if canaryName != "" {
-			return canary.EnableCanary(syntheticsClient, canaryName)
+			return canary.EnableCanary(cmd.Context(), syntheticsClient, canaryName)
 		}
 		return fmt.Errorf("オプションが指定されていません")
 	},
@@ -79,13 +88,13 @@ var canaryDisableCmd = &cobra.Command{
     --name, --filter, --all のいずれかを指定してください。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if canaryAll {
-			return canary.DisableAllCanaries(syntheticsClient, canaryYes)
+			return canary.DisableAllCanaries(cmd.Context(), syntheticsClient, canaryYes)
 		}
 		if canaryFilter != "" {
-			return canary.DisableCanariesByFilter(syntheticsClient, canaryFilter, canaryYes)
+			return canary.DisableCanariesByFilter(cmd.Context(), syntheticsClient, canaryFilter, canaryYes)
 		}
 		if canaryName != "" {
-			return canary.DisableCanary(syntheticsClient, canaryName)
+			return canary.DisableCanary(cmd.Context(), syntheticsClient, canaryName)
 		}
 		return fmt.Errorf("オプションが指定されていません")
 	},
@@ -98,16 +107,19 @@ var canaryRunCmd = &cobra.Command{
 	Long: `指定したCanaryを手動で実行します。
     --name または --filter を指定してください。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if canaryName != "" {
-			if canaryDryRun {
-				return canary.RunCanaryDryRun(syntheticsClient, canaryName)
-			}
-			return canary.RunCanary(syntheticsClient, canaryName)
+		if canaryName == "" && len(canaryFilters) == 0 {
+			return common.InvalidInputf("--name または --filter のいずれかを指定してください")
 		}
-		if len(canaryFilters) > 0 {
-			return canary.RunCanariesByFilter(syntheticsClient, canaryFilters, canaryDryRun, canaryYes)
+
+		actions, err := canary.RunActions(cmd.Context(), syntheticsClient, canaryName, canaryFilters)
+		if err != nil {
+			return err
 		}
-		return fmt.Errorf("--name または --filter のいずれかを指定してください")
+
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		// フィルター指定時のみ、--yes がなければ実行前に確認する
+		return runPlan(cmd, plan, canaryName == "" && !canaryYes)
 	},
 	SilenceUsage: true,
 }
@@ -115,6 +127,7 @@ var canaryRunCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(CanaryCmd)
 	CanaryCmd.AddCommand(canaryLsCmd)
+	addRegionsFlag(canaryLsCmd)
 	CanaryCmd.AddCommand(canaryEnableCmd)
 	CanaryCmd.AddCommand(canaryDisableCmd)
 	CanaryCmd.AddCommand(canaryRunCmd)
@@ -133,7 +146,7 @@ func init() {
 	// Runコマンドのフラグ設定
 	canaryRunCmd.Flags().StringVarP(&canaryName, "name", "n", "", "Canary名")
 	canaryRunCmd.Flags().StringSliceVarP(&canaryFilters, "filter", "f", []string{}, "名前パターン（複数指定可能、ワイルドカード対応）")
-	canaryRunCmd.Flags().BoolVarP(&canaryDryRun, "dry-run", "d", false, "ドライラン実行")
+	addPlanFlags(canaryRunCmd)
 	canaryRunCmd.Flags().BoolVarP(&canaryYes, "yes", "y", false, "確認なしで実行")
 	// --name と --filter は相互排他かついずれか必須
 	canaryRunCmd.MarkFlagsMutuallyExclusive("name", "filter")
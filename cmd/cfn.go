@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"awstk/internal/audit"
+	"awstk/internal/i18n"
 	"awstk/internal/service/cfn"
 	"awstk/internal/service/common"
+	"awstk/internal/service/env"
+	"awstk/internal/ui/picker"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -26,15 +32,30 @@ var cfnLsCmd = &cobra.Command{
 	Short: "CloudFormationスタック一覧を表示するコマンド",
 	Long:  `CloudFormationスタック一覧を表示します。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return cfn.ListCfnStacksInRegions(cmd.Context(), regions, func(r string) cfn.API {
+				return cloudformation.NewFromConfig(regionConfig(r))
+			}, showAll)
+		}
+
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		stacks, err := cfn.ListCfnStacks(cfnClient, showAll)
+		stacks, err := cfn.ListCfnStacks(cmd.Context(), cfnClient, showAll)
 		if err != nil {
-			return common.FormatListError("CloudFormationスタック", err)
+			return common.FormatListError(i18n.T("resource.cfn_stack"), err)
+		}
+
+		// 機械可読形式の場合はスタック情報をそのまま出力
+		if common.IsMachineReadable() {
+			return common.RenderRecords(stacks)
 		}
 
 		if len(stacks) == 0 {
-			fmt.Println(common.FormatEmptyMessage("CloudFormationスタック"))
+			fmt.Println(common.FormatEmptyMessage(i18n.T("resource.cfn_stack")))
 			return nil
 		}
 
@@ -46,7 +67,7 @@ var cfnLsCmd = &cobra.Command{
 				Status: stk.Status,
 			}
 		}
-		common.PrintStatusList("CloudFormationスタック一覧", items, "スタック")
+		common.PrintStatusList(common.GenerateFilteredTitle(i18n.T("resource.cfn_stack")), items, i18n.T("resource.stack"))
 
 		return nil
 	},
@@ -58,28 +79,28 @@ var cfnStartCmd = &cobra.Command{
 	Short: "CloudFormationスタック内のリソースを一括起動するコマンド",
 	Long: `CloudFormationスタック内の起動・停止可能なリソースを一括起動します。
 対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス
+-S を省略した場合は、スタック一覧から選択できます。
 
 例:
   ` + AppName + ` cfn start -S my-stack -P my-profile`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		if stackName == "" {
-			return fmt.Errorf("❌ エラー: スタック名 (-S) を指定してください")
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
 		}
 
 		printAwsContextWithInfo("Stack", stackName)
 
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
 		ec2Client := ec2.NewFromConfig(awsCfg)
 		rdsClient := rds.NewFromConfig(awsCfg)
 		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
 
-		err := cfn.StartAllStackResources(cfnClient, ec2Client, rdsClient, aasClient, stackName)
+		err := cfn.StartAllStackResources(cmd.Context(), cfnClient, ec2Client, rdsClient, aasClient, stackName)
 		if err != nil {
 			return fmt.Errorf("❌ リソース起動処理でエラー: %w", err)
 		}
 
-		fmt.Println("✅ リソース起動処理が完了しました")
+		common.Progressln("✅ リソース起動処理が完了しました")
 		return nil
 	},
 	SilenceUsage: true,
@@ -90,28 +111,29 @@ var cfnStopCmd = &cobra.Command{
 	Short: "CloudFormationスタック内のリソースを一括停止するコマンド",
 	Long: `CloudFormationスタック内の起動・停止可能なリソースを一括停止します。
 対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス
+-S を省略した場合は、スタック一覧から選択できます。
 
 例:
-  ` + AppName + ` cfn stop -S my-stack -P my-profile`,
+  ` + AppName + ` cfn stop -S my-stack -P my-profile
+  ` + AppName + ` cfn stop -S my-stack --dry-run`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		if stackName == "" {
-			return fmt.Errorf("❌ エラー: スタック名 (-S) を指定してください")
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
 		}
 
 		printAwsContextWithInfo("Stack", stackName)
 
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		ec2Client := ec2.NewFromConfig(awsCfg)
-		rdsClient := rds.NewFromConfig(awsCfg)
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
-		err := cfn.StopAllStackResources(cfnClient, ec2Client, rdsClient, aasClient, stackName)
+		actions, err := cfn.StopActions(cmd.Context(), cfnClient, stackName)
 		if err != nil {
 			return fmt.Errorf("❌ リソース停止処理でエラー: %w", err)
 		}
 
-		fmt.Println("✅ リソース停止処理が完了しました")
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		if err := runPlan(cmd, plan, false); err != nil {
+			return fmt.Errorf("❌ リソース停止処理でエラー: %w", err)
+		}
 		return nil
 	},
 	SilenceUsage: true,
@@ -140,21 +162,28 @@ var cfnCleanupCmd = &cobra.Command{
   ` + AppName + ` cfn cleanup --filter dev- --status CREATE_FAILED
 
   # 確認プロンプトをスキップ
-  ` + AppName + ` cfn cleanup --filter test- --force`,
+  ` + AppName + ` cfn cleanup --filter test- --force
+
+  # 削除対象を確認するのみ
+  ` + AppName + ` cfn cleanup --filter test- --dry-run`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		printAwsContext()
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.CleanupStacks(cfnClient, cfn.CleanupOptions{
+		actions, err := cfn.CleanupActions(cmd.Context(), cfnClient, cfn.CleanupOptions{
 			Filter: cleanupFilter,
 			Status: cleanupStatus,
-			Force:  cleanupForce,
 		})
 		if err != nil {
 			return fmt.Errorf("❌ スタック削除処理でエラー: %w", err)
 		}
 
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		if err := runPlan(cmd, plan, !cleanupForce); err != nil {
+			return fmt.Errorf("❌ スタック削除処理でエラー: %w", err)
+		}
 		return nil
 	},
 	SilenceUsage: true,
@@ -193,7 +222,7 @@ var cfnProtectCmd = &cobra.Command{
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.UpdateProtection(cfnClient, cfn.ProtectOptions{
+		err := cfn.UpdateProtection(cmd.Context(), cfnClient, cfn.ProtectOptions{
 			Stacks: args,
 			Filter: protectFilter,
 			Status: protectStatus,
@@ -213,6 +242,8 @@ var cfnDriftDetectCmd = &cobra.Command{
 	Short: "CloudFormationスタックのドリフト検出を一括実行するコマンド",
 	Long: `指定した条件に一致するCloudFormationスタックのドリフト検出を一括で実行します。
 フィルターによる名前の部分一致検索、または全スタックを対象にできます。
+--wait を指定すると、開始したすべての検出の完了を並列で待機して結果を表示します。
+待機タイムアウトは--timeoutで秒数指定できます（デフォルト: 600秒）。
 
 例:
   # 名前に "prod-" を含むスタックのドリフト検出
@@ -224,12 +255,14 @@ var cfnDriftDetectCmd = &cobra.Command{
   # 特定のスタックを指定
   ` + AppName + ` cfn drift-detect stack-a stack-b stack-c
 
-  # 実行例
-  ` + AppName + ` cfn drift-detect --filter test-`,
+  # 検出の完了を待機して結果を表示
+  ` + AppName + ` cfn drift-detect --filter prod- --wait`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// フラグの値を取得
 		driftFilter, _ := cmd.Flags().GetString("filter")
 		driftAll, _ := cmd.Flags().GetBool("all")
+		driftWait, _ := cmd.Flags().GetBool("wait")
+		driftTimeout, _ := cmd.Flags().GetInt("timeout")
 
 		// 排他チェック
 		if err := ValidateStackSelection(args, driftFilter != "" || driftAll); err != nil {
@@ -240,11 +273,24 @@ var cfnDriftDetectCmd = &cobra.Command{
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.DetectDrift(cfnClient, cfn.DriftOptions{
-			Stacks: args,
-			Filter: driftFilter,
-			All:    driftAll,
+		results, err := cfn.DetectDrift(cmd.Context(), cfnClient, cfn.DriftOptions{
+			Stacks:         args,
+			Filter:         driftFilter,
+			All:            driftAll,
+			Wait:           driftWait,
+			TimeoutSeconds: driftTimeout,
 		})
+		// 一部のスタックで待機に失敗した場合も、完了したスタックの結果は表示する
+		if len(results) > 0 {
+			if common.IsMachineReadable() {
+				if renderErr := common.RenderRecords(results); renderErr != nil {
+					return renderErr
+				}
+			} else {
+				fmt.Println()
+				cfn.PrintDriftDetectionResults(results)
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("❌ ドリフト検出処理でエラー: %w", err)
 		}
@@ -287,7 +333,7 @@ var cfnDriftStatusCmd = &cobra.Command{
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.ShowDriftStatus(cfnClient, cfn.DriftStatusOptions{
+		err := cfn.ShowDriftStatus(cmd.Context(), cfnClient, cfn.DriftStatusOptions{
 			Stacks:      args,
 			Filter:      driftFilter,
 			All:         driftAll,
@@ -302,6 +348,511 @@ var cfnDriftStatusCmd = &cobra.Command{
 	SilenceUsage: true,
 }
 
+var (
+	eventsFollow    bool
+	eventsSince     string
+	eventsRootCause bool
+)
+
+var cfnEventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "CloudFormationスタックのイベントを表示するコマンド",
+	Long: `CloudFormationスタックのイベントを、ネストしたスタックのイベントも含めて古い順に表示します。
+各操作で最初に失敗したリソースには「👈 原因の可能性があります」と表示します。
+
+--follow を指定すると、スタックが処理中（*_IN_PROGRESS）の間は新しいイベントを表示し続けます。
+--since を省略した場合は、最後の操作（作成・更新・削除）の開始以降のイベントから表示します。
+処理が終わったときにスタックが失敗・ロールバックの状態であれば、終了コード 1 で終了します。
+
+--root-cause を指定すると、スタック・ネストしたスタックごとに、最後の操作で最初に失敗したリソースと
+その理由のみを表示します。
+-S を省略した場合は、スタック一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn events -S my-stack --since 1h
+  ` + AppName + ` cfn events -S my-stack --follow
+  ` + AppName + ` cfn events -S my-stack --root-cause`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since, err := audit.ParseSince(eventsSince, time.Now())
+		if err != nil {
+			return common.InvalidInputf("❌ %w", err)
+		}
+
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
+		}
+
+		printAwsContextWithInfo("Stack", stackName)
+
+		if eventsFollow {
+			var events []cfn.StackEvent
+			printer := &cfn.EventPrinter{}
+			status, err := cfn.FollowStackEvents(cmd.Context(), cfnClient, stackName, since, func(e cfn.StackEvent) {
+				// 機械可読形式の場合は終了時にまとめて出力する
+				if common.IsMachineReadable() {
+					events = append(events, e)
+					return
+				}
+				printer.Print(e)
+			})
+			if err != nil {
+				return fmt.Errorf("❌ スタックイベントの取得でエラー: %w", err)
+			}
+			if common.IsMachineReadable() {
+				if err := common.RenderRecords(events); err != nil {
+					return err
+				}
+			}
+			common.Progressf("\n📋 スタック %s のステータス: %s\n", stackName, status)
+			return cfn.CheckStackResult(stackName, status)
+		}
+
+		events, err := cfn.GetStackEvents(cmd.Context(), cfnClient, stackName, since)
+		if err != nil {
+			return fmt.Errorf("❌ スタックイベントの取得でエラー: %w", err)
+		}
+		if eventsRootCause {
+			events = cfn.RootCauses(events)
+		}
+
+		if common.IsMachineReadable() {
+			return common.RenderRecords(events)
+		}
+		if eventsRootCause {
+			cfn.PrintRootCauses(events)
+			return nil
+		}
+		if len(events) == 0 {
+			fmt.Println(common.FormatEmptyMessage(i18n.T("resource.stack_event")))
+			return nil
+		}
+		printer := &cfn.EventPrinter{}
+		for _, e := range events {
+			printer.Print(e)
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var outputsFormat string
+
+var cfnOutputsCmd = &cobra.Command{
+	Use:   "outputs",
+	Short: "CloudFormationスタックの出力値を表示するコマンド",
+	Long: `CloudFormationスタックの出力値（Outputs）を表示します。
+--format を指定すると、出力値を環境変数として読み込める形式で出力します。
+  env     export KEY='value' の形式（eval で現在のシェルに設定できます）
+  dotenv  KEY="value" の形式（.env ファイルとして保存できます）
+  json    {"KEY": "value"} の形式
+変数名に使えない文字はアンダースコアに置き換えます。
+-S を省略した場合は、スタック一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn outputs -S my-stack
+  eval "$(` + AppName + ` cfn outputs -S my-stack --format env)"
+  ` + AppName + ` cfn outputs -S my-stack --format dotenv > .env`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCfnOutputs(cmd, cloudformation.NewFromConfig(awsCfg))
+	},
+	SilenceUsage: true,
+}
+
+// runCfnOutputs はスタックの出力値を表示する（--format の場合は環境変数の形式で出力する）
+func runCfnOutputs(cmd *cobra.Command, cfnClient cfn.API) error {
+	if outputsFormat != "" {
+		if err := env.ValidateExportFormat(outputsFormat); err != nil {
+			return err
+		}
+		// eval や .env へのリダイレクトで読み込めるよう、標準出力には変数のみを出力する
+		common.SetProgressToStderr(true)
+	}
+
+	if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+		return err
+	}
+
+	outputs, err := cfn.GetStackOutputs(cmd.Context(), cfnClient, stackName)
+	if err != nil {
+		return fmt.Errorf("❌ スタックの出力値の取得でエラー: %w", err)
+	}
+
+	if outputsFormat != "" {
+		names := make([]string, len(outputs))
+		values := make(map[string]string, len(outputs))
+		for i, o := range outputs {
+			names[i] = o.Key
+			values[o.Key] = o.Value
+		}
+		return env.WriteVariables(os.Stdout, outputsFormat, names, values)
+	}
+	if common.IsMachineReadable() {
+		return common.RenderRecords(outputs)
+	}
+	cfn.PrintStackOutputs(stackName, outputs)
+	return nil
+}
+
+var (
+	exportsFilter string
+	exportsFormat string
+)
+
+var cfnExportsCmd = &cobra.Command{
+	Use:   "exports",
+	Short: "CloudFormationのエクスポート一覧を表示するコマンド",
+	Long: `CloudFormationでエクスポートされた値の一覧を、エクスポート元のスタックと
+その値をインポートしているスタックとともに表示します。
+--format を指定すると、cfn outputs と同様に環境変数として読み込める形式で出力します（変数名はエクスポート名）。
+
+例:
+  ` + AppName + ` cfn exports
+  ` + AppName + ` cfn exports --filter prod-
+  eval "$(` + AppName + ` cfn exports --filter prod- --format env)"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if exportsFormat != "" {
+			if err := env.ValidateExportFormat(exportsFormat); err != nil {
+				return err
+			}
+			common.SetProgressToStderr(true)
+		}
+
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+
+		exports, err := cfn.ListExports(cmd.Context(), cfnClient, exportsFilter)
+		if err != nil {
+			return common.FormatListError(i18n.T("resource.export"), err)
+		}
+
+		if exportsFormat != "" {
+			names := make([]string, len(exports))
+			values := make(map[string]string, len(exports))
+			for i, e := range exports {
+				names[i] = e.Name
+				values[e.Name] = e.Value
+			}
+			return env.WriteVariables(os.Stdout, exportsFormat, names, values)
+		}
+		if common.IsMachineReadable() {
+			return common.RenderRecords(exports)
+		}
+		cfn.PrintStackExports(exports)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var (
+	resourcesType string
+	resourcesTree bool
+)
+
+var cfnResourcesCmd = &cobra.Command{
+	Use:   "resources",
+	Short: "CloudFormationスタック内のリソース一覧を表示するコマンド",
+	Long: `CloudFormationスタック内のリソースを、ネストしたスタックのリソースも含めてすべて表示します。
+--type を指定するとリソースタイプ（例: AWS::S3::Bucket）に一致するリソースのみ表示します。
+--tree を指定するとネストしたスタックごとのツリー形式で表示します。
+-S を省略した場合は、スタック一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn resources -S my-stack
+  ` + AppName + ` cfn resources -S my-stack --type AWS::S3::Bucket
+  ` + AppName + ` cfn resources -S my-stack --tree`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
+		}
+
+		resources, err := cfn.WalkStackResources(cmd.Context(), cfnClient, stackName)
+		if err != nil {
+			return common.FormatListError(i18n.T("resource.stack_resource"), err)
+		}
+		if resourcesType != "" {
+			resources = cfn.FilterStackResources(resources, resourcesType, resourcesTree && !common.IsMachineReadable())
+		}
+
+		if common.IsMachineReadable() {
+			return common.RenderRecords(resources)
+		}
+		if len(resources) == 0 {
+			fmt.Println(common.FormatEmptyMessage(i18n.T("resource.stack_resource")))
+			return nil
+		}
+		if resourcesTree {
+			cfn.PrintStackResourceTree(stackName, resources)
+			return nil
+		}
+		cfn.PrintStackResources(stackName, resources)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var (
+	driftShowDetect      bool
+	driftShowTimeout     int
+	driftShowFailOnDrift bool
+)
+
+var cfnDriftShowCmd = &cobra.Command{
+	Use:   "drift-show",
+	Short: "CloudFormationスタックのドリフトしたリソースの差分を表示するコマンド",
+	Long: `CloudFormationスタックで変更・削除されたリソースと、プロパティごとの差分を表示します。
+差分はプロパティのパスごとに、テンプレートで期待される値と実際の値を表示します。
+  + パス: 実際の値               テンプレートにないプロパティが追加されている
+  - パス: 期待される値           テンプレートのプロパティが削除されている
+  ~ パス: 期待される値 → 実際の値  値が変更されている
+結果は直前のドリフト検出の時点のものです。--detect を指定すると、先にドリフト検出を実行して完了を待機します。
+--fail-on-drift を指定すると、ドリフトしたリソースがある場合に終了コード1で終了するため、CIでのチェックに使用できます。
+-S を省略した場合は、スタック一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn drift-show -S my-stack
+  ` + AppName + ` cfn drift-show -S my-stack --detect
+  ` + AppName + ` cfn drift-show -S my-stack --detect --fail-on-drift --output json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
+		}
+
+		report, err := cfn.GetResourceDrifts(cmd.Context(), cfnClient, cfn.DriftShowOptions{
+			StackName:      stackName,
+			Detect:         driftShowDetect,
+			TimeoutSeconds: driftShowTimeout,
+		})
+		if err != nil {
+			return fmt.Errorf("❌ ドリフトの取得でエラー: %w", err)
+		}
+
+		if common.IsMachineReadable() {
+			if err := common.RenderRecord(report); err != nil {
+				return err
+			}
+		} else {
+			cfn.PrintDriftReport(report)
+		}
+
+		if driftShowFailOnDrift && report.DriftedCount > 0 {
+			return fmt.Errorf("❌ スタック %s で %d 個のリソースがドリフトしています", stackName, report.DriftedCount)
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var (
+	changesetYes    bool
+	changesetNoWait bool
+)
+
+var cfnChangesetCmd = &cobra.Command{
+	Use:   "changeset",
+	Short: "CloudFormationのチェンジセット操作コマンド",
+	Long: `CloudFormationスタックのチェンジセットを一覧・表示・実行・削除するコマンド群です。
+CDK（cdk deploy --no-execute）やSAM（sam deploy --no-execute-changeset）で作成したチェンジセットを、実行前に確認するために使用できます。
+-S を省略した場合は、スタック一覧から選択できます。`,
+}
+
+var cfnChangesetLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "スタックのチェンジセット一覧を表示するコマンド",
+	Long: `CloudFormationスタックのチェンジセットの一覧を表示します。
+ネストしたスタックのチェンジセットは、ルートのチェンジセットの変更内容に含めて表示されるため一覧には表示しません。
+
+例:
+  ` + AppName + ` cfn changeset ls -S my-stack`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
+		}
+
+		summaries, err := cfn.ListChangeSets(cmd.Context(), cfnClient, stackName)
+		if err != nil {
+			return common.FormatListError(i18n.T("resource.changeset"), err)
+		}
+
+		if common.IsMachineReadable() {
+			return common.RenderRecords(summaries)
+		}
+		if len(summaries) == 0 {
+			fmt.Println(common.FormatEmptyMessage(i18n.T("resource.changeset")))
+			return nil
+		}
+		cfn.PrintChangeSets(stackName, summaries)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var cfnChangesetShowCmd = &cobra.Command{
+	Use:   "show [change-set-name]",
+	Short: "チェンジセットの変更内容を表示するコマンド",
+	Long: `チェンジセットに含まれるリソースの変更を表形式で表示します。
+アクション・論理ID・リソースタイプ・置き換えの有無（True / Conditional）・変更範囲・変更の原因を表示し、
+ネストしたスタックのチェンジセットの変更も、そのスタックの直後に表示します。
+RDS・DynamoDB・S3・EFS などステートフルなリソースが置き換えられる変更は赤色で強調し、警告としてまとめて表示します。
+チェンジセット名を省略した場合は、一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn changeset show -S my-stack my-changeset
+  ` + AppName + ` cfn changeset show -S my-stack --output json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		changeSet, err := describeChangeSetFromArgs(cmd, cfnClient, args)
+		if err != nil {
+			return err
+		}
+
+		if common.IsMachineReadable() {
+			return common.RenderRecord(changeSet)
+		}
+		cfn.PrintChangeSet(changeSet)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var cfnChangesetExecCmd = &cobra.Command{
+	Use:   "exec [change-set-name]",
+	Short: "チェンジセットの変更内容を確認して実行するコマンド",
+	Long: `チェンジセットの変更内容を表示し、確認後に実行します。
+実行後はスタックの処理が終わるまでスタックイベントを表示し、失敗・ロールバックした場合はエラーで終了します。
+--no-wait を指定すると、実行を開始した時点で終了します。
+チェンジセット名を省略した場合は、一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn changeset exec -S my-stack my-changeset
+  ` + AppName + ` cfn changeset exec -S my-stack my-changeset --yes --no-wait`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		changeSet, err := describeChangeSetFromArgs(cmd, cfnClient, args)
+		if err != nil {
+			return err
+		}
+		if err := changeSet.CheckExecutable(); err != nil {
+			return err
+		}
+
+		if common.IsMachineReadable() {
+			if err := common.RenderRecord(changeSet); err != nil {
+				return err
+			}
+		} else {
+			cfn.PrintChangeSet(changeSet)
+		}
+
+		if !changesetYes {
+			message := fmt.Sprintf("\nチェンジセット %s を実行しますか？", changeSet.Name)
+			if len(changeSet.StatefulReplacements()) > 0 {
+				message = common.Red(fmt.Sprintf("\n%s  ステートフルなリソースの置き換えを含みます。", common.WarningIcon)) + message
+			}
+			if !picker.Confirm(message) {
+				return &common.UserAbortedError{}
+			}
+		}
+
+		// 実行前の時刻から、このチェンジセットの実行によるイベントのみを表示する
+		since := time.Now()
+		if err := cfn.ExecuteChangeSet(cmd.Context(), cfnClient, stackName, changeSet.Name); err != nil {
+			return fmt.Errorf("❌ チェンジセットの実行でエラー: %w", err)
+		}
+		common.Progressf("✅ チェンジセット %s の実行を開始しました\n", changeSet.Name)
+		if changesetNoWait {
+			return nil
+		}
+
+		printer := &cfn.EventPrinter{}
+		status, err := cfn.FollowStackEvents(cmd.Context(), cfnClient, stackName, since, func(e cfn.StackEvent) {
+			if !common.IsMachineReadable() {
+				printer.Print(e)
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("❌ スタックイベントの取得でエラー: %w", err)
+		}
+		common.Progressf("\n📋 スタック %s のステータス: %s\n", stackName, status)
+		return cfn.CheckStackResult(stackName, status)
+	},
+	SilenceUsage: true,
+}
+
+var cfnChangesetDeleteCmd = &cobra.Command{
+	Use:   "delete [change-set-name]",
+	Short: "チェンジセットを削除するコマンド",
+	Long: `チェンジセットを削除します。スタック自体は変更されません。
+チェンジセット名を省略した場合は、一覧から選択できます。
+
+例:
+  ` + AppName + ` cfn changeset delete -S my-stack my-changeset
+  ` + AppName + ` cfn changeset delete -S my-stack my-changeset --yes`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+			return err
+		}
+		changeSetName, err := resolveChangeSetName(cmd, cfnClient, args)
+		if err != nil {
+			return err
+		}
+
+		if !changesetYes && !picker.Confirm(fmt.Sprintf("チェンジセット %s を削除しますか？", changeSetName)) {
+			return &common.UserAbortedError{}
+		}
+		if err := cfn.DeleteChangeSet(cmd.Context(), cfnClient, stackName, changeSetName); err != nil {
+			return fmt.Errorf("❌ チェンジセットの削除でエラー: %w", err)
+		}
+		common.Progressln(i18n.Tf(common.DeleteSuccessFormat, common.SuccessIcon, changeSetName))
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// resolveChangeSetName はチェンジセット名を引数から解決し、なければスタックのチェンジセット一覧から選択させる
+func resolveChangeSetName(cmd *cobra.Command, cfnClient cfn.API, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return cfn.SelectChangeSet(cmd.Context(), cfnClient, stackName)
+}
+
+// describeChangeSetFromArgs はスタック名・チェンジセット名を解決してチェンジセットの変更内容を取得する
+func describeChangeSetFromArgs(cmd *cobra.Command, cfnClient cfn.API, args []string) (*cfn.ChangeSet, error) {
+	if err := resolveCfnStackName(cmd, cfnClient); err != nil {
+		return nil, err
+	}
+	changeSetName, err := resolveChangeSetName(cmd, cfnClient, args)
+	if err != nil {
+		return nil, err
+	}
+	changeSet, err := cfn.DescribeChangeSet(cmd.Context(), cfnClient, stackName, changeSetName)
+	if err != nil {
+		return nil, fmt.Errorf("❌ チェンジセットの取得でエラー: %w", err)
+	}
+	return changeSet, nil
+}
+
+// resolveCfnStackName はスタック名をフラグ・環境変数から解決し、どちらもなければスタック一覧から選択させる
+func resolveCfnStackName(cmd *cobra.Command, cfnClient cfn.API) error {
+	resolveStackName()
+	if stackName != "" {
+		return nil
+	}
+	selected, err := cfn.SelectStack(cmd.Context(), cfnClient)
+	if err != nil {
+		return err
+	}
+	stackName = selected
+	return nil
+}
+
 func init() {
 	RootCmd.AddCommand(CfnCmd)
 	CfnCmd.AddCommand(cfnLsCmd)
@@ -311,17 +862,36 @@ func init() {
 	CfnCmd.AddCommand(cfnProtectCmd)
 	CfnCmd.AddCommand(cfnDriftDetectCmd)
 	CfnCmd.AddCommand(cfnDriftStatusCmd)
+	CfnCmd.AddCommand(cfnDriftShowCmd)
+	CfnCmd.AddCommand(cfnEventsCmd)
+	CfnCmd.AddCommand(cfnOutputsCmd)
+	CfnCmd.AddCommand(cfnExportsCmd)
+	CfnCmd.AddCommand(cfnResourcesCmd)
+	CfnCmd.AddCommand(cfnChangesetCmd)
+	cfnChangesetCmd.AddCommand(cfnChangesetLsCmd)
+	cfnChangesetCmd.AddCommand(cfnChangesetShowCmd)
+	cfnChangesetCmd.AddCommand(cfnChangesetExecCmd)
+	cfnChangesetCmd.AddCommand(cfnChangesetDeleteCmd)
 
 	cfnLsCmd.Flags().BoolVarP(&showAll, "all", "a", false, "全てのステータスのスタックを表示")
+	addRegionsFlag(cfnLsCmd)
 
 	// cfn start/stopコマンド用のフラグ
-	cfnStartCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	cfnStopCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	cfnStartCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnStopCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnEventsCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnOutputsCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnResourcesCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnDriftShowCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	cfnChangesetCmd.PersistentFlags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名（省略時は一覧から選択）")
+	registerStackCompletion(cfnStartCmd, cfnStopCmd, cfnEventsCmd, cfnOutputsCmd, cfnResourcesCmd, cfnDriftShowCmd, cfnChangesetCmd)
+	addPlanFlags(cfnStopCmd)
 
 	// cfn cleanupコマンド用のフラグ
 	cfnCleanupCmd.Flags().StringVar(&cleanupFilter, "filter", "", "スタック名のフィルター（部分一致）")
 	cfnCleanupCmd.Flags().StringVar(&cleanupStatus, "status", "", "削除対象のステータス（カンマ区切り）")
 	cfnCleanupCmd.Flags().BoolVarP(&cleanupForce, "force", "f", false, "確認プロンプトをスキップ")
+	addPlanFlags(cfnCleanupCmd)
 	// どちらか1つ必須
 	cfnCleanupCmd.MarkFlagsOneRequired("filter", "status")
 
@@ -337,9 +907,36 @@ func init() {
 	// cfn drift-detectコマンド用のフラグ
 	cfnDriftDetectCmd.Flags().StringP("filter", "F", "", "スタック名のフィルター（部分一致）")
 	cfnDriftDetectCmd.Flags().BoolP("all", "a", false, "すべてのスタックを対象")
+	cfnDriftDetectCmd.Flags().BoolP("wait", "w", false, "検出の完了を待機して結果を表示")
+	cfnDriftDetectCmd.Flags().Int("timeout", cfn.DefaultDriftTimeoutSeconds, "待機タイムアウト（秒）")
 
 	// cfn drift-statusコマンド用のフラグ
 	cfnDriftStatusCmd.Flags().StringP("filter", "F", "", "スタック名のフィルター（部分一致）")
 	cfnDriftStatusCmd.Flags().BoolP("all", "a", false, "すべてのスタックを対象")
 	cfnDriftStatusCmd.Flags().BoolP("drifted-only", "d", false, "ドリフトしているスタックのみ表示")
+
+	// cfn drift-showコマンド用のフラグ
+	cfnDriftShowCmd.Flags().BoolVar(&driftShowDetect, "detect", false, "表示する前にドリフト検出を実行して完了を待機する")
+	cfnDriftShowCmd.Flags().IntVar(&driftShowTimeout, "timeout", cfn.DefaultDriftTimeoutSeconds, "--detect の待機タイムアウト（秒）")
+	cfnDriftShowCmd.Flags().BoolVar(&driftShowFailOnDrift, "fail-on-drift", false, "ドリフトしたリソースがある場合にエラー（終了コード1）で終了する")
+
+	// cfn eventsコマンド用のフラグ
+	cfnEventsCmd.Flags().BoolVarP(&eventsFollow, "follow", "f", false, "スタックの処理が終わるまで新しいイベントを表示し続ける")
+	cfnEventsCmd.Flags().StringVar(&eventsSince, "since", "", "指定期間内のイベントのみ表示 (例: 1h, 7d, 2006-01-02)")
+	cfnEventsCmd.Flags().BoolVar(&eventsRootCause, "root-cause", false, "スタックごとに最初に失敗したリソースとその理由のみ表示")
+	cfnEventsCmd.MarkFlagsMutuallyExclusive("follow", "root-cause")
+
+	// cfn outputs/exportsコマンド用のフラグ
+	cfnOutputsCmd.Flags().StringVar(&outputsFormat, "format", "", "環境変数として出力する形式 (env|dotenv|json)")
+	cfnExportsCmd.Flags().StringVarP(&exportsFilter, "filter", "F", "", "エクスポート名のフィルター（部分一致）")
+	cfnExportsCmd.Flags().StringVar(&exportsFormat, "format", "", "環境変数として出力する形式 (env|dotenv|json)")
+
+	// cfn resourcesコマンド用のフラグ
+	cfnResourcesCmd.Flags().StringVarP(&resourcesType, "type", "t", "", "リソースタイプで絞り込む (例: AWS::S3::Bucket)")
+	cfnResourcesCmd.Flags().BoolVar(&resourcesTree, "tree", false, "ネストしたスタックごとのツリー形式で表示")
+
+	// cfn changesetコマンド用のフラグ
+	cfnChangesetExecCmd.Flags().BoolVarP(&changesetYes, "yes", "y", false, "確認なしで実行")
+	cfnChangesetExecCmd.Flags().BoolVar(&changesetNoWait, "no-wait", false, "実行を開始したら完了を待たずに終了する")
+	cfnChangesetDeleteCmd.Flags().BoolVarP(&changesetYes, "yes", "y", false, "確認なしで削除")
 }
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/spf13/cobra"
+)
+
+func TestCfnOutputsFormatWritesOnlyVariables(t *testing.T) {
+	fake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+		Name:   "app",
+		Status: types.StackStatusCreateComplete,
+		Outputs: []types.Output{
+			fakeaws.Output("ApiUrl", "https://example.com/api", ""),
+			fakeaws.Output("BucketName", "app-bucket", "app-BucketName"),
+		},
+	})
+	stackName, outputsFormat = "app", "env"
+	t.Cleanup(func() {
+		stackName, outputsFormat = "", ""
+		common.SetProgressToStderr(false)
+	})
+
+	// 標準出力をキャプチャする
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	t.Cleanup(func() { os.Stdout = stdout })
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(t.Context())
+	runErr := runCfnOutputs(cmd, fake)
+	_ = w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+	if runErr != nil {
+		t.Fatalf("runCfnOutputs() error = %v", runErr)
+	}
+
+	// スタック名の解決などの進捗メッセージは標準出力に含めない
+	want := "export ApiUrl=https://example.com/api\nexport BucketName=app-bucket\n"
+	if string(out) != want {
+		t.Errorf("標準出力 = %q, want %q", out, want)
+	}
+}
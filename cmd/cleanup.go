@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"awstk/internal/aws"
 	cleanup "awstk/internal/service/cleanup"
+	"awstk/internal/service/common"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -24,39 +27,61 @@ var allCleanupCmd = &cobra.Command{
 	Short: "S3バケット、ECRリポジトリ、CloudWatch Logsを横断削除",
 	Long: `指定した文字列を含むS3バケット、ECRリポジトリ、CloudWatch Logsグループを一括削除するコマンドです。
 CloudFormationスタック名を指定することで、スタック内のリソースを対象にすることもできます。
+--tag を指定するとタグの条件に一致するリソースを対象にします（-f・-S と組み合わせた場合は両方に一致するもの）。
 
 例:
   ` + AppName + ` cleanup all -f "test" -P my-profile
-  ` + AppName + ` cleanup all -S my-stack -P my-profile`,
+  ` + AppName + ` cleanup all -S my-stack -P my-profile
+  ` + AppName + ` cleanup all --tag env=dev --tag owner=team-a -P my-profile
+  ` + AppName + ` cleanup all -f "test" --dry-run          # 削除対象を確認するのみ
+  ` + AppName + ` cleanup all -f "test" --plan-out plan.json # 実行計画を保存（` + AppName + ` apply で実行）`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
 		filter, _ := cmd.Flags().GetString("filter")
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		resolveStackNameUnless(filter != "" || len(tags) > 0)
 
-		if filter == "" && stackName == "" {
-			return fmt.Errorf("❌ エラー: フィルター (-f) またはスタック名 (-S) のいずれかを指定してください")
+		if filter == "" && stackName == "" && len(tags) == 0 {
+			return common.InvalidInputf("❌ エラー: フィルター (-f)・スタック名 (-S)・タグ (--tag) のいずれかを指定してください")
 		}
 
 		printAwsContext()
 
 		// クライアントセットを作成
 		clients := cleanup.ClientSet{
-			S3Client:   s3.NewFromConfig(awsCfg),
+			S3Client:   s3.NewFromConfig(awsCfg, aws.S3PathStyle),
 			EcrClient:  ecr.NewFromConfig(awsCfg),
 			CfnClient:  cloudformation.NewFromConfig(awsCfg),
 			LogsClient: cloudwatchlogs.NewFromConfig(awsCfg),
+			TagClient:  resourceGroupsTaggingClient(),
 		}
 
 		opts := cleanup.Options{
 			SearchString: filter,
 			StackName:    stackName,
+			Tags:         tags,
 		}
 
-		err := cleanup.CleanupResources(clients, opts)
-		if err != nil {
-			return fmt.Errorf("❌ クリーンアップ処理でエラー: %w", err)
+		// 一部のサービスで一覧取得に失敗した場合も、取得できたリソースの計画は続行する
+		actions, listErr := cleanup.CleanupActions(cmd.Context(), clients, opts)
+		var partial *common.PartialFailureError
+		if listErr != nil && !errors.As(listErr, &partial) {
+			return fmt.Errorf("❌ クリーンアップ処理でエラー: %w", listErr)
 		}
 
-		fmt.Println("✅ クリーンアップが完了しました")
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		err = runPlan(cmd, plan, false)
+		var notFound *common.NotFoundError
+		if listErr != nil && errors.As(err, &notFound) {
+			// 一覧取得に失敗したサービスがある場合は「対象なし」と断定しない
+			err = nil
+		}
+		if err := errors.Join(listErr, err); err != nil {
+			return fmt.Errorf("❌ クリーンアップ処理でエラー: %w", err)
+		}
 		return nil
 	},
 	SilenceUsage: true,
@@ -67,4 +92,7 @@ func init() {
 	cleanupCmd.AddCommand(allCleanupCmd)
 	allCleanupCmd.Flags().StringP("filter", "f", "", "削除対象のフィルターパターン")
 	allCleanupCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	addTagFlag(allCleanupCmd)
+	registerStackCompletion(allCleanupCmd)
+	addPlanFlags(allCleanupCmd)
 }
@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"awstk/internal/i18n"
 	cfsvc "awstk/internal/service/cloudfront"
 	"awstk/internal/service/cloudfront/tenant"
 	"awstk/internal/service/common"
 	"fmt"
+	"os"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -38,8 +40,10 @@ var cfInvalidateCmd = &cobra.Command{
 	Short: "CloudFrontのキャッシュを無効化するコマンド",
 	Long: `CloudFrontディストリビューションのキャッシュを無効化します。
 ディストリビューションIDを直接指定するか、CloudFormationスタック名から自動検出できます。
+どちらも指定しない場合は、ディストリビューション一覧から選択できます。
 
 【使い方】
+  ` + AppName + ` cf invalidate                                 # 一覧から選択
   ` + AppName + ` cf invalidate ABCD1234EFGH                    # 全体を無効化（/*）
   ` + AppName + ` cf invalidate ABCD1234EFGH -p "/images/*"     # 特定パスを無効化
   ` + AppName + ` cf invalidate -S my-stack                      # スタックから自動検出
@@ -50,7 +54,6 @@ var cfInvalidateCmd = &cobra.Command{
   → 複数のパスを同時に無効化します`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
-		resolveStackName()
 		paths, _ := cmdCobra.Flags().GetStringSlice("path")
 		wait, _ := cmdCobra.Flags().GetBool("wait")
 
@@ -58,6 +61,13 @@ var cfInvalidateCmd = &cobra.Command{
 		if len(args) > 0 {
 			distributionId = args[0]
 		}
+		// -S も環境変数のスタック名もなければ、コンテキストのディストリビューションIDを使用
+		if distributionId == "" && stackName == "" && os.Getenv("AWS_STACK_NAME") == "" &&
+			activeContext.CloudFront.Distribution != "" {
+			distributionId = activeContext.CloudFront.Distribution
+			common.Progressf("🔍 コンテキスト '%s' のディストリビューション '%s' を使用します\n", activeContextName, distributionId)
+		}
+		resolveStackNameUnless(distributionId != "")
 
 		opts := cfsvc.InvalidateOptions{
 			DistributionId: distributionId,
@@ -66,7 +76,7 @@ var cfInvalidateCmd = &cobra.Command{
 			StackName:      stackName,
 		}
 
-		err := cfsvc.InvalidateByIdOrStack(cfClient, cfnClient, opts)
+		err := cfsvc.InvalidateByIdOrStack(cmdCobra.Context(), cfClient, cfnClient, opts)
 		if err != nil {
 			return fmt.Errorf("❌ %w", err)
 		}
@@ -90,9 +100,14 @@ var cfTenantListCmd = &cobra.Command{
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
 		distributionId := args[0]
 
-		tenants, err := tenant.ListTenants(cfClient, distributionId)
+		tenants, err := tenant.ListTenants(cmdCobra.Context(), cfClient, distributionId)
 		if err != nil {
-			return common.FormatListError("テナント", err)
+			return common.FormatListError(i18n.T("resource.tenant"), err)
+		}
+
+		// 機械可読形式の場合はテナント情報をそのまま出力
+		if common.IsMachineReadable() {
+			return common.RenderRecords(tenants)
 		}
 
 		// テナントIDの一覧を作成
@@ -106,7 +121,7 @@ var cfTenantListCmd = &cobra.Command{
 		common.PrintNumberedList(common.ListOutput{
 			Title:        title,
 			Items:        tenantIds,
-			ResourceName: "テナント",
+			ResourceName: i18n.T("resource.tenant"),
 		})
 
 		return nil
@@ -150,13 +165,13 @@ var cfTenantInvalidateCmd = &cobra.Command{
 
 		if all {
 			// 全テナント無効化
-			err := cfsvc.InvalidateAllTenantsWithMessage(cfClient, opts)
+			err := cfsvc.InvalidateAllTenantsWithMessage(cmdCobra.Context(), cfClient, opts)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
 		} else {
 			// 特定テナントまたは選択
-			err := cfsvc.InvalidateTenantByIdOrSelection(cfClient, list, opts)
+			err := cfsvc.InvalidateTenantByIdOrSelection(cmdCobra.Context(), cfClient, list, opts)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
@@ -180,6 +195,7 @@ func init() {
 	cfInvalidateCmd.Flags().StringSliceP("path", "p", []string{"/*"}, "無効化するパス（デフォルト: /*）")
 	cfInvalidateCmd.Flags().BoolP("wait", "w", false, "無効化完了まで待機")
 	cfInvalidateCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	registerStackCompletion(cfInvalidateCmd)
 
 	// tenant invalidate フラグ
 	cfTenantInvalidateCmd.Flags().StringSliceP("path", "p", []string{"/*"}, "無効化するパス（デフォルト: /*）")
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"awstk/internal/aws"
+	"awstk/internal/completion"
+	"awstk/internal/service/cfn"
+	ec2svc "awstk/internal/service/ec2"
+	ecssvc "awstk/internal/service/ecs"
+	logssvc "awstk/internal/service/logs"
+	rdssvc "awstk/internal/service/rds"
+	"awstk/internal/service/schedule"
+	secretsmgrSvc "awstk/internal/service/secretsmanager"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout は補完時にAWS APIの応答を待つ最大時間
+const completionTimeout = 5 * time.Second
+
+// resourceLister は補完候補となるリソース名の一覧を取得する関数
+// 候補には "名前\t説明" の形式で説明を付けられる
+type resourceLister func(ctx context.Context, cfg awsconfig.Config) ([]string, error)
+
+// completeResources はリソース名の一覧をプロファイル・リージョンごとにキャッシュしながら補完候補を返す
+// 補完時は PersistentPreRunE の認証処理を通らないため、プロファイルとリージョンはここで解決する
+func completeResources(cmd *cobra.Command, kind string, list resourceLister, toComplete string, exclude []string) ([]string, cobra.ShellCompDirective) {
+	if err := loadConfigFile(); err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	profileName := profileSetting(cmd).Value
+	if profileName == "" && !aws.HasStaticCredentials() {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	regionName := regionSetting(cmd).Value
+	endpoint := endpointSetting(cmd).Value
+
+	cache, err := completion.New()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	key := completion.Key{Profile: profileName, Region: regionName, Kind: kind}
+	if endpoint != "" {
+		// LocalStack などの一覧が実環境のキャッシュと混ざらないよう、エンドポイントごとに分ける
+		key.Profile += "@" + endpoint
+	}
+	values, err := cache.Get(key, func() ([]string, error) {
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+		defer cancel()
+
+		cfg, err := aws.LoadAwsConfig(ctx, awsContext(profileName, regionName, endpoint))
+		if err != nil {
+			return nil, err
+		}
+		return list(ctx, cfg)
+	})
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("%s の補完候補の取得に失敗: %v", kind, err), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return filterCompletions(values, toComplete, exclude), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filterCompletions は入力中の文字列で始まる候補を返す（exclude に含まれる名前は除く）
+func filterCompletions(values []string, toComplete string, exclude []string) []string {
+	var matched []string
+	for _, v := range values {
+		name, _, _ := strings.Cut(v, "\t")
+		if strings.HasPrefix(name, toComplete) && !slices.Contains(exclude, name) {
+			matched = append(matched, v)
+		}
+	}
+	return matched
+}
+
+// completeStackNames は -S (--stack) にCloudFormationスタック名を補完する
+func completeStackNames(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeResources(cmd, "stacks", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		stacks, err := cfn.ListCfnStacks(ctx, cloudformation.NewFromConfig(cfg), false)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(stacks))
+		for i, stk := range stacks {
+			values[i] = stk.Name + "\t" + stk.Status
+		}
+		return values, nil
+	}, toComplete, nil)
+}
+
+// completeEcsClusters は -c (--cluster) にECSクラスター名を補完する
+func completeEcsClusters(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeResources(cmd, "ecs-clusters", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		return ecssvc.ListClusterNames(ctx, ecs.NewFromConfig(cfg))
+	}, toComplete, nil)
+}
+
+// completeEcsServices は -s (--service) に、-c またはコンテキストで指定したクラスターのECSサービス名を補完する
+func completeEcsServices(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cluster, _ := cmd.Flags().GetString("cluster")
+	if cluster == "" {
+		if err := loadConfigFile(); err == nil {
+			cluster = activeContext.Ecs.Cluster
+		}
+	}
+	if cluster == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeResources(cmd, "ecs-services/"+cluster, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		return ecssvc.ListServiceNames(ctx, ecs.NewFromConfig(cfg), cluster)
+	}, toComplete, nil)
+}
+
+// completeEc2Instances は -i にEC2インスタンスIDを補完する
+func completeEc2Instances(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeResources(cmd, "ec2-instances", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		instances, err := ec2svc.GetAllEc2Instances(ctx, ec2.NewFromConfig(cfg))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(instances))
+		for i, ins := range instances {
+			values[i] = fmt.Sprintf("%s\t%s (%s)", ins.InstanceId, ins.InstanceName, ins.State)
+		}
+		return values, nil
+	}, toComplete, nil)
+}
+
+// completeRdsInstances は -i にRDSインスタンス名を補完する
+func completeRdsInstances(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeResources(cmd, "rds-instances", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		instances, err := rdssvc.GetAllRdsInstances(ctx, rds.NewFromConfig(cfg))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(instances))
+		for i, ins := range instances {
+			values[i] = fmt.Sprintf("%s\t%s (%s)", ins.InstanceId, ins.Engine, ins.Status)
+		}
+		return values, nil
+	}, toComplete, nil)
+}
+
+// completeScheduleNames は schedule trigger の引数にEventBridge Rule / Scheduler の名前を補完する
+func completeScheduleNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeResources(cmd, "schedules", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		schedules, err := schedule.ListSchedules(ctx, eventbridge.NewFromConfig(cfg), scheduler.NewFromConfig(cfg), schedule.ListOptions{Type: "all"})
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(schedules))
+		for i, s := range schedules {
+			values[i] = s.Name + "\t" + s.Type
+		}
+		return values, nil
+	}, toComplete, nil)
+}
+
+// completeSecretNames は secrets get / delete の引数にシークレット名を補完する
+func completeSecretNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeResources(cmd, "secrets", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		secrets, err := secretsmgrSvc.ListSecrets(ctx, secretsmanager.NewFromConfig(cfg))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(secrets))
+		for i, s := range secrets {
+			values[i] = awsconfig.ToString(s.Name)
+		}
+		return values, nil
+	}, toComplete, nil)
+}
+
+// completeLogGroups は logs delete の引数にロググループ名を補完する（指定済みのロググループは除く）
+func completeLogGroups(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeResources(cmd, "log-groups", func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		groups, err := logssvc.ListLogGroups(ctx, cloudwatchlogs.NewFromConfig(cfg))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]string, len(groups))
+		for i, g := range groups {
+			values[i] = awsconfig.ToString(g.LogGroupName)
+		}
+		return values, nil
+	}, toComplete, args)
+}
+
+// registerStackCompletion はコマンドの -S (--stack) にスタック名の補完を登録する
+func registerStackCompletion(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		_ = c.RegisterFlagCompletionFunc("stack", completeStackNames)
+	}
+}
+
+// registerEcsCompletion はコマンドの -c (--cluster) / -s (--service) にECSクラスター名・サービス名の補完を登録する
+func registerEcsCompletion(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		_ = c.RegisterFlagCompletionFunc("cluster", completeEcsClusters)
+		_ = c.RegisterFlagCompletionFunc("service", completeEcsServices)
+	}
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"awstk/internal/completion"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/spf13/cobra"
+)
+
+func TestFilterCompletions(t *testing.T) {
+	values := []string{"dev-api\tCREATE_COMPLETE", "dev-web\tUPDATE_COMPLETE", "prod-api\tCREATE_COMPLETE"}
+	tests := []struct {
+		name       string
+		toComplete string
+		exclude    []string
+		want       []string
+	}{
+		{name: "入力なしは全件", toComplete: "", want: values},
+		{name: "前方一致", toComplete: "dev-", want: values[:2]},
+		{name: "説明は検索対象外", toComplete: "CREATE", want: nil},
+		{name: "指定済みの名前を除く", toComplete: "dev-", exclude: []string{"dev-api"}, want: values[1:2]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filterCompletions(values, tt.toComplete, tt.exclude); !slices.Equal(got, tt.want) {
+				t.Errorf("filterCompletions() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteResources(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv(completion.TTLEnvName, "")
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().StringP("profile", "P", "", "")
+		cmd.Flags().StringP("region", "R", "", "")
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+	failing := func(context.Context, awsconfig.Config) ([]string, error) {
+		return nil, errors.New("AWSには接続しない")
+	}
+
+	t.Run("プロファイルが解決できなければ候補なし", func(t *testing.T) {
+		got, directive := completeResources(newCmd(), "stacks", failing, "", nil)
+		if got != nil || directive != cobra.ShellCompDirectiveNoFileComp {
+			t.Errorf("completeResources() = (%v, %v)", got, directive)
+		}
+	})
+
+	t.Run("キャッシュ済みの候補を返す", func(t *testing.T) {
+		cache, err := completion.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := completion.Key{Profile: "dev", Region: "us-east-1", Kind: "stacks"}
+		if _, err := cache.Get(key, func() ([]string, error) { return []string{"dev-api", "prod-api"}, nil }); err != nil {
+			t.Fatal(err)
+		}
+
+		got, _ := completeResources(newCmd("-P", "dev", "-R", "us-east-1"), "stacks", failing, "dev", nil)
+		if !slices.Equal(got, []string{"dev-api"}) {
+			t.Errorf("completeResources() = %v, want [dev-api]", got)
+		}
+	})
+
+	t.Run("取得に失敗した場合は候補なし", func(t *testing.T) {
+		got, directive := completeResources(newCmd("-P", "dev", "-R", "eu-west-1"), "stacks", failing, "", nil)
+		if got != nil || directive != cobra.ShellCompDirectiveNoFileComp {
+			t.Errorf("completeResources() = (%v, %v)", got, directive)
+		}
+	})
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"awstk/internal/aws"
+	"awstk/internal/config"
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// 設定ファイルから読み込んだアクティブなコンテキスト
+var (
+	configFile        *config.File
+	activeContextName string
+	activeContext     = &config.Context{}
+)
+
+// ContextCmd represents the context command
+var ContextCmd = &cobra.Command{
+	Use:   "context",
+	Short: "設定ファイルのコンテキスト管理コマンド",
+	Long: `.awstk.yaml（見つからない場合は ~/.config/awstk/config.yaml）に定義した
+名前付きコンテキストを切り替えるコマンド群です。
+
+コンテキストにはプロファイル・リージョン・スタック名・ECSのデフォルト値などを定義できます。
+値の優先順位は フラグ > 環境変数 > コンテキスト > デフォルト です。
+環境変数 ` + config.ContextEnvName + ` を設定すると current-context より優先されます。
+
+設定例 (.awstk.yaml):
+  current-context: dev
+  contexts:
+    dev:
+      profile: my-dev
+      region: ap-northeast-1
+      stack: my-app-dev
+      endpoint-url: http://localhost:4566   # LocalStack など（全サービス共通）
+      endpoints:                           # サービスごとのエンドポイント（endpoint-url より優先）
+        s3: http://localhost:4566
+      ecs:
+        cluster: my-cluster
+        service: my-service
+        container: app
+      cloudfront:
+        distribution: E2ABC123DEF456`,
+}
+
+var contextUseCmd = &cobra.Command{
+	Use:   "use <name>",
+	Short: "カレントコンテキストを切り替える",
+	Long: `設定ファイルの current-context を指定したコンテキストに書き換えます。
+
+例:
+  ` + AppName + ` context use dev`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if configFile.Path == "" {
+			return fmt.Errorf("❌ エラー: 設定ファイルが見つかりません。%s または ~/.config/awstk/config.yaml を作成してください", config.LocalFileName)
+		}
+		if err := configFile.Use(args[0]); err != nil {
+			return fmt.Errorf("❌ エラー: %w", err)
+		}
+		if err := configFile.Save(); err != nil {
+			return fmt.Errorf("❌ エラー: %w", err)
+		}
+		common.Progressf("✅ コンテキストを '%s' に切り替えました (%s)\n", args[0], configFile.Path)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var contextLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "コンテキスト一覧を表示する",
+	Long: `設定ファイルに定義されたコンテキストの一覧を表示します。
+
+例:
+  ` + AppName + ` context ls`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if configFile.Path == "" {
+			fmt.Println("設定ファイルが見つかりませんでした")
+			return nil
+		}
+		common.Progressf("📄 設定ファイル: %s\n", configFile.Path)
+
+		type contextRow struct {
+			Current bool
+			Name    string
+			config.Context
+		}
+		var rows []contextRow
+		for _, name := range configFile.Names() {
+			rows = append(rows, contextRow{
+				Current: name == activeContextName,
+				Name:    name,
+				Context: *configFile.Contexts[name],
+			})
+		}
+
+		return common.DisplayList(
+			rows,
+			common.GenerateFilteredTitle(i18n.T("resource.context")),
+			func(items []contextRow) ([]common.TableColumn, [][]string) {
+				columns := []common.TableColumn{
+					{Header: ""},
+					{Header: i18n.T("header.name")},
+					{Header: i18n.T("header.profile")},
+					{Header: i18n.T("header.region")},
+					{Header: i18n.T("header.stack")},
+				}
+				data := make([][]string, len(items))
+				for i, r := range items {
+					mark := ""
+					if r.Current {
+						mark = "*"
+					}
+					data[i] = []string{mark, r.Name, r.Profile, r.Region, r.Stack}
+				}
+				return columns, data
+			},
+			&common.DisplayOptions{EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.context"))},
+		)
+	},
+	SilenceUsage: true,
+}
+
+var contextCurrentCmd = &cobra.Command{
+	Use:   "current",
+	Short: "カレントコンテキストを表示する",
+	Long: `現在アクティブなコンテキスト名を表示します。
+
+例:
+  ` + AppName + ` context current`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if activeContextName == "" {
+			return fmt.Errorf("❌ エラー: コンテキストが選択されていません。'%s context use <name>' で選択してください", AppName)
+		}
+		fmt.Println(activeContextName)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// loadConfigFile は設定ファイルを読み込み、アクティブなコンテキストを設定する
+func loadConfigFile() error {
+	file, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("❌ エラー: %w", err)
+	}
+	name, ctx, err := file.Active()
+	if err != nil {
+		return fmt.Errorf("❌ エラー: %w", err)
+	}
+	configFile = file
+	activeContextName = name
+	if ctx != nil {
+		activeContext = ctx
+	}
+	return nil
+}
+
+// flagValue はフラグが明示的に指定された場合のみその値を返す
+func flagValue(cmd *cobra.Command, name string) string {
+	f := cmd.Flags().Lookup(name)
+	if f == nil || !f.Changed {
+		return ""
+	}
+	return f.Value.String()
+}
+
+// profileSetting はプロファイルを フラグ > 環境変数 > コンテキスト の順に解決する
+func profileSetting(cmd *cobra.Command) config.Setting {
+	return config.Resolve("profile",
+		config.FromFlag("-P", flagValue(cmd, "profile")),
+		config.FromEnv("AWS_PROFILE"),
+		config.FromContext(activeContextName, activeContext.Profile),
+	)
+}
+
+// regionSetting はリージョンを フラグ > 環境変数 > コンテキスト > デフォルト の順に解決する
+func regionSetting(cmd *cobra.Command) config.Setting {
+	return config.Resolve("region",
+		config.FromFlag("-R", flagValue(cmd, "region")),
+		config.FromEnv("AWS_REGION"),
+		config.FromEnv("AWS_DEFAULT_REGION"),
+		config.FromContext(activeContextName, activeContext.Region),
+		config.Default(DefaultRegion),
+	)
+}
+
+// stackSetting はスタック名を フラグ > 環境変数 > コンテキスト の順に解決する
+func stackSetting(flagStack string) config.Setting {
+	return config.Resolve("stack",
+		config.FromFlag("-S", flagStack),
+		config.FromEnv("AWS_STACK_NAME"),
+		config.FromContext(activeContextName, activeContext.Stack),
+	)
+}
+
+// endpointSetting は全サービス共通のエンドポイントURLを フラグ > 環境変数 > コンテキスト の順に解決する
+func endpointSetting(cmd *cobra.Command) config.Setting {
+	return config.Resolve("endpoint-url",
+		config.FromFlag("--endpoint-url", flagValue(cmd, "endpoint-url")),
+		config.FromEnv(aws.EndpointURLEnvName),
+		config.FromContext(activeContextName, activeContext.EndpointURL),
+	)
+}
+
+// serviceEndpoints はサービスごとのエンドポイントURLを 環境変数 > コンテキスト の順に解決する
+func serviceEndpoints() map[string]string {
+	endpoints := map[string]string{}
+	for service, endpoint := range activeContext.Endpoints {
+		endpoints[aws.ServiceKey(service)] = endpoint
+	}
+	maps.Copy(endpoints, aws.ServiceEndpointsFromEnv(os.Environ()))
+	return endpoints
+}
+
+// awsContext は解決済みのプロファイル・リージョンとエンドポイントの設定から aws.Context を作成する
+func awsContext(profileName, regionName, endpoint string) aws.Context {
+	return aws.Context{
+		Profile:     profileName,
+		Region:      regionName,
+		EndpointURL: endpoint,
+		Endpoints:   serviceEndpoints(),
+	}
+}
+
+// allSettings は env show で表示する設定値の一覧を返す
+func allSettings(cmd *cobra.Command) []config.Setting {
+	return []config.Setting{
+		profileSetting(cmd),
+		regionSetting(cmd),
+		stackSetting(""),
+		endpointSetting(cmd),
+		config.Resolve("ecs.cluster", config.FromContext(activeContextName, activeContext.Ecs.Cluster)),
+		config.Resolve("ecs.service", config.FromContext(activeContextName, activeContext.Ecs.Service)),
+		config.Resolve("ecs.container",
+			config.FromContext(activeContextName, activeContext.Ecs.Container),
+			config.Default(DefaultContainerName),
+		),
+		config.Resolve("cloudfront.distribution", config.FromContext(activeContextName, activeContext.CloudFront.Distribution)),
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(ContextCmd)
+	ContextCmd.AddCommand(contextUseCmd)
+	ContextCmd.AddCommand(contextLsCmd)
+	ContextCmd.AddCommand(contextCurrentCmd)
+}
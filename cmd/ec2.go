@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"awstk/internal/service/common"
 	ec2svc "awstk/internal/service/ec2"
+	"awstk/internal/service/tagging"
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/spf13/cobra"
 )
 
@@ -38,17 +42,33 @@ var ec2StartCmd = &cobra.Command{
 	Short: "EC2インスタンスを起動するコマンド",
 	Long: `EC2インスタンスを起動します。
 インスタンスIDを直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのインスタンスを起動します。
+-i・--tag を省略した場合は、インスタンス一覧から選択できます。
 
 例:
-  ` + AppName + ` ec2 start -i i-1234567890abcdef0`,
+  ` + AppName + ` ec2 start -i i-1234567890abcdef0
+  ` + AppName + ` ec2 start --tag env=dev --tag owner=team-a
+  ` + AppName + ` ec2 start`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("🚀 EC2インスタンス (%s) を起動します...\n", ec2InstanceId)
-		err := ec2svc.StartEc2Instance(ec2Client, ec2InstanceId)
+		if len(tagExprs) > 0 {
+			return forEachTagged(cmd, tagging.ResourceEc2Instance, "EC2インスタンスの起動", nil, func(ctx context.Context, id string) error {
+				if err := ec2svc.StartEc2Instance(ctx, ec2Client, id); err != nil {
+					return err
+				}
+				common.Progressf("✅ EC2インスタンス (%s) の起動を開始しました\n", id)
+				return nil
+			})
+		}
+		if err := resolveEc2InstanceId(cmd); err != nil {
+			return err
+		}
+		common.Progressf("🚀 EC2インスタンス (%s) を起動します...\n", ec2InstanceId)
+		err := ec2svc.StartEc2Instance(cmd.Context(), ec2Client, ec2InstanceId)
 		if err != nil {
 			return fmt.Errorf("❌ EC2インスタンス起動エラー: %w", err)
 		}
 
-		fmt.Printf("✅ EC2インスタンス (%s) の起動を開始しました\n", ec2InstanceId)
+		common.Progressf("✅ EC2インスタンス (%s) の起動を開始しました\n", ec2InstanceId)
 		return nil
 	},
 	SilenceUsage: true,
@@ -59,17 +79,33 @@ var ec2StopCmd = &cobra.Command{
 	Short: "EC2インスタンスを停止するコマンド",
 	Long: `EC2インスタンスを停止します。
 インスタンスIDを直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのインスタンスを停止します。
+-i・--tag を省略した場合は、インスタンス一覧から選択できます。
 
 例:
-  ` + AppName + ` ec2 stop -i i-1234567890abcdef0`,
+  ` + AppName + ` ec2 stop -i i-1234567890abcdef0
+  ` + AppName + ` ec2 stop --tag env=dev
+  ` + AppName + ` ec2 stop`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("🛑 EC2インスタンス (%s) を停止します...\n", ec2InstanceId)
-		err := ec2svc.StopEc2Instance(ec2Client, ec2InstanceId)
+		if len(tagExprs) > 0 {
+			return forEachTagged(cmd, tagging.ResourceEc2Instance, "EC2インスタンスの停止", nil, func(ctx context.Context, id string) error {
+				if err := ec2svc.StopEc2Instance(ctx, ec2Client, id); err != nil {
+					return err
+				}
+				common.Progressf("✅ EC2インスタンス (%s) の停止を開始しました\n", id)
+				return nil
+			})
+		}
+		if err := resolveEc2InstanceId(cmd); err != nil {
+			return err
+		}
+		common.Progressf("🛑 EC2インスタンス (%s) を停止します...\n", ec2InstanceId)
+		err := ec2svc.StopEc2Instance(cmd.Context(), ec2Client, ec2InstanceId)
 		if err != nil {
 			return fmt.Errorf("❌ EC2インスタンス停止エラー: %w", err)
 		}
 
-		fmt.Printf("✅ EC2インスタンス (%s) の停止を開始しました\n", ec2InstanceId)
+		common.Progressf("✅ EC2インスタンス (%s) の停止を開始しました\n", ec2InstanceId)
 		return nil
 	},
 	SilenceUsage: true,
@@ -78,14 +114,54 @@ var ec2StopCmd = &cobra.Command{
 var ec2LsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "EC2インスタンス一覧を表示するコマンド",
-	Long:  `EC2インスタンス一覧を表示します。`,
+	Long: `EC2インスタンス一覧を表示します。
+-S でスタック、--tag でタグの条件に一致するインスタンスに絞り込めます。
+
+例:
+  ` + AppName + ` ec2 ls --tag env=dev --tag owner!=team-a`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		opts := ec2svc.ListOptions{StackName: stackName, Tags: tags}
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return ec2svc.ListEc2InstancesInRegions(cmd.Context(), regions, func(r string) ec2svc.ClientSet {
+				cfg := regionConfig(r)
+				return ec2svc.ClientSet{
+					Ec2Client: ec2.NewFromConfig(cfg),
+					CfnClient: cloudformation.NewFromConfig(cfg),
+					TagClient: resourcegroupstaggingapi.NewFromConfig(cfg),
+				}
+			}, opts)
+		}
 		// service層の統合関数を呼び出すだけ
-		return ec2svc.ListEc2Instances(ec2Client, cfnClient, stackName)
+		return ec2svc.ListEc2Instances(cmd.Context(), ec2svc.ClientSet{
+			Ec2Client: ec2Client,
+			CfnClient: cfnClient,
+			TagClient: resourceGroupsTaggingClient(),
+		}, opts)
 	},
 	SilenceUsage: true,
 }
 
+// resolveEc2InstanceId は -i が省略された場合にインスタンス一覧から選択させる
+func resolveEc2InstanceId(cmd *cobra.Command) error {
+	if ec2InstanceId != "" {
+		return nil
+	}
+	instanceId, err := ec2svc.SelectInstanceInteractively(cmd.Context(), ec2Client)
+	if err != nil {
+		return err
+	}
+	ec2InstanceId = instanceId
+	return nil
+}
+
 func init() {
 	RootCmd.AddCommand(Ec2Cmd)
 	Ec2Cmd.AddCommand(ec2StartCmd)
@@ -93,9 +169,14 @@ func init() {
 	Ec2Cmd.AddCommand(ec2LsCmd)
 
 	// フラグの追加
-	ec2StartCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID")
-	_ = ec2StartCmd.MarkFlagRequired("instance")
-	ec2StopCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID")
-	_ = ec2StopCmd.MarkFlagRequired("instance")
+	ec2StartCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID（省略時は一覧から選択）")
+	ec2StopCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID（省略時は一覧から選択）")
 	ec2LsCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	_ = ec2StartCmd.RegisterFlagCompletionFunc("instance", completeEc2Instances)
+	_ = ec2StopCmd.RegisterFlagCompletionFunc("instance", completeEc2Instances)
+	addTagFlag(ec2StartCmd, ec2StopCmd, ec2LsCmd)
+	ec2StartCmd.MarkFlagsMutuallyExclusive("instance", "tag")
+	ec2StopCmd.MarkFlagsMutuallyExclusive("instance", "tag")
+	registerStackCompletion(ec2LsCmd)
+	addRegionsFlag(ec2LsCmd)
 }
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"awstk/internal/service/common"
 	ecrsvc "awstk/internal/service/ecr"
+	"awstk/internal/service/tagging"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/spf13/cobra"
@@ -33,15 +36,32 @@ var ecrCleanupCmd = &cobra.Command{
 	Use:   "cleanup",
 	Short: "ECRリポジトリを削除するコマンド",
 	Long: `指定したキーワードを含むECRリポジトリを削除します。
+--tag を指定するとタグの条件に一致するリポジトリを対象にします（-f と組み合わせた場合は両方に一致するもの）。
 
 例:
-  ` + AppName + ` ecr cleanup -f "test-repo" -P my-profile`,
+  ` + AppName + ` ecr cleanup -f "test-repo" -P my-profile
+  ` + AppName + ` ecr cleanup --tag env=dev -P my-profile
+  ` + AppName + ` ecr cleanup -f "test-repo" --dry-run`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filter, _ := cmd.Flags().GetString("filter")
 
 		printAwsContextWithInfo("検索文字列", filter)
 
-		return ecrsvc.CleanupRepositoriesByFilter(ecrClient, filter)
+		repositories, err := ecrsvc.GetEcrRepositoriesByFilter(cmd.Context(), ecrClient, filter)
+		if err != nil {
+			return fmt.Errorf("❌ ECRリポジトリ一覧取得エラー: %w", err)
+		}
+		repositories, err = filterByTag(cmd.Context(), tagging.ResourceEcrRepository, repositories)
+		if err != nil {
+			return err
+		}
+
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(ecrsvc.CleanupActions(repositories)...)
+		if err := runPlan(cmd, plan, false); err != nil {
+			return fmt.Errorf("❌ ECRリポジトリ削除エラー: %w", err)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -77,7 +97,17 @@ var ecrLsCmd = &cobra.Command{
 			ShowDetails: showDetails,
 		}
 
-		return ecrsvc.ListRepositories(ecrClient, opts)
+		regions, err := resolveTargetRegions(cmdCobra)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return ecrsvc.ListRepositoriesInRegions(cmdCobra.Context(), regions, func(r string) ecrsvc.API {
+				return ecr.NewFromConfig(regionConfig(r))
+			}, opts)
+		}
+
+		return ecrsvc.ListRepositories(cmdCobra.Context(), ecrClient, opts)
 	},
 	SilenceUsage: true,
 }
@@ -91,8 +121,11 @@ func init() {
 	ecrLsCmd.Flags().BoolP("empty-only", "e", false, "空のリポジトリのみを表示")
 	ecrLsCmd.Flags().BoolP("no-lifecycle", "n", false, "ライフサイクルポリシー未設定のリポジトリのみを表示")
 	ecrLsCmd.Flags().BoolP("details", "d", false, "詳細情報を表示")
+	addRegionsFlag(ecrLsCmd)
 
 	// cleanup コマンドのフラグ
 	ecrCleanupCmd.Flags().StringP("filter", "f", "", "削除対象のフィルターパターン")
-	_ = ecrCleanupCmd.MarkFlagRequired("filter")
+	addTagFlag(ecrCleanupCmd)
+	addPlanFlags(ecrCleanupCmd)
+	ecrCleanupCmd.MarkFlagsOneRequired("filter", "tag")
 }
@@ -1,9 +1,14 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
+	"awstk/internal/config"
+	"awstk/internal/service/common"
 	ecssvc "awstk/internal/service/ecs"
+	"awstk/internal/service/tagging"
+	"context"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -24,6 +29,9 @@ var (
 	ecsClient      *ecs.Client
 )
 
+// DefaultContainerName はコンテナ名が指定されていない場合に使用するコンテナ名
+const DefaultContainerName = "app"
+
 var EcsCmd = &cobra.Command{
 	Use:   "ecs",
 	Short: "ECSリソース操作コマンド",
@@ -46,34 +54,27 @@ var ecsExecCmd = &cobra.Command{
 	Short: "Fargateコンテナに接続するコマンド",
 	Long: `Fargateコンテナにシェル接続するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 
 例:
   ` + AppName + ` ecs exec -P my-profile -S my-stack
   ` + AppName + ` ecs exec -P my-profile -c my-cluster -s my-service -t app`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
-		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
+		resolveContainerName()
 
 		// タスクIDを取得
-		taskId, err := ecssvc.GetRunningTask(ecsClient, clusterName, serviceName)
+		taskId, err := ecssvc.GetRunningTask(cmd.Context(), ecsClient, clusterName, serviceName)
 		if err != nil {
 			return fmt.Errorf("❌ エラー: %w", err)
 		}
 
 		// シェル接続を実行
-		fmt.Printf("🔍 コンテナ '%s' に接続しています...\n", containerName)
-		awsCtx := aws.Context{Region: region, Profile: profile}
+		common.Progressf("🔍 コンテナ '%s' に接続しています...\n", containerName)
+		awsCtx := awsContext(profile, region, endpointURL)
 		err = ecssvc.ExecuteEcsCommand(awsCtx, ecssvc.ExecOptions{
 			ClusterName:   clusterName,
 			TaskId:        taskId,
@@ -93,29 +94,33 @@ var ecsStartCmd = &cobra.Command{
 	Short: "ECSサービスのキャパシティを設定して起動するコマンド",
 	Long: `ECSサービスの最小・最大キャパシティを設定して起動するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 サービスが指定したキャパシティになるまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。
+--tag を指定すると、タグの条件に一致するすべてのサービスを1つずつ起動します（--concurrency で並列数を変更できます）。
 
 例:
   ` + AppName + ` ecs start -P my-profile -S my-stack -m 1 -M 2
   ` + AppName + ` ecs start -P my-profile -c my-cluster -s my-service -m 1 -M 3
-  ` + AppName + ` ecs start -P my-profile -S my-stack -m 1 -M 2`,
+  ` + AppName + ` ecs start -P my-profile --tag env=dev -m 1 -M 2`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
+		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+		if len(tagExprs) > 0 {
+			return forEachTaggedEcsService(cmd, "ECSサービスの起動", func(ctx context.Context, cluster, service string) error {
+				return ecssvc.StartEcsService(ctx, ecsClient, aasClient, ecssvc.StartServiceOptions{
+					ClusterName:    cluster,
+					ServiceName:    service,
+					MinCapacity:    minCapacity,
+					MaxCapacity:    maxCapacity,
+					TimeoutSeconds: timeoutSeconds,
+				})
+			})
 		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
 
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
 		startOpts := ecssvc.StartServiceOptions{
 			ClusterName:    clusterName,
 			ServiceName:    serviceName,
@@ -123,7 +128,7 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			MaxCapacity:    maxCapacity,
 			TimeoutSeconds: timeoutSeconds,
 		}
-		err = ecssvc.StartEcsService(ecsClient, aasClient, startOpts)
+		err = ecssvc.StartEcsService(cmd.Context(), ecsClient, aasClient, startOpts)
 		if err != nil {
 			return err
 		}
@@ -138,35 +143,37 @@ var ecsStopCmd = &cobra.Command{
 	Short: "ECSサービスを停止するコマンド",
 	Long: `ECSサービスの最小・最大キャパシティを0に設定して停止するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 サービスが完全に停止するまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。
+--tag を指定すると、タグの条件に一致するすべてのサービスを1つずつ停止します（--concurrency で並列数を変更できます）。
 
 例:
   ` + AppName + ` ecs stop -P my-profile -S my-stack
   ` + AppName + ` ecs stop -P my-profile -c my-cluster -s my-service
-  ` + AppName + ` ecs stop -P my-profile -S my-stack`,
+  ` + AppName + ` ecs stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
+		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+		if len(tagExprs) > 0 {
+			return forEachTaggedEcsService(cmd, "ECSサービスの停止", func(ctx context.Context, cluster, service string) error {
+				return ecssvc.StopEcsService(ctx, ecsClient, aasClient, ecssvc.StopServiceOptions{
+					ClusterName:    cluster,
+					ServiceName:    service,
+					TimeoutSeconds: timeoutSeconds,
+				})
+			})
 		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
 
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
 		stopOpts := ecssvc.StopServiceOptions{
 			ClusterName:    clusterName,
 			ServiceName:    serviceName,
 			TimeoutSeconds: timeoutSeconds,
 		}
-		err = ecssvc.StopEcsService(ecsClient, aasClient, stopOpts)
+		err = ecssvc.StopEcsService(cmd.Context(), ecsClient, aasClient, stopOpts)
 		if err != nil {
 			return err
 		}
@@ -181,6 +188,7 @@ var ecsRunCmd = &cobra.Command{
 	Short: "ECSタスクを実行するコマンド",
 	Long: `ECSタスクを実行してその完了を待機するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 タスク定義は指定されていない場合、サービスで使用されている最新のタスク定義が使用されます。
 待機タイムアウトは--timeoutで秒数指定できます（デフォルト: 300秒）。
 
@@ -189,19 +197,11 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
   ` + AppName + ` ecs run -P my-profile -c my-cluster -s my-service -t app -C "echo hello"
   ` + AppName + ` ecs run -P my-profile -S my-stack -t app -d my-task-def:1 -C "echo hello"`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
-		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
+		resolveContainerName()
 
 		// タスク実行オプションを作成
 		runOpts := ecssvc.RunAndWaitForTaskOptions{
@@ -214,13 +214,13 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 		}
 
 		// タスクを実行して完了を待機
-		fmt.Println("🚀 ECSタスクを実行します...")
-		exitCode, err := ecssvc.RunAndWaitForTask(ecsClient, runOpts)
+		common.Progressln("🚀 ECSタスクを実行します...")
+		exitCode, err := ecssvc.RunAndWaitForTask(cmd.Context(), ecsClient, runOpts)
 		if err != nil {
 			return fmt.Errorf("❌ タスク実行エラー: %w", err)
 		}
 
-		fmt.Printf("✅ タスクが完了しました。終了コード: %d\n", exitCode)
+		common.Progressf("✅ タスクが完了しました。終了コード: %d\n", exitCode)
 		// 終了コードが0以外の場合はエラーとして扱う
 		if exitCode != 0 {
 			return fmt.Errorf("❌ タスクが異常終了しました。終了コード: %d", exitCode)
@@ -237,6 +237,7 @@ var ecsRedeployCmd = &cobra.Command{
 	Long: `ECSサービスを強制再デプロイするコマンドです。
 パラメータストアの値を更新した後などに、新しい設定でタスクを再起動したい場合に使用します。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 デフォルトでデプロイ完了まで待機します。--no-waitフラグを指定すると、待機せずに即座に終了します。
 
 例:
@@ -244,22 +245,13 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
   ` + AppName + ` ecs redeploy -P my-profile -c my-cluster -s my-service
   ` + AppName + ` ecs redeploy -P my-profile -S my-stack --no-wait`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
-		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
 
 		// 強制再デプロイを実行
-		err = ecssvc.ForceRedeployService(ecsClient, clusterName, serviceName)
+		err = ecssvc.ForceRedeployService(cmd.Context(), ecsClient, clusterName, serviceName)
 		if err != nil {
 			return fmt.Errorf("❌ エラー: %w", err)
 		}
@@ -272,7 +264,7 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 				ServiceName:    serviceName,
 				TimeoutSeconds: timeoutSeconds,
 			}
-			err = ecssvc.WaitForDeploymentComplete(ecsClient, waitOpts)
+			err = ecssvc.WaitForDeploymentComplete(cmd.Context(), ecsClient, waitOpts)
 			if err != nil {
 				return fmt.Errorf("❌ デプロイ完了待機エラー: %w", err)
 			}
@@ -288,21 +280,13 @@ var ecsStatusCmd = &cobra.Command{
 	Short: "ECSサービスの状態を表示するコマンド",
 	Long: `ECSサービスのタスク稼働状況を表示するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+どちらも省略した場合は、クラスターとサービスを一覧から選択できます。
 
 例:
   ` + AppName + ` ecs status -P my-profile -S my-stack
   ` + AppName + ` ecs status -P my-profile -c my-cluster -s my-service`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var err error
-
-		resolveStackName()
-		opts := ecssvc.ResolveOptions{
-			StackName:   stackName,
-			ClusterName: clusterName,
-			ServiceName: serviceName,
-		}
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		err := resolveEcsTarget(cmd.Context())
 		if err != nil {
 			return err
 		}
@@ -315,11 +299,16 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 		}
 
 		// サービス状態を取得
-		status, err := ecssvc.GetServiceStatus(ecsClient, aasClient, statusOpts)
+		status, err := ecssvc.GetServiceStatus(cmd.Context(), ecsClient, aasClient, statusOpts)
 		if err != nil {
 			return fmt.Errorf("❌ エラー: %w", err)
 		}
 
+		// 機械可読形式の場合はサービス状態をそのまま出力
+		if common.IsMachineReadable() {
+			return common.RenderRecord(status)
+		}
+
 		// 状態を表示
 		ecssvc.ShowServiceStatus(status)
 		return nil
@@ -327,6 +316,55 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 	SilenceUsage: true,
 }
 
+// resolveEcsTarget はフラグ・環境変数・コンテキストからECSクラスター名とサービス名を解決し、
+// グローバル変数 clusterName, serviceName にセットする
+func resolveEcsTarget(ctx context.Context) error {
+	// -S も環境変数のスタック名もなければ、未指定の -c/-s をコンテキストで補完する
+	if stackName == "" && os.Getenv("AWS_STACK_NAME") == "" &&
+		(activeContext.Ecs.Cluster != "" || activeContext.Ecs.Service != "") {
+		if clusterName == "" {
+			clusterName = activeContext.Ecs.Cluster
+		}
+		if serviceName == "" {
+			serviceName = activeContext.Ecs.Service
+		}
+		common.Progressf("🔍 コンテキスト '%s' のECSサービス '%s/%s' を使用します\n", activeContextName, clusterName, serviceName)
+	}
+	resolveStackNameUnless(clusterName != "" || serviceName != "")
+
+	opts := ecssvc.ResolveOptions{
+		StackName:   stackName,
+		ClusterName: clusterName,
+		ServiceName: serviceName,
+	}
+	cfnClient := cloudformation.NewFromConfig(awsCfg)
+	var err error
+	clusterName, serviceName, err = ecssvc.ResolveClusterAndService(ctx, ecsClient, cfnClient, opts)
+	return err
+}
+
+// forEachTaggedEcsService は --tag に一致するECSサービスごとに fn を実行する
+// 起動・停止の待機中の出力が混ざらないよう、既定では1つずつ処理する
+func forEachTaggedEcsService(cmd *cobra.Command, operation string, fn func(ctx context.Context, cluster, service string) error) error {
+	return forEachTagged(cmd, tagging.ResourceEcsService, operation, &common.RunOptions{Concurrency: 1, MaxAttempts: 1}, func(ctx context.Context, name string) error {
+		cluster, service, ok := strings.Cut(name, "/")
+		if !ok {
+			// 旧形式のARN (service/<サービス名>) にはクラスター名が含まれない
+			return fmt.Errorf("クラスター名を判別できません。-c/-s で指定してください")
+		}
+		return fn(ctx, cluster, service)
+	})
+}
+
+// resolveContainerName はコンテナ名を フラグ > コンテキスト > デフォルト の順に解決する
+func resolveContainerName() {
+	containerName = config.Resolve("ecs.container",
+		config.FromFlag("-t", containerName),
+		config.FromContext(activeContextName, activeContext.Ecs.Container),
+		config.Default(DefaultContainerName),
+	).Value
+}
+
 func init() {
 	RootCmd.AddCommand(EcsCmd)
 	EcsCmd.AddCommand(ecsExecCmd)
@@ -338,38 +376,46 @@ func init() {
 
 	// execコマンドのフラグを設定
 	ecsExecCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsExecCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsExecCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
-	ecsExecCmd.Flags().StringVarP(&containerName, "container", "t", "app", "接続するコンテナ名")
+	ecsExecCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsExecCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
+	ecsExecCmd.Flags().StringVarP(&containerName, "container", "t", "", "接続するコンテナ名 (デフォルト: "+DefaultContainerName+")")
 	ecsExecCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
 	ecsExecCmd.MarkFlagsMutuallyExclusive("stack", "service")
 	ecsExecCmd.MarkFlagsRequiredTogether("cluster", "service")
 
 	// startコマンドのフラグを設定
 	ecsStartCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsStartCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsStartCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
+	ecsStartCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsStartCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
 	ecsStartCmd.Flags().IntVarP(&minCapacity, "min", "m", 1, "最小キャパシティ")
 	ecsStartCmd.Flags().IntVarP(&maxCapacity, "max", "M", 2, "最大キャパシティ")
 	ecsStartCmd.Flags().IntVar(&timeoutSeconds, "timeout", 300, "待機タイムアウト（秒）")
 	ecsStartCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
 	ecsStartCmd.MarkFlagsMutuallyExclusive("stack", "service")
 	ecsStartCmd.MarkFlagsRequiredTogether("cluster", "service")
+	addTagFlag(ecsStartCmd)
+	ecsStartCmd.MarkFlagsMutuallyExclusive("tag", "stack")
+	ecsStartCmd.MarkFlagsMutuallyExclusive("tag", "cluster")
+	ecsStartCmd.MarkFlagsMutuallyExclusive("tag", "service")
 
 	// stopコマンドのフラグを設定
 	ecsStopCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsStopCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsStopCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
+	ecsStopCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsStopCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
 	ecsStopCmd.Flags().IntVar(&timeoutSeconds, "timeout", 300, "待機タイムアウト（秒）")
 	ecsStopCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
 	ecsStopCmd.MarkFlagsMutuallyExclusive("stack", "service")
 	ecsStopCmd.MarkFlagsRequiredTogether("cluster", "service")
+	addTagFlag(ecsStopCmd)
+	ecsStopCmd.MarkFlagsMutuallyExclusive("tag", "stack")
+	ecsStopCmd.MarkFlagsMutuallyExclusive("tag", "cluster")
+	ecsStopCmd.MarkFlagsMutuallyExclusive("tag", "service")
 
 	// runコマンドのフラグを設定
 	ecsRunCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsRunCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsRunCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
-	ecsRunCmd.Flags().StringVarP(&containerName, "container", "t", "app", "実行するコンテナ名")
+	ecsRunCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsRunCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
+	ecsRunCmd.Flags().StringVarP(&containerName, "container", "t", "", "実行するコンテナ名 (デフォルト: "+DefaultContainerName+")")
 	ecsRunCmd.Flags().StringVarP(&taskDefinition, "task-definition", "d", "", "タスク定義 (指定しない場合はサービスのタスク定義を使用)")
 	ecsRunCmd.Flags().StringVarP(&commandString, "command", "C", "", "実行するコマンド")
 	ecsRunCmd.Flags().IntVar(&timeoutSeconds, "timeout", 300, "待機タイムアウト（秒）")
@@ -379,8 +425,8 @@ func init() {
 
 	// redeployコマンドのフラグを設定
 	ecsRedeployCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsRedeployCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsRedeployCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
+	ecsRedeployCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsRedeployCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
 	ecsRedeployCmd.Flags().IntVar(&timeoutSeconds, "timeout", 300, "待機タイムアウト（秒）")
 	ecsRedeployCmd.Flags().Bool("no-wait", false, "デプロイ完了を待機せずに即座に終了する")
 	ecsRedeployCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
@@ -389,9 +435,13 @@ func init() {
 
 	// statusコマンドのフラグを設定
 	ecsStatusCmd.Flags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
-	ecsStatusCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sが指定されていない場合に必須)")
-	ecsStatusCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sが指定されていない場合に必須)")
+	ecsStatusCmd.Flags().StringVarP(&clusterName, "cluster", "c", "", "ECSクラスター名 (-Sも省略した場合は一覧から選択)")
+	ecsStatusCmd.Flags().StringVarP(&serviceName, "service", "s", "", "ECSサービス名 (-Sも省略した場合は一覧から選択)")
 	ecsStatusCmd.MarkFlagsMutuallyExclusive("stack", "cluster")
 	ecsStatusCmd.MarkFlagsMutuallyExclusive("stack", "service")
 	ecsStatusCmd.MarkFlagsRequiredTogether("cluster", "service")
+
+	// -S / -c / -s にリソース名の補完を登録
+	registerStackCompletion(ecsExecCmd, ecsStartCmd, ecsStopCmd, ecsRunCmd, ecsRedeployCmd, ecsStatusCmd)
+	registerEcsCompletion(ecsExecCmd, ecsStartCmd, ecsStopCmd, ecsRunCmd, ecsRedeployCmd, ecsStatusCmd)
 }
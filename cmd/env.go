@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"awstk/internal/service/common"
 	"awstk/internal/service/env"
 	"fmt"
 
@@ -49,10 +50,12 @@ var envSetCmd = &cobra.Command{
 		}
 
 		if len(commands) == 0 {
-			return fmt.Errorf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
+			return common.InvalidInputf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
 		}
 
-		fmt.Println("✅ 以下のコマンドを実行して環境変数を設定してください：")
+		// eval で読み込めるよう、標準出力にはコマンドのみを出力する
+		common.SetProgressToStderr(true)
+		common.Progressln("✅ 以下のコマンドを実行して環境変数を設定してください：")
 		for _, cmd := range commands {
 			fmt.Println(cmd)
 		}
@@ -62,14 +65,17 @@ var envSetCmd = &cobra.Command{
 
 var envShowCmd = &cobra.Command{
 	Use:   "show",
-	Short: "環境変数の現在値を表示",
-	Long: `現在設定されているAWS関連の環境変数を表示します。
+	Short: "設定値と取得元を表示",
+	Long: `プロファイル・リージョン・スタック名などの現在の設定値と、その取得元を表示します。
+値は フラグ > 環境変数 > コンテキスト > デフォルト の優先順位で解決されます。
 
 例:
   ` + AppName + ` env show`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		env.ShowAllVariables()
-		return nil
+		if activeContextName != "" {
+			common.Progressf("📄 コンテキスト: %s (%s)\n", activeContextName, configFile.Path)
+		}
+		return env.ShowSettings(allSettings(cmd))
 	},
 }
 
@@ -105,10 +111,12 @@ var envUnsetCmd = &cobra.Command{
 		}
 
 		if len(commands) == 0 {
-			return fmt.Errorf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
+			return common.InvalidInputf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
 		}
 
-		fmt.Println("✅ 以下のコマンドを実行して環境変数を削除してください：")
+		// eval で読み込めるよう、標準出力にはコマンドのみを出力する
+		common.SetProgressToStderr(true)
+		common.Progressln("✅ 以下のコマンドを実行して環境変数を削除してください：")
 		for _, cmd := range commands {
 			fmt.Println(cmd)
 		}
@@ -124,11 +132,12 @@ func init() {
 
 	// env set のフラグ
 	envSetCmd.Flags().StringVarP(&envStackName, "stack", "S", "", "設定するスタック名")
+	registerStackCompletion(envSetCmd)
 	envSetCmd.Flags().StringVarP(&envProfile, "profile", "P", "", "設定するプロファイル名")
 	// どちらか1つ必須
 	envSetCmd.MarkFlagsOneRequired("stack", "profile")
 
-	// env show のフラグは不要（常に全て表示）
+	// env show はグローバルフラグ (-P, -R) を含めて解決結果を表示する
 
 	// env unset のフラグ
 	envUnsetCmd.Flags().BoolP("stack", "S", false, "スタック名を削除")
@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"awstk/internal/config"
+	"awstk/internal/service/common"
+	"awstk/internal/service/group"
+	"awstk/internal/ui/picker"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/spf13/cobra"
+)
+
+var (
+	groupDryRun  bool
+	groupNoWait  bool
+	groupTimeout int
+)
+
+// GroupCmd represents the group command
+var GroupCmd = &cobra.Command{
+	Use:   "group",
+	Short: "リソースグループの一括起動・停止コマンド",
+	Long: `設定ファイルに定義したリソースグループを、段階ごとに順序を守って起動・停止します。
+CloudFormationスタックに含まれないリソースや、種類の異なるリソースをまとめて扱えます。
+対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス、Canary、EventBridgeスケジュール
+
+グループは設定ファイル（.awstk.yaml / ~/.config/awstk/config.yaml）の groups に定義します。
+起動時は tiers の上から順に、停止時は下から順に処理し、各段階の完了を待ってから次の段階に進みます。
+type: ec2 | rds | aurora | ecs | canary | schedule
+id と tag のどちらか一方を指定します（ECSサービスの id は <クラスター名>/<サービス名>）。
+
+  groups:
+    dev:
+      tiers:
+        - name: db
+          resources:
+            - {type: aurora, id: dev-cluster}
+        - name: app
+          resources:
+            - {type: ecs, id: dev-cluster/api, min: 1, max: 2}
+            - {type: ec2, tag: [env=dev, role=batch]}
+        - name: monitoring
+          resources:
+            - {type: canary, id: dev-healthcheck}
+            - {type: schedule, id: dev-nightly-batch}
+
+グループ名の代わりに --tag を指定すると、タグに一致するリソースを
+DB（RDS・Aurora）→ アプリケーション（EC2・ECS）→ 監視（Canary・スケジュール）の順に扱います。`,
+}
+
+var groupStartCmd = &cobra.Command{
+	Use:   "start [group]",
+	Short: "リソースグループを起動するコマンド",
+	Long: `リソースグループのリソースを、定義した段階の順に起動します。
+各段階の起動完了（EC2: running、RDS・Aurora: available、ECS: 実行中タスク数が希望数に一致 など）を待ってから次の段階に進みます。
+失敗したリソースがある場合は以降の段階を実行しません。
+グループ名と --tag を省略した場合は、設定ファイルのグループ一覧から選択できます。
+
+例:
+  ` + AppName + ` group start dev
+  ` + AppName + ` group start dev --dry-run
+  ` + AppName + ` group start --tag env=dev`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g, err := resolveGroup(cmd, args)
+		if err != nil {
+			return err
+		}
+		if groupDryRun {
+			group.PrintPlan(g, false)
+			return nil
+		}
+
+		printAwsContextWithInfo("Group", g.Name)
+		if err := group.Start(cmd.Context(), groupClients(), g, groupOptions()); err != nil {
+			return fmt.Errorf("❌ グループの起動処理でエラー: %w", err)
+		}
+		common.Progressln("\n✅ グループの起動処理が完了しました")
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var groupStopCmd = &cobra.Command{
+	Use:   "stop [group]",
+	Short: "リソースグループを停止するコマンド",
+	Long: `リソースグループのリソースを、定義した段階の逆順に停止します。
+各段階の停止完了を待ってから次の段階に進みます（例: ECSサービスを停止してからDBを停止）。
+失敗したリソースがある場合は以降の段階を実行しません。
+グループ名と --tag を省略した場合は、設定ファイルのグループ一覧から選択できます。
+
+例:
+  ` + AppName + ` group stop dev
+  ` + AppName + ` group stop dev --no-wait
+  ` + AppName + ` group stop --tag env=dev`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g, err := resolveGroup(cmd, args)
+		if err != nil {
+			return err
+		}
+		if groupDryRun {
+			group.PrintPlan(g, true)
+			return nil
+		}
+
+		printAwsContextWithInfo("Group", g.Name)
+		if err := group.Stop(cmd.Context(), groupClients(), g, groupOptions()); err != nil {
+			return fmt.Errorf("❌ グループの停止処理でエラー: %w", err)
+		}
+		common.Progressln("\n✅ グループの停止処理が完了しました")
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var groupStatusCmd = &cobra.Command{
+	Use:   "status [group]",
+	Short: "リソースグループの状態を表示するコマンド",
+	Long: `リソースグループの各リソースの状態を段階の順に表示します。
+
+例:
+  ` + AppName + ` group status dev
+  ` + AppName + ` group status --tag env=dev --output json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g, err := resolveGroup(cmd, args)
+		if err != nil {
+			return err
+		}
+		return group.ShowStatus(cmd.Context(), groupClients(), g)
+	},
+	SilenceUsage: true,
+}
+
+// resolveGroup は引数のグループ名または --tag からグループを解決する
+// どちらも指定されていない場合は設定ファイルのグループ一覧から選択させる
+func resolveGroup(cmd *cobra.Command, args []string) (group.Group, error) {
+	selector, err := tagSelector()
+	if err != nil {
+		return group.Group{}, err
+	}
+	if len(args) > 0 && len(selector) > 0 {
+		return group.Group{}, common.InvalidInputf("❌ エラー: グループ名と --tag は同時に指定できません")
+	}
+	if len(selector) > 0 {
+		common.Progressf("🏷️  タグ (%s) に一致するリソースを検索しています...\n", selector)
+		g, err := group.FromTags(cmd.Context(), groupClients(), selector)
+		if err != nil {
+			return g, fmt.Errorf("❌ エラー: %w", err)
+		}
+		return g, nil
+	}
+
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	} else {
+		name, err = selectGroupName()
+		if err != nil {
+			return group.Group{}, err
+		}
+	}
+	def, err := configFile.Group(name)
+	if err != nil {
+		return group.Group{}, common.NotFoundf("❌ エラー: %v", err)
+	}
+	g, err := group.Resolve(cmd.Context(), groupClients(), name, def)
+	if err != nil {
+		return g, fmt.Errorf("❌ エラー: %w", err)
+	}
+	return g, nil
+}
+
+// selectGroupName は設定ファイルに定義されたグループから1つを選択させる
+func selectGroupName() (string, error) {
+	names := configFile.GroupNames()
+	if len(names) == 0 {
+		return "", common.InvalidInputf("❌ エラー: グループ名または --tag を指定してください（設定ファイルの groups にグループが定義されていません）")
+	}
+	items := make([]picker.Item, len(names))
+	for i, name := range names {
+		items[i] = picker.Item{Label: name, Detail: fmt.Sprintf("%d段階", len(configFile.Groups[name].Tiers))}
+	}
+	name, err := picker.Select(items, &picker.Options{
+		Prompt: "リソースグループを選択してください",
+		Hint:   "グループ名または --tag",
+	})
+	if err != nil {
+		return "", err
+	}
+	common.Progressf("✅ 選択されたグループ: %s\n", name)
+	return name, nil
+}
+
+// groupClients はグループの操作に使うクライアントを返す
+func groupClients() group.ClientSet {
+	return group.ClientSet{
+		Ec2Client:         ec2.NewFromConfig(awsCfg),
+		RdsClient:         rds.NewFromConfig(awsCfg),
+		EcsClient:         ecs.NewFromConfig(awsCfg),
+		AutoScalingClient: applicationautoscaling.NewFromConfig(awsCfg),
+		CanaryClient:      synthetics.NewFromConfig(awsCfg),
+		EventBridgeClient: eventbridge.NewFromConfig(awsCfg),
+		SchedulerClient:   scheduler.NewFromConfig(awsCfg),
+		TagClient:         resourceGroupsTaggingClient(),
+	}
+}
+
+// groupOptions はフラグからグループの起動・停止のオプションを作成する
+func groupOptions() group.Options {
+	return group.Options{NoWait: groupNoWait, TimeoutSeconds: groupTimeout}
+}
+
+// completeGroupNames は group の引数に設定ファイルのグループ名を補完する
+func completeGroupNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	file, err := config.Load()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return file.GroupNames(), cobra.ShellCompDirectiveNoFileComp
+}
+
+func init() {
+	RootCmd.AddCommand(GroupCmd)
+	GroupCmd.AddCommand(groupStartCmd)
+	GroupCmd.AddCommand(groupStopCmd)
+	GroupCmd.AddCommand(groupStatusCmd)
+
+	addTagFlag(groupStartCmd, groupStopCmd, groupStatusCmd)
+	for _, c := range []*cobra.Command{groupStartCmd, groupStopCmd} {
+		c.Flags().BoolVarP(&groupDryRun, "dry-run", "d", false, "実行する順序を表示するのみ（実際には実行しない）")
+		c.Flags().BoolVar(&groupNoWait, "no-wait", false, "各段階の完了を待たずに次の段階に進む")
+		c.Flags().IntVarP(&groupTimeout, "timeout", "t", group.DefaultTimeoutSeconds, "1段階あたりの待機タイムアウト（秒）")
+	}
+	for _, c := range []*cobra.Command{groupStartCmd, groupStopCmd, groupStatusCmd} {
+		c.ValidArgsFunction = completeGroupNames
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"awstk/internal/config"
+	"awstk/internal/i18n"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var langFlag string
+
+// commandHelp はコマンド定義に書かれた日本語（原文）のヘルプ
+type commandHelp struct {
+	short string
+	long  string
+	flags map[*pflag.Flag]string
+}
+
+// sourceHelp は言語を切り替えても原文に戻せるよう、最初に翻訳する前のヘルプを保持する
+var sourceHelp = map[*cobra.Command]commandHelp{}
+
+// initLang は表示言語を --lang > AWSTK_LANG > LANG の順に決定し、コマンドのヘルプに反映する
+// ヘルプ表示は PersistentPreRunE より前に行われるため、cobra の解析前に引数から --lang を取り出す
+func initLang(args []string) error {
+	// ~/.config/awstk/locales/<言語>.yaml があれば組み込みのメッセージに追加する
+	if global, err := config.GlobalPath(); err == nil {
+		if err := i18n.LoadDir(filepath.Join(filepath.Dir(global), "locales")); err != nil {
+			return fmt.Errorf("❌ エラー: %w", err)
+		}
+	}
+
+	lang, err := i18n.Resolve(langArg(args))
+	if err != nil {
+		return fmt.Errorf("❌ エラー: %w", err)
+	}
+	SetLang(lang)
+	return nil
+}
+
+// SetLang は表示言語を切り替え、全コマンドのヘルプをその言語に置き換える
+func SetLang(lang i18n.Lang) {
+	i18n.SetLang(lang)
+	localizeCommand(RootCmd, "cmd")
+}
+
+// langArg は引数から --lang の値を取り出す
+func langArg(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--lang="); ok {
+			return value
+		}
+		if arg == "--lang" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// localizeCommand はコマンドとそのサブコマンドのヘルプを現在の言語に置き換える
+// メッセージキーはコマンドパスから cmd.<サブコマンド>.short / .long / .flag.<フラグ名> の形で決まり、
+// 現在の言語にキーがない場合はコマンド定義の原文を使う
+func localizeCommand(cmd *cobra.Command, key string) {
+	src, ok := sourceHelp[cmd]
+	if !ok {
+		src = commandHelp{short: cmd.Short, long: cmd.Long, flags: map[*pflag.Flag]string{}}
+		for _, fs := range []*pflag.FlagSet{cmd.LocalNonPersistentFlags(), cmd.PersistentFlags()} {
+			fs.VisitAll(func(f *pflag.Flag) { src.flags[f] = f.Usage })
+		}
+		sourceHelp[cmd] = src
+	}
+
+	cmd.Short = translate(key+".short", src.short)
+	cmd.Long = translate(key+".long", src.long)
+	for f, usage := range src.flags {
+		f.Usage = translate(key+".flag."+f.Name, usage)
+	}
+
+	for _, sub := range cmd.Commands() {
+		localizeCommand(sub, key+"."+sub.Name())
+	}
+}
+
+// translate は現在の言語のメッセージがあればそれを、なければ原文を返す
+func translate(key, source string) string {
+	if msg, ok := i18n.Lookup(key); ok {
+		return msg
+	}
+	return source
+}
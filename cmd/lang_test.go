@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"awstk/internal/i18n"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func TestLangArg(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "指定なし", args: []string{"s3", "ls"}, want: ""},
+		{name: "スペース区切り", args: []string{"--lang", "en", "s3", "ls"}, want: "en"},
+		{name: "イコール区切り", args: []string{"s3", "ls", "--lang=en"}, want: "en"},
+		{name: "--以降は無視", args: []string{"ecs", "run", "--", "--lang", "en"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := langArg(tt.args); got != tt.want {
+				t.Errorf("langArg() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestEnglishHelp は全コマンド・フラグの英語ヘルプがカタログに定義されていることを確認する
+func TestEnglishHelp(t *testing.T) {
+	SetLang(i18n.En)
+	t.Cleanup(func() { SetLang(i18n.Default) })
+
+	var walk func(cmd *cobra.Command, key string)
+	walk = func(cmd *cobra.Command, key string) {
+		if _, ok := i18n.Lookup(key + ".short"); !ok {
+			t.Errorf("%s: %s.short が未定義です", cmd.CommandPath(), key)
+		}
+		if _, ok := i18n.Lookup(key + ".long"); !ok && sourceHelp[cmd].long != "" {
+			t.Errorf("%s: %s.long が未定義です", cmd.CommandPath(), key)
+		}
+		for _, fs := range []*pflag.FlagSet{cmd.LocalNonPersistentFlags(), cmd.PersistentFlags()} {
+			fs.VisitAll(func(f *pflag.Flag) {
+				if _, ok := i18n.Lookup(key + ".flag." + f.Name); !ok {
+					t.Errorf("%s: %s.flag.%s が未定義です", cmd.CommandPath(), key, f.Name)
+				}
+			})
+		}
+		for _, sub := range cmd.Commands() {
+			walk(sub, key+"."+sub.Name())
+		}
+	}
+	walk(RootCmd, "cmd")
+}
+
+func TestSetLangRestoresSource(t *testing.T) {
+	SetLang(i18n.Ja)
+	ja := RootCmd.Short
+
+	SetLang(i18n.En)
+	if RootCmd.Short == ja {
+		t.Fatalf("英語に切り替えてもヘルプが変わりません: %q", RootCmd.Short)
+	}
+
+	SetLang(i18n.Ja)
+	if RootCmd.Short != ja {
+		t.Errorf("日本語に戻したヘルプ = %q, want %q", RootCmd.Short, ja)
+	}
+}
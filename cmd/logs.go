@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	logssvc "awstk/internal/service/logs"
+	"awstk/internal/service/tagging"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
@@ -35,14 +37,19 @@ var logsDeleteCmd = &cobra.Command{
 	Short: "CloudWatch Logsグループを削除するコマンド",
 	Long: `指定したCloudWatch Logsグループを削除します。
 ロググループ名の直接指定とフィルターパターンの両方に対応しています。
+--tag を指定するとタグの条件に一致するロググループに絞り込みます（ロググループ名・フィルターを省略した場合はすべてのロググループが対象）。
+いずれも指定しない場合は、ロググループ一覧から削除対象を選択できます（Tabで複数選択）。
 
 【使い方】
+  ` + AppName + ` logs delete                                 # 一覧から選択して削除
   ` + AppName + ` logs delete my-log-group                    # 単一のロググループを削除
   ` + AppName + ` logs delete log1 log2 log3                  # 複数のロググループを削除
   ` + AppName + ` logs delete --filter "/aws/lambda/*"        # パターンに一致するロググループを削除
   ` + AppName + ` logs delete --filter "test-*" prod-log      # フィルターと直接指定の組み合わせ
   ` + AppName + ` logs delete --filter "*" --empty-only       # 空のロググループをすべて削除
   ` + AppName + ` logs delete --filter "*" --no-retention     # 保存期間未設定のロググループを削除
+  ` + AppName + ` logs delete --filter "test-*" --dry-run     # 削除対象を確認するのみ
+  ` + AppName + ` logs delete --tag env=dev --empty-only       # タグ env=dev の空のロググループを削除
 
 【例】
   ` + AppName + ` logs delete /aws/lambda/my-function
@@ -55,11 +62,6 @@ var logsDeleteCmd = &cobra.Command{
 		emptyOnly, _ := cmdCobra.Flags().GetBool("empty-only")
 		noRetention, _ := cmdCobra.Flags().GetBool("no-retention")
 
-		// 引数もフィルターも指定されていない場合はエラー
-		if len(args) == 0 && filter == "" {
-			return fmt.Errorf("削除対象のロググループ名またはフィルターを指定してください")
-		}
-
 		opts := logssvc.DeleteOptions{
 			Filter:      filter,
 			LogGroups:   args,
@@ -67,7 +69,33 @@ var logsDeleteCmd = &cobra.Command{
 			NoRetention: noRetention,
 		}
 
-		return logssvc.DeleteLogGroups(logsClient, opts)
+		// タグのみ指定された場合は、すべてのロググループをタグで絞り込む
+		if len(tagExprs) > 0 && len(args) == 0 && filter == "" {
+			opts.Filter = "*"
+		}
+
+		// 引数もフィルターも指定されていない場合は一覧から選択
+		if len(args) == 0 && opts.Filter == "" {
+			selected, err := logssvc.SelectLogGroups(cmdCobra.Context(), logsClient, opts)
+			if err != nil {
+				return err
+			}
+			opts.LogGroups = selected
+		}
+
+		targets, err := logssvc.CollectTargetLogGroups(cmdCobra.Context(), logsClient, opts)
+		if err != nil {
+			return err
+		}
+		targets, err = filterByTag(cmdCobra.Context(), tagging.ResourceLogGroup, targets)
+		if err != nil {
+			return err
+		}
+		actions := logssvc.CleanupActions(targets)
+
+		plan := common.NewPlan(cmdCobra.CommandPath())
+		plan.Add(actions...)
+		return runPlan(cmdCobra, plan, false)
 	},
 	SilenceUsage: true,
 }
@@ -97,14 +125,28 @@ var logsLsCmd = &cobra.Command{
 		noRetention, _ := cmdCobra.Flags().GetBool("no-retention")
 		showDetails, _ := cmdCobra.Flags().GetBool("details")
 
+		regions, err := resolveTargetRegions(cmdCobra)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return logssvc.ListLogGroupsInRegions(cmdCobra.Context(), regions, func(r string) logssvc.API {
+				return cloudwatchlogs.NewFromConfig(regionConfig(r))
+			}, logssvc.ListOptions{
+				EmptyOnly:   emptyOnly,
+				NoRetention: noRetention,
+				ShowDetails: showDetails,
+			})
+		}
+
 		// ログループ一覧を取得
-		logGroups, err := logssvc.ListLogGroups(logsClient)
+		logGroups, err := logssvc.ListLogGroups(cmdCobra.Context(), logsClient)
 		if err != nil {
-			return common.FormatListError("CloudWatch Logsグループ", err)
+			return common.FormatListError(i18n.T("resource.log_group"), err)
 		}
 
-		if len(logGroups) == 0 {
-			fmt.Println(common.FormatEmptyMessage("CloudWatch Logsグループ"))
+		if len(logGroups) == 0 && !common.IsMachineReadable() {
+			fmt.Println(common.FormatEmptyMessage(i18n.T("resource.log_group")))
 			return nil
 		}
 
@@ -113,15 +155,20 @@ var logsLsCmd = &cobra.Command{
 		var conditions []string
 
 		if emptyOnly {
-			conditions = append(conditions, "空の")
+			conditions = append(conditions, i18n.T("condition.empty"))
 			filteredGroups = logssvc.FilterEmptyLogGroups(filteredGroups)
 		}
 		if noRetention {
-			conditions = append(conditions, "保存期間未設定の")
+			conditions = append(conditions, i18n.T("condition.no_retention"))
 			filteredGroups = logssvc.FilterNoRetentionLogGroups(filteredGroups)
 		}
 
-		title := common.GenerateFilteredTitle("CloudWatch Logsグループ", conditions...)
+		// 機械可読形式の場合はロググループ情報をそのまま出力
+		if common.IsMachineReadable() {
+			return common.RenderRecords(logssvc.ToLogGroupInfos(filteredGroups))
+		}
+
+		title := common.GenerateFilteredTitle(i18n.T("resource.log_group"), conditions...)
 
 		// 結果表示
 		if !showDetails {
@@ -133,20 +180,20 @@ var logsLsCmd = &cobra.Command{
 			common.PrintSimpleList(common.ListOutput{
 				Title:        title,
 				Items:        names,
-				ResourceName: "ログループ",
+				ResourceName: i18n.T("resource.log_group_short"),
 				ShowCount:    true,
 			})
 		} else {
 			// 詳細表示
 			fmt.Printf("%s:\n", title)
 			if len(filteredGroups) == 0 {
-				fmt.Println("該当するログループはありませんでした")
+				fmt.Println(common.FormatNoMatchMessage(i18n.T("resource.log_group_short")))
 				return nil
 			}
 			for _, group := range filteredGroups {
 				logssvc.DisplayLogGroupDetails(group)
 			}
-			fmt.Printf("\n合計: %d個のログループ\n", len(filteredGroups))
+			fmt.Printf("\n%s\n", common.FormatTotalMessage(len(filteredGroups), i18n.T("resource.log_group_short")))
 		}
 
 		return nil
@@ -163,9 +210,13 @@ func init() {
 	logsLsCmd.Flags().BoolP("empty-only", "e", false, "空のログループのみを表示")
 	logsLsCmd.Flags().BoolP("no-retention", "n", false, "保存期間が未設定のログのみを表示")
 	logsLsCmd.Flags().BoolP("details", "d", false, "詳細情報を表示")
+	addRegionsFlag(logsLsCmd)
 
 	// delete コマンドのフラグ
 	logsDeleteCmd.Flags().StringP("filter", "f", "", "削除対象のフィルターパターン（ワイルドカード対応）")
 	logsDeleteCmd.Flags().BoolP("empty-only", "e", false, "空のログループのみを削除")
 	logsDeleteCmd.Flags().BoolP("no-retention", "n", false, "保存期間が未設定のログのみを削除")
+	addTagFlag(logsDeleteCmd)
+	logsDeleteCmd.ValidArgsFunction = completeLogGroups
+	addPlanFlags(logsDeleteCmd)
 }
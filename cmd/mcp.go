@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/mcp"
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
+	ec2svc "awstk/internal/service/ec2"
+	ecssvc "awstk/internal/service/ecs"
+	logssvc "awstk/internal/service/logs"
+	rdssvc "awstk/internal/service/rds"
+	"awstk/internal/service/schedule"
+	secretsmgrSvc "awstk/internal/service/secretsmanager"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/spf13/cobra"
+)
+
+var mcpAllowWrite bool
+
+// McpCmd represents the mcp command
+var McpCmd = &cobra.Command{
+	Use:          "mcp",
+	Short:        "MCP (Model Context Protocol) サーバーコマンド",
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// MCPサーバーは1つのプロファイルで動作する
+		if isMultiAccount() {
+			return common.InvalidInputf("❌ エラー: mcp コマンドでは --profiles/--profiles-from は指定できません")
+		}
+		return RootCmd.PersistentPreRunE(cmd, args)
+	},
+}
+
+// mcpServeCmd represents the serve command
+var mcpServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "MCPサーバーを標準入出力で起動するコマンド",
+	Long: `Model Context Protocol (MCP) のサーバーを標準入出力で起動し、` + AppName + ` の操作をAIアシスタントなどのツールとして公開します。
+各ツールの引数のJSON Schemaは、対応するコマンドのオプションから生成されます。
+
+デフォルトでは参照系のツール（cfn_ls, cfn_drift_status, ecs_status, logs_ls, schedule_ls, ec2_ls, rds_ls）のみを公開します。
+--allow-write を指定すると変更系のツール（cfn_stop, logs_delete, secrets_delete）も公開しますが、
+これらは実行計画を作成するのみで、実際の変更は作成した計画の planId を apply_plan に渡したときに行われます。
+
+【使い方】
+  ` + AppName + ` mcp serve -P my-profile                  # 参照系のツールのみ公開
+  ` + AppName + ` mcp serve -P my-profile --allow-write    # 変更系のツールも公開
+
+【設定例】(.mcp.json)
+  {
+    "mcpServers": {
+      "awstk": { "command": "awstk", "args": ["mcp", "serve", "-P", "my-profile"] }
+    }
+  }`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		out := os.Stdout
+		// 標準出力はプロトコルのメッセージ専用にし、サービス層の表示は標準エラーに出す
+		os.Stdout = os.Stderr
+		defer func() { os.Stdout = out }()
+
+		common.Progressf("🔌 MCPサーバーを起動しました (Profile: %s, Region: %s, 変更系ツール: %t)\n", profile, region, mcpAllowWrite)
+		server := mcp.NewServer(AppName, Version, mcpTools(mcpAllowWrite)...)
+		return server.Serve(cmd.Context(), os.Stdin, out)
+	},
+	SilenceUsage: true,
+}
+
+// mcpCfnLsArgs は cfn_ls ツールの引数
+type mcpCfnLsArgs struct {
+	All bool `json:"all,omitempty" desc:"全てのステータスのスタックを対象にする（省略時はアクティブなスタックのみ）"`
+}
+
+// mcpCfnStopArgs は cfn_stop ツールの引数
+type mcpCfnStopArgs struct {
+	StackName string `json:"stackName" desc:"停止するリソースを含むCloudFormationスタック名"`
+}
+
+// mcpSecretsDeleteArgs は secrets_delete ツールの引数
+type mcpSecretsDeleteArgs struct {
+	SecretIds []string `json:"secretIds" desc:"即時削除するシークレットのIDまたは名前"`
+}
+
+// mcpApplyPlanArgs は apply_plan ツールの引数
+type mcpApplyPlanArgs struct {
+	PlanId string `json:"planId" desc:"変更系のツールが返した実行計画のID"`
+}
+
+// mcpTools はMCPサーバーで公開するツールを返す
+// allowWrite が false の場合は参照系のツールのみを返す
+func mcpTools(allowWrite bool) []mcp.Tool {
+	readOnly := mcp.Annotations{ReadOnlyHint: true}
+	cfnClient := cloudformation.NewFromConfig(awsCfg)
+	ecsClient := ecs.NewFromConfig(awsCfg)
+	logsClient := cloudwatchlogs.NewFromConfig(awsCfg)
+
+	tools := []mcp.Tool{
+		mcp.NewTool("cfn_ls", "CloudFormationスタックの一覧を取得します", readOnly,
+			func(ctx context.Context, args mcpCfnLsArgs) (any, error) {
+				return cfn.ListCfnStacks(ctx, cfnClient, args.All)
+			}),
+		mcp.NewTool("cfn_drift_status", "CloudFormationスタックのドリフト状態を取得します（ドリフト検出は実行しません）", readOnly,
+			func(ctx context.Context, opts cfn.DriftStatusOptions) (any, error) {
+				if err := ValidateStackSelection(opts.Stacks, opts.Filter != "" || opts.All); err != nil {
+					return nil, err
+				}
+				return cfn.GetDriftStatuses(ctx, cfnClient, opts)
+			}),
+		mcp.NewTool("ecs_status", "ECSサービスの状態（タスク数・タスク一覧・Auto Scaling設定）を取得します", readOnly,
+			func(ctx context.Context, opts ecssvc.ResolveOptions) (any, error) {
+				// 一覧からの選択はできないため、対象の指定を必須にする
+				if opts.StackName == "" && opts.ClusterName == "" && opts.ServiceName == "" {
+					return nil, common.InvalidInputf("❌ エラー: stackName または clusterName と serviceName を指定してください")
+				}
+				cluster, service, err := ecssvc.ResolveClusterAndService(ctx, ecsClient, cfnClient, opts)
+				if err != nil {
+					return nil, err
+				}
+				return ecssvc.GetServiceStatus(ctx, ecsClient, applicationautoscaling.NewFromConfig(awsCfg), ecssvc.StatusOptions{
+					ClusterName: cluster,
+					ServiceName: service,
+				})
+			}),
+		mcp.NewTool("logs_ls", "CloudWatch Logsグループの一覧をサイズ・作成日時・保存期間付きで取得します", readOnly,
+			func(ctx context.Context, opts logssvc.ListOptions) (any, error) {
+				return logssvc.GetLogGroupInfos(ctx, logsClient, opts)
+			}),
+		mcp.NewTool("schedule_ls", "EventBridge Rules / EventBridge Scheduler のスケジュール一覧を取得します", readOnly,
+			func(ctx context.Context, opts schedule.ListOptions) (any, error) {
+				if opts.Type == "" {
+					opts.Type = "all"
+				}
+				if !slices.Contains([]string{"all", "rule", "scheduler"}, opts.Type) {
+					return nil, common.InvalidInputf("❌ エラー: type には all, rule, scheduler のいずれかを指定してください: %s", opts.Type)
+				}
+				return schedule.ListSchedules(ctx, eventbridge.NewFromConfig(awsCfg), scheduler.NewFromConfig(awsCfg), opts)
+			}),
+		mcp.NewTool("ec2_ls", "EC2インスタンスの一覧を取得します", readOnly,
+			func(ctx context.Context, _ struct{}) (any, error) {
+				return ec2svc.GetAllEc2Instances(ctx, ec2.NewFromConfig(awsCfg))
+			}),
+		mcp.NewTool("rds_ls", "RDSインスタンスの一覧を取得します", readOnly,
+			func(ctx context.Context, _ struct{}) (any, error) {
+				return rdssvc.GetAllRdsInstances(ctx, rds.NewFromConfig(awsCfg))
+			}),
+	}
+	if !allowWrite {
+		return tools
+	}
+
+	// 変更系のツールは実行計画を作成するだけで、apply_plan を呼ぶまでリソースは変更しない
+	plans := &mcpPlanStore{plans: map[string]*common.Plan{}}
+	return append(tools,
+		mcp.NewTool("cfn_stop", "スタック内のEC2・RDS・Aurora・ECSサービスを停止する実行計画を作成します（実行は apply_plan）", readOnly,
+			func(ctx context.Context, args mcpCfnStopArgs) (any, error) {
+				if args.StackName == "" {
+					return nil, common.InvalidInputf("❌ エラー: stackName を指定してください")
+				}
+				actions, err := cfn.StopActions(ctx, cfnClient, args.StackName)
+				if err != nil {
+					return nil, err
+				}
+				return plans.propose(AppName+" cfn stop", actions)
+			}),
+		mcp.NewTool("logs_delete", "CloudWatch Logsグループを削除する実行計画を作成します（実行は apply_plan）", readOnly,
+			func(ctx context.Context, opts logssvc.DeleteOptions) (any, error) {
+				if opts.Filter == "" && len(opts.LogGroups) == 0 {
+					return nil, common.InvalidInputf("❌ エラー: filter または logGroups を指定してください")
+				}
+				actions, err := logssvc.DeleteActions(ctx, logsClient, opts)
+				if err != nil {
+					return nil, err
+				}
+				return plans.propose(AppName+" logs delete", actions)
+			}),
+		mcp.NewTool("secrets_delete", "Secrets Managerのシークレットを復旧期間なしで即時削除する実行計画を作成します（実行は apply_plan）", readOnly,
+			func(_ context.Context, args mcpSecretsDeleteArgs) (any, error) {
+				if len(args.SecretIds) == 0 {
+					return nil, common.InvalidInputf("❌ エラー: secretIds を指定してください")
+				}
+				return plans.propose(AppName+" secrets delete", secretsmgrSvc.DeleteActions(args.SecretIds))
+			}),
+		mcp.NewTool("apply_plan", "変更系のツールで作成した実行計画を実行します。各計画は1回だけ実行できます", mcp.Annotations{DestructiveHint: true},
+			func(ctx context.Context, args mcpApplyPlanArgs) (any, error) {
+				plan, ok := plans.take(args.PlanId)
+				if !ok {
+					return nil, common.NotFoundf("❌ 実行計画 %s が見つかりません（実行済みの計画は再実行できません）", args.PlanId)
+				}
+				if err := executePlan(ctx, plan); err != nil {
+					return nil, fmt.Errorf("❌ 実行計画の実行でエラー: %w", err)
+				}
+				return fmt.Sprintf("✅ 実行計画 %s（%d件のアクション）の実行が完了しました", args.PlanId, len(plan.Actions)), nil
+			}),
+	)
+}
+
+// mcpPlanStore は変更系のツールで作成した実行計画を apply_plan で実行されるまで保持する
+type mcpPlanStore struct {
+	mu    sync.Mutex
+	seq   int
+	plans map[string]*common.Plan
+}
+
+// mcpPlanResult は変更系のツールの結果
+type mcpPlanResult struct {
+	PlanId  string       `json:"planId"`
+	Message string       `json:"message"`
+	Plan    *common.Plan `json:"plan"`
+}
+
+// propose は実行計画を作成して保持し、内容と planId を返す
+func (s *mcpPlanStore) propose(command string, actions []common.Action) (any, error) {
+	plan := common.NewPlan(command)
+	plan.Profile = profile
+	plan.Region = region
+	plan.Add(actions...)
+	if plan.IsEmpty() {
+		return nil, &common.NotFoundError{Err: errors.New(i18n.T("plan.empty"))}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	id := fmt.Sprintf("plan-%d", s.seq)
+	s.plans[id] = plan
+	return mcpPlanResult{
+		PlanId:  id,
+		Message: fmt.Sprintf("実行計画を作成しました（まだ実行していません）。内容を確認し、実行する場合は apply_plan に planId %q を指定してください", id),
+		Plan:    plan,
+	}, nil
+}
+
+// take は実行計画を取り出す（同じ計画を2回実行しないよう、取り出した計画は削除する）
+func (s *mcpPlanStore) take(id string) (*common.Plan, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	plan, ok := s.plans[id]
+	delete(s.plans, id)
+	return plan, ok
+}
+
+func init() {
+	RootCmd.AddCommand(McpCmd)
+	McpCmd.AddCommand(mcpServeCmd)
+
+	mcpServeCmd.Flags().BoolVar(&mcpAllowWrite, "allow-write", false, "変更系のツール（実行計画の作成と apply_plan）も公開する")
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"awstk/internal/mcp"
+	"awstk/internal/service/common"
+)
+
+func TestMcpTools(t *testing.T) {
+	names := func(tools []mcp.Tool) []string {
+		var got []string
+		for _, tool := range tools {
+			got = append(got, tool.Name)
+		}
+		return got
+	}
+	writeTools := []string{"cfn_stop", "logs_delete", "secrets_delete", "apply_plan"}
+
+	t.Run("デフォルトは参照系のツールのみ", func(t *testing.T) {
+		for _, tool := range mcpTools(false) {
+			if !tool.Annotations.ReadOnlyHint || slices.Contains(writeTools, tool.Name) {
+				t.Errorf("参照系以外のツールが公開されています: %s", tool.Name)
+			}
+		}
+	})
+
+	t.Run("--allow-write で変更系のツールを公開", func(t *testing.T) {
+		got := names(mcpTools(true))
+		for _, name := range writeTools {
+			if !slices.Contains(got, name) {
+				t.Errorf("%s が公開されていません: %v", name, got)
+			}
+		}
+	})
+}
+
+func TestMcpPlanStore(t *testing.T) {
+	store := &mcpPlanStore{plans: map[string]*common.Plan{}}
+	actions := []common.Action{{ResourceType: common.ResourceLogGroup, ResourceId: "/aws/lambda/a", Operation: common.OperationDelete, Risk: common.RiskHigh}}
+
+	result, err := store.propose("awstk logs delete", actions)
+	if err != nil {
+		t.Fatalf("propose() error = %v", err)
+	}
+	id := result.(mcpPlanResult).PlanId
+
+	if plan, ok := store.take(id); !ok || len(plan.Actions) != 1 {
+		t.Fatalf("take(%s) = (%v, %v)", id, plan, ok)
+	}
+	if _, ok := store.take(id); ok {
+		t.Error("実行済みの計画を再度取り出せます")
+	}
+
+	var notFound *common.NotFoundError
+	if _, err := store.propose("awstk logs delete", nil); !errors.As(err, &notFound) {
+		t.Errorf("空の計画で propose() error = %v, want NotFoundError", err)
+	}
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"awstk/internal/aws"
+	"awstk/internal/i18n"
+	"awstk/internal/service/apply"
+	"awstk/internal/service/common"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+	"github.com/spf13/cobra"
+)
+
+var (
+	planDryRun  bool
+	planOutPath string
+)
+
+// addPlanFlags は破壊的コマンドに --dry-run / --plan-out フラグを追加する
+func addPlanFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&planDryRun, "dry-run", "d", false, "実行計画を表示するのみ（実際には実行しない）")
+	cmd.Flags().StringVar(&planOutPath, "plan-out", "", "実行計画をJSONファイルに保存する（実際には実行しない）")
+	_ = cmd.MarkFlagFilename("plan-out", "json")
+}
+
+// runPlan は組み立てた実行計画を表示し、--dry-run / --plan-out の指定がなければ実行する
+// confirm が true の場合は実行前に確認プロンプトを表示する
+func runPlan(cmd *cobra.Command, plan *common.Plan, confirm bool) error {
+	plan.Profile = profile
+	plan.Region = region
+
+	// 対象がない場合は終了コードで区別できるようエラーを返す（表形式の一覧はエラーメッセージと重複するため表示しない）
+	if plan.IsEmpty() && planOutPath == "" {
+		if common.IsMachineReadable() {
+			if err := plan.Render(); err != nil {
+				return err
+			}
+		}
+		return &common.NotFoundError{Err: errors.New(i18n.T("plan.empty"))}
+	}
+
+	if err := plan.Render(); err != nil {
+		return err
+	}
+
+	if planOutPath != "" {
+		if err := plan.Save(planOutPath); err != nil {
+			return fmt.Errorf("❌ 実行計画の保存に失敗: %w", err)
+		}
+		common.Progressf("💾 実行計画を %s に保存しました。'%s apply %s' で実行できます\n", planOutPath, AppName, planOutPath)
+		return nil
+	}
+	if planDryRun {
+		common.Progressln("🔍 ドライランのため実行しません")
+		return nil
+	}
+	if confirm && !common.ConfirmPlan(plan) {
+		return &common.UserAbortedError{}
+	}
+
+	return executePlan(cmd.Context(), plan)
+}
+
+// executePlan は現在のAWS設定で計画を実行する
+func executePlan(ctx context.Context, plan *common.Plan) error {
+	clients := apply.ClientSet{
+		S3Client:         s3.NewFromConfig(awsCfg, aws.S3PathStyle),
+		EcrClient:        ecr.NewFromConfig(awsCfg),
+		LogsClient:       cloudwatchlogs.NewFromConfig(awsCfg),
+		CfnClient:        cloudformation.NewFromConfig(awsCfg),
+		Ec2Client:        ec2.NewFromConfig(awsCfg),
+		RdsClient:        rds.NewFromConfig(awsCfg),
+		AasClient:        applicationautoscaling.NewFromConfig(awsCfg),
+		SecretsClient:    secretsmanager.NewFromConfig(awsCfg),
+		SyntheticsClient: synthetics.NewFromConfig(awsCfg),
+		Route53Client:    route53.NewFromConfig(awsCfg),
+		SsmClient:        ssm.NewFromConfig(awsCfg),
+	}
+	return apply.Execute(ctx, clients, plan)
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/plugin"
+	"awstk/internal/service/common"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+)
+
+// pluginAnnotation はプラグインとして登録したコマンドに付けるアノテーションのキー（値は実行ファイルのパス）
+const pluginAnnotation = "awstk-plugin"
+
+// pluginFlagNames はプラグイン実行時に awstk が解釈するフラグ（それ以外の引数はそのままプラグインに渡す）
+var pluginFlagNames = []string{"profile", "region", "stack", "output", "lang"}
+
+// reservedCommandNames は cobra が実行時に追加するため、プラグイン名として使えない名前
+var reservedCommandNames = []string{"help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd}
+
+// PluginCmd represents the plugin command
+var PluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "プラグイン管理コマンド",
+	Long: `PATH 上にある ` + plugin.Prefix + `<name> という実行ファイルを ` + AppName + ` <name> として呼び出せます（git・kubectl と同様）。
+組み込みコマンドと同じ名前のプラグインは使われず、同じ名前のプラグインが複数ある場合は PATH で先に見つかったものが使われます。
+
+プラグインには解決済みの設定が環境変数で渡されます。
+  AWSTK_PROFILE / AWSTK_REGION / AWSTK_STACK   -P・-R・-S、環境変数、コンテキストから解決した値
+  AWSTK_OUTPUT                                 出力形式 (--output)
+  AWSTK_CONTEXT                                アクティブなコンテキスト名
+  AWSTK_VERSION / AWSTK_BIN                    ` + AppName + ` のバージョンと実行ファイルのパス
+  AWS_PROFILE / AWS_REGION                     AWS CLI・SDK 向けに同じ値を設定
+
+` + AppName + ` の -P・-R・-S・--output・--lang は ` + AppName + ` が解釈し、それ以外の引数はそのままプラグインに渡します。
+-- 以降の引数は解釈せずにすべてプラグインに渡します。
+設定ファイルで plugins.<name>.context-stdin: true を指定すると、同じ内容をJSONで標準入力にも渡します。
+プラグインの終了コードはそのまま ` + AppName + ` の終了コードになります。
+
+【例】
+  ` + AppName + ` plugin ls
+  ` + AppName + ` hello -P dev --verbose       # awstk-hello --verbose を AWSTK_PROFILE=dev で実行`,
+}
+
+// pluginLsCmd represents the ls command
+var pluginLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "PATH 上のプラグイン一覧を表示するコマンド",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		plugins := plugin.Discover(os.Getenv("PATH"))
+		infos := make([]pluginInfo, len(plugins))
+		for i, p := range plugins {
+			infos[i] = pluginInfo{Name: p.Name, Path: p.Path, Enabled: !isBuiltinCommand(p.Name)}
+		}
+		return common.DisplayList(infos, common.GenerateFilteredTitle(i18n.T("resource.plugin")), pluginsToTableData, &common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.Tf("plugin.empty", plugin.Prefix),
+		})
+	},
+	SilenceUsage: true,
+}
+
+// pluginInfo は plugin ls で表示するプラグインの情報
+type pluginInfo struct {
+	Name    string
+	Path    string
+	Enabled bool // 組み込みコマンドと名前が重複していなければ true
+}
+
+// pluginsToTableData はプラグイン一覧をテーブルデータに変換する
+func pluginsToTableData(infos []pluginInfo) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.name")},
+		{Header: i18n.T("header.path")},
+		{Header: i18n.T("header.state")},
+	}
+	data := make([][]string, len(infos))
+	for i, info := range infos {
+		state := "有効"
+		if !info.Enabled {
+			state = "無効（組み込みコマンドと重複）"
+		}
+		data[i] = []string{info.Name, info.Path, state}
+	}
+	return columns, data
+}
+
+// isBuiltinCommand は組み込みコマンド（プラグイン以外）に同じ名前・別名のものがあるかを判定する
+func isBuiltinCommand(name string) bool {
+	if slices.Contains(reservedCommandNames, name) {
+		return true
+	}
+	for _, c := range RootCmd.Commands() {
+		if _, ok := c.Annotations[pluginAnnotation]; ok {
+			continue
+		}
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// registerPlugins は PATH 上のプラグインをサブコマンドとして登録する
+// 組み込みコマンドと同じ名前のプラグインは登録しない
+func registerPlugins(pathList string) {
+	for _, p := range plugin.Discover(pathList) {
+		if isBuiltinCommand(p.Name) {
+			continue
+		}
+		RootCmd.AddCommand(newPluginCmd(p))
+	}
+}
+
+// newPluginCmd はプラグインを実行するコマンドを生成する
+func newPluginCmd(p plugin.Plugin) *cobra.Command {
+	c := &cobra.Command{
+		Use:         p.Name,
+		Short:       fmt.Sprintf(i18n.T("plugin.short"), p.Path),
+		Annotations: map[string]string{pluginAnnotation: p.Path},
+		// --help なども含めてプラグインに渡すため、cobra ではフラグを解析しない
+		DisableFlagParsing: true,
+		// 認証はプラグイン側で行うため、ルートの PersistentPreRunE（プロファイル必須チェック・AWS設定の読み込み）は通さない
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPlugin(cmd, p, args)
+		},
+		SilenceUsage: true,
+	}
+	c.Flags().StringVarP(&stackName, "stack", "S", "", i18n.T("plugin.flag_stack"))
+	return c
+}
+
+// runPlugin は awstk の共通フラグと設定を解決し、プラグインを実行する
+func runPlugin(cmd *cobra.Command, p plugin.Plugin, args []string) error {
+	// DisableFlagParsing のときはルートの永続フラグがマージされないため、InheritedFlags で cmd.Flags() に取り込む
+	cmd.InheritedFlags()
+	args, err := plugin.ExtractFlags(cmd.Flags(), pluginFlagNames, args)
+	if err != nil {
+		return common.InvalidInputf("❌ エラー: %v", err)
+	}
+
+	format, err := common.ParseOutputFormat(outputFormat)
+	if err != nil {
+		return err
+	}
+	if err := loadConfigFile(); err != nil {
+		return err
+	}
+	executable, _ := os.Executable()
+	pctx := plugin.Context{
+		Profile:    profileSetting(cmd).Value,
+		Region:     regionSetting(cmd).Value,
+		Stack:      stackSetting(stackName).Value,
+		Output:     string(format),
+		Context:    activeContextName,
+		Version:    Version,
+		Executable: executable,
+	}
+
+	err = plugin.Run(cmd.Context(), p, args, pctx, configFile.Plugins[p.Name].ContextStdin)
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		// エラーの内容はプラグインが表示しているため、awstk 側では表示せず終了コードだけ引き継ぐ
+		cmd.SilenceErrors = true
+		return &common.ExternalExitError{Name: filepath.Base(p.Path), Code: exitErr.ExitCode()}
+	}
+	if err != nil {
+		return fmt.Errorf("❌ プラグイン %s の実行に失敗: %w", p.Name, err)
+	}
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(PluginCmd)
+	PluginCmd.AddCommand(pluginLsCmd)
+}
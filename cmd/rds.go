@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
 	rdssvc "awstk/internal/service/rds"
+	"awstk/internal/service/tagging"
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/spf13/cobra"
 )
 
@@ -38,23 +42,34 @@ var rdsStartCmd = &cobra.Command{
 	Short: "RDSインスタンスを起動するコマンド",
 	Long: `RDSインスタンスを起動します。
 CloudFormationスタック名を指定するか、インスタンス名を直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのインスタンスを起動します。
 
 例:
   ` + AppName + ` rds start -P my-profile -S my-stack
-  ` + AppName + ` rds start -P my-profile -i my-instance`,
+  ` + AppName + ` rds start -P my-profile -i my-instance
+  ` + AppName + ` rds start -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(tagExprs) > 0 {
+			return forEachTaggedFiltered(cmd, tagging.ResourceRdsInstance, excludeRdsClusterMembers, "RDSインスタンスの起動", nil, func(ctx context.Context, name string) error {
+				if err := rdssvc.StartRdsInstance(ctx, rdsClient, name); err != nil {
+					return err
+				}
+				common.Progressf("✅ RDSインスタンス (%s) の起動を開始しました\n", name)
+				return nil
+			})
+		}
 		instanceName, err := resolveRdsInstanceName(cmd)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("🚀 RDSインスタンス (%s) を起動します...\n", instanceName)
-		err = rdssvc.StartRdsInstance(rdsClient, instanceName)
+		common.Progressf("🚀 RDSインスタンス (%s) を起動します...\n", instanceName)
+		err = rdssvc.StartRdsInstance(cmd.Context(), rdsClient, instanceName)
 		if err != nil {
 			return fmt.Errorf("❌ RDSインスタンス起動エラー: %w", err)
 		}
 
-		fmt.Printf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceName)
+		common.Progressf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceName)
 		return nil
 	},
 	SilenceUsage: true,
@@ -65,23 +80,34 @@ var rdsStopCmd = &cobra.Command{
 	Short: "RDSインスタンスを停止するコマンド",
 	Long: `RDSインスタンスを停止します。
 CloudFormationスタック名を指定するか、インスタンス名を直接指定することができます。
+--tag を指定すると、タグの条件に一致するすべてのインスタンスを停止します。
 
 例:
   ` + AppName + ` rds stop -P my-profile -S my-stack
-  ` + AppName + ` rds stop -P my-profile -i my-instance`,
+  ` + AppName + ` rds stop -P my-profile -i my-instance
+  ` + AppName + ` rds stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(tagExprs) > 0 {
+			return forEachTaggedFiltered(cmd, tagging.ResourceRdsInstance, excludeRdsClusterMembers, "RDSインスタンスの停止", nil, func(ctx context.Context, name string) error {
+				if err := rdssvc.StopRdsInstance(ctx, rdsClient, name); err != nil {
+					return err
+				}
+				common.Progressf("✅ RDSインスタンス (%s) の停止を開始しました\n", name)
+				return nil
+			})
+		}
 		instanceName, err := resolveRdsInstanceName(cmd)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("🚀 RDSインスタンス (%s) を停止します...\n", instanceName)
-		err = rdssvc.StopRdsInstance(rdsClient, instanceName)
+		common.Progressf("🚀 RDSインスタンス (%s) を停止します...\n", instanceName)
+		err = rdssvc.StopRdsInstance(cmd.Context(), rdsClient, instanceName)
 		if err != nil {
 			return fmt.Errorf("❌ RDSインスタンス停止エラー: %w", err)
 		}
 
-		fmt.Printf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceName)
+		common.Progressf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceName)
 		return nil
 	},
 	SilenceUsage: true,
@@ -90,22 +116,49 @@ CloudFormationスタック名を指定するか、インスタンス名を直接
 var rdsLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "RDSインスタンス一覧を表示するコマンド",
-	Long:  `RDSインスタンス一覧を表示します。`,
+	Long: `RDSインスタンス一覧を表示します。
+-S でスタック、--tag でタグの条件に一致するインスタンスに絞り込めます。
+
+例:
+  ` + AppName + ` rds ls --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		resolveStackName()
-		return rdssvc.ListRdsInstances(rdsClient, cfnClient, stackName)
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		opts := rdssvc.ListOptions{StackName: stackName, Tags: tags}
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return rdssvc.ListRdsInstancesInRegions(cmd.Context(), regions, func(r string) rdssvc.ClientSet {
+				cfg := regionConfig(r)
+				return rdssvc.ClientSet{
+					RdsClient: rds.NewFromConfig(cfg),
+					CfnClient: cloudformation.NewFromConfig(cfg),
+					TagClient: resourcegroupstaggingapi.NewFromConfig(cfg),
+				}
+			}, opts)
+		}
+		return rdssvc.ListRdsInstances(cmd.Context(), rdssvc.ClientSet{
+			RdsClient: rdsClient,
+			CfnClient: cfnClient,
+			TagClient: resourceGroupsTaggingClient(),
+		}, opts)
 	},
 	SilenceUsage: true,
 }
 
 // resolveRdsInstanceName はRDSインスタンス名を解決する
 func resolveRdsInstanceName(cmd *cobra.Command) (string, error) {
-	resolveStackName()
 	instanceName, _ := cmd.Flags().GetString("instance")
+	resolveStackNameUnless(instanceName != "")
 
 	// スタック名が指定されている場合
 	if stackName != "" {
-		return getRdsInstanceFromStack(stackName)
+		return getRdsInstanceFromStack(cmd.Context(), stackName)
 	}
 
 	// インスタンス名が直接指定されている場合
@@ -114,19 +167,32 @@ func resolveRdsInstanceName(cmd *cobra.Command) (string, error) {
 	}
 
 	// どちらも指定されていない場合
-	return "", fmt.Errorf("❌ エラー: RDSインスタンス名 (-i) またはスタック名 (-S) を指定してください")
+	return "", common.InvalidInputf("❌ エラー: RDSインスタンス名 (-i) またはスタック名 (-S) を指定してください")
 }
 
 // getRdsInstanceFromStack はCloudFormationスタックからRDSインスタンス名を取得する
-func getRdsInstanceFromStack(stackName string) (string, error) {
-	instanceName, err := cfn.GetRdsFromStack(cfnClient, stackName)
+func getRdsInstanceFromStack(ctx context.Context, stackName string) (string, error) {
+	instanceName, err := cfn.GetRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
 	}
-	fmt.Printf("✅ CloudFormationスタック '%s' からRDSインスタンス '%s' を検出しました\n", stackName, instanceName)
+	common.Progressf("✅ CloudFormationスタック '%s' からRDSインスタンス '%s' を検出しました\n", stackName, instanceName)
 	return instanceName, nil
 }
 
+// excludeRdsClusterMembers は --tag に一致したインスタンスからAuroraクラスターのメンバーを除外する
+// クラスターのメンバーはインスタンス単位で起動・停止できないため、aurora start/stop で操作する
+func excludeRdsClusterMembers(ctx context.Context, names []string) ([]string, error) {
+	ids, err := rdssvc.ExcludeClusterMembers(ctx, rdsClient, names)
+	if err != nil {
+		return nil, err
+	}
+	if excluded := len(names) - len(ids); excluded > 0 {
+		common.Progressf("ℹ️  Auroraクラスターのメンバーの%d件は対象外です（aurora start/stop で操作してください）\n", excluded)
+	}
+	return ids, nil
+}
+
 func init() {
 	RootCmd.AddCommand(RdsCmd)
 	RdsCmd.AddCommand(rdsStartCmd)
@@ -136,7 +202,14 @@ func init() {
 	// 共通フラグをRdsCmd（親コマンド）に定義
 	RdsCmd.PersistentFlags().StringVarP(&rdsInstanceId, "instance", "i", "", "RDSインスタンス名")
 	RdsCmd.PersistentFlags().StringVarP(&stackName, "stack", "S", "", "CloudFormationスタック名")
+	_ = RdsCmd.RegisterFlagCompletionFunc("instance", completeRdsInstances)
+	registerStackCompletion(RdsCmd)
 	// stack と instance は同時指定不可（どちらか片方使用）
 	rdsStartCmd.MarkFlagsMutuallyExclusive("stack", "instance")
 	rdsStopCmd.MarkFlagsMutuallyExclusive("stack", "instance")
+	addTagFlag(rdsStartCmd, rdsStopCmd, rdsLsCmd)
+	// tag は stack・instance と同時指定不可
+	rdsStartCmd.MarkFlagsMutuallyExclusive("stack", "instance", "tag")
+	rdsStopCmd.MarkFlagsMutuallyExclusive("stack", "instance", "tag")
+	addRegionsFlag(rdsLsCmd)
 }
@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	regionSvc "awstk/internal/service/region"
+	"context"
 	"fmt"
+	"sort"
+	"strings"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/spf13/cobra"
@@ -37,24 +43,32 @@ var regionLsCmd = &cobra.Command{
   ` + AppName + ` region ls
   ` + AppName + ` region ls --all`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return listRegions(showAllRegions)
+		return listRegions(cmd.Context(), showAllRegions)
 	},
 	SilenceUsage: true,
 }
 
-func listRegions(showAllRegions bool) error {
+func listRegions(ctx context.Context, showAllRegions bool) error {
 	ec2Client := ec2.NewFromConfig(awsCfg)
 
-	regions, err := regionSvc.ListRegions(ec2Client, showAllRegions)
+	regions, err := regionSvc.ListRegions(ctx, ec2Client, showAllRegions)
 	if err != nil {
-		return common.FormatListError("リージョン", err)
+		return common.FormatListError(i18n.T("resource.region"), err)
+	}
+
+	// 機械可読形式の場合はリージョン情報をそのまま出力
+	if common.IsMachineReadable() {
+		if !showAllRegions {
+			regions, _ = regionSvc.GroupRegions(regions)
+		}
+		return common.RenderRecords(regions)
 	}
 
 	if showAllRegions {
 		// 有効なリージョンと無効なリージョンを分けて表示
 		available, disabled := regionSvc.GroupRegions(regions)
 
-		fmt.Printf("AWSリージョン一覧: (全%d件)\n\n", len(regions))
+		fmt.Printf("%s\n\n", i18n.Tf("list.count_title", common.GenerateFilteredTitle(i18n.T("resource.region")), len(regions)))
 
 		if len(available) > 0 {
 			availableNames := make([]string, len(available))
@@ -62,9 +76,9 @@ func listRegions(showAllRegions bool) error {
 				availableNames[i] = fmt.Sprintf("%s (%s)", region.RegionName, region.OptInStatus)
 			}
 			common.PrintNumberedList(common.ListOutput{
-				Title:        fmt.Sprintf("✅ 有効なリージョン (%d件)", len(available)),
+				Title:        common.SuccessIcon + " " + common.GenerateFilteredTitle(i18n.T("resource.region"), i18n.T("condition.enabled")),
 				Items:        availableNames,
-				ResourceName: "リージョン",
+				ResourceName: i18n.T("resource.region"),
 			})
 		}
 
@@ -75,9 +89,9 @@ func listRegions(showAllRegions bool) error {
 				disabledNames[i] = fmt.Sprintf("%s (%s)", region.RegionName, region.OptInStatus)
 			}
 			common.PrintNumberedList(common.ListOutput{
-				Title:        fmt.Sprintf("❌ 無効なリージョン (%d件)", len(disabled)),
+				Title:        common.ErrorIcon + " " + common.GenerateFilteredTitle(i18n.T("resource.region"), i18n.T("condition.disabled")),
 				Items:        disabledNames,
-				ResourceName: "リージョン",
+				ResourceName: i18n.T("resource.region"),
 			})
 		}
 	} else {
@@ -88,15 +102,69 @@ func listRegions(showAllRegions bool) error {
 			names[i] = region.RegionName
 		}
 		common.PrintNumberedList(common.ListOutput{
-			Title:        "利用可能なリージョン一覧",
+			Title:        common.GenerateFilteredTitle(i18n.T("resource.region"), i18n.T("condition.available")),
 			Items:        names,
-			ResourceName: "リージョン",
+			ResourceName: i18n.T("resource.region"),
 		})
 	}
 
 	return nil
 }
 
+// addRegionsFlag は一覧系コマンドに複数リージョン指定用の --regions フラグを追加する
+func addRegionsFlag(cmd *cobra.Command) {
+	cmd.Flags().String("regions", "", "複数リージョンを並列で取得 (all: 有効な全リージョン, またはカンマ区切りで指定)")
+}
+
+// resolveTargetRegions は --regions フラグから対象リージョン一覧を決定する
+// フラグが指定されていない場合は nil を返す（-R の単一リージョンで実行する）
+func resolveTargetRegions(cmd *cobra.Command) ([]string, error) {
+	value, _ := cmd.Flags().GetString("regions")
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	var regions []string
+	if value == "all" {
+		enabled, err := regionSvc.ListRegions(cmd.Context(), ec2.NewFromConfig(awsCfg), false)
+		if err != nil {
+			return nil, common.FormatListError(i18n.T("resource.region"), err)
+		}
+		available, _ := regionSvc.GroupRegions(enabled)
+		for _, r := range available {
+			regions = append(regions, r.RegionName)
+		}
+		sort.Strings(regions)
+	} else {
+		seen := make(map[string]struct{})
+		for _, r := range strings.Split(value, ",") {
+			r = strings.TrimSpace(r)
+			if r == "" {
+				continue
+			}
+			if _, ok := seen[r]; ok {
+				continue
+			}
+			seen[r] = struct{}{}
+			regions = append(regions, r)
+		}
+	}
+
+	if len(regions) == 0 {
+		return nil, fmt.Errorf("❌ エラー: --regions に有効なリージョンが指定されていません")
+	}
+	common.Progressf("🌏 %d個のリージョンを並列で取得します: %s\n", len(regions), strings.Join(regions, ", "))
+	return regions, nil
+}
+
+// regionConfig は指定したリージョン向けにAWS設定をコピーして返す
+func regionConfig(region string) awsconfig.Config {
+	cfg := awsCfg.Copy()
+	cfg.Region = region
+	return cfg
+}
+
 func init() {
 	RootCmd.AddCommand(RegionCmd)
 	RegionCmd.AddCommand(regionLsCmd)
@@ -108,7 +176,7 @@ func init() {
 	RegionCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		// エイリアスで呼ばれた場合、lsコマンドのロジックを実行
 		if cmd.CalledAs() == regionLsAlias {
-			return listRegions(showAllRegions)
+			return listRegions(cmd.Context(), showAllRegions)
 		}
 		// 'region' コマンドが直接呼ばれた場合はヘルプを表示
 		return cmd.Help()
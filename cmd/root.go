@@ -2,9 +2,14 @@ package cmd
 
 import (
 	"awstk/internal/aws"
-	"errors"
+	"awstk/internal/config"
+	"awstk/internal/service/common"
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -14,10 +19,16 @@ import (
 
 const AppName = "awstk"
 
+// DefaultRegion はフラグ・環境変数・コンテキストのいずれでも指定されていない場合のリージョン
+const DefaultRegion = "ap-northeast-1"
+
 var region string
+var endpointURL string
 var profile string
 var awsCfg awsconfig.Config
 var stackName string
+var outputFormat string
+var concurrency int
 var cfnClient *cloudformation.Client
 var rdsClient *rds.Client
 
@@ -33,15 +44,107 @@ S3、ECR、ECS、CloudFormation などの各種 AWS サービスに対して、
 使用例:
   awstk cleanup all -k "test"    # "test"を含むS3/ECRを一括削除
   awstk s3 gunzip my-bucket/logs # S3の.gzファイルを一括ダウンロード&解凍
-  awstk ecs exec -s my-service   # Fargateコンテナへシェル接続`,
+  awstk ecs exec -s my-service   # Fargateコンテナへシェル接続
+  awstk ec2 ls --output json     # 一覧をJSON形式で出力
+  awstk context use dev          # .awstk.yaml のコンテキストを切り替え
+  awstk iam role ls --profiles 'prod-*' # 複数アカウントで並列実行
+
+シェル補完:
+  awstk completion bash|zsh|fish|powershell で補完スクリプトを出力します。
+  -S のスタック名や -c/-s のECSクラスター・サービス名なども補完され、取得した一覧は
+  プロファイル・リージョンごとに2分間キャッシュされます（AWSTK_COMPLETION_CACHE_TTL で変更、0 で無効）。
+
+プラグイン:
+  PATH 上の awstk-<name> という実行ファイルは awstk <name> で呼び出せます（awstk plugin ls で一覧表示）。
+
+LocalStack などの利用:
+  --endpoint-url（または AWSTK_ENDPOINT_URL、コンテキストの endpoint-url）でエンドポイントを変更できます。
+  サービスごとの指定は AWSTK_ENDPOINT_<SERVICE>（例: AWSTK_ENDPOINT_S3）かコンテキストの endpoints で行います。
+  AWS_ACCESS_KEY_ID / AWS_SECRET_ACCESS_KEY が設定されていればプロファイルの指定は不要です。
+
+出力レベル:
+  -q/--quiet     結果・警告・エラーのみ表示します（進捗メッセージを表示しないため cron などに便利です）
+  -v/--verbose   AWS APIの呼び出しとレイテンシを標準エラー出力に表示します
+  --debug        さらにAWS SDKのリクエスト・リトライ・レスポンス（ボディを除く）のログと、終了時にAPIごとの呼び出し回数・レイテンシを表示します
+
+終了コード:
+  0    成功
+  1    分類できないエラー
+  2    フラグ・引数の指定が不正
+  3    対象のリソースが存在しない（削除対象なしを含む）
+  4    権限不足
+  5    一括処理の一部の対象で失敗
+  6    待機のタイムアウト
+  130  確認プロンプトでの中止・Ctrl-Cによる中断`,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := RootCmd.Execute()
+	// SIGINT/SIGTERMでキャンセルされるコンテキストを各コマンドに渡す
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// 1回目のシグナルで処理を中断し、2回目以降は通常どおりプロセスを終了させる
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	if err := initLang(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(common.ExitInvalidInput)
+	}
+	registerPlugins(os.Getenv("PATH"))
+	wrapInputErrors(RootCmd)
+
+	err := RootCmd.ExecuteContext(ctx)
+	printAPISummary()
 	if err != nil {
-		os.Exit(1)
+		code := common.ExitCode(cobraInputError(err))
+		if ctx.Err() != nil {
+			fmt.Fprintln(os.Stderr, "⚠️  処理を中断しました")
+			code = common.ExitUserAborted
+		}
+		stop()
+		os.Exit(code)
+	}
+}
+
+// cobraInputErrorPrefixes は cobra がコマンド・フラグの指定誤りで返すエラーメッセージの接頭辞
+var cobraInputErrorPrefixes = []string{
+	"unknown command ",
+	"required flag(s) ",
+	"if any flags in the group ",
+	"at least one of the flags in the group ",
+}
+
+// cobraInputError は cobra が文字列で返す入力エラーを InvalidInputError に変換する
+func cobraInputError(err error) error {
+	for _, prefix := range cobraInputErrorPrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return &common.InvalidInputError{Err: err}
+		}
+	}
+	return err
+}
+
+// wrapInputErrors はフラグ・引数の解析エラーを InvalidInputError として返すようにする
+// cobra が返すエラーは型で区別できないため、各コマンドの Args 検証とフラグのエラー処理を差し替える
+func wrapInputErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return &common.InvalidInputError{Err: err}
+	})
+	if args := cmd.Args; args != nil {
+		cmd.Args = func(c *cobra.Command, a []string) error {
+			if err := args(c, a); err != nil {
+				return &common.InvalidInputError{Err: err}
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		wrapInputErrors(sub)
 	}
 }
 
@@ -49,32 +152,47 @@ func Execute() {
 func isAuthNotRequired(cmd *cobra.Command) bool {
 	// 認証が不要なコマンド
 	if cmd.Name() == "help" ||
-		cmd.Name() == "version" {
+		cmd.Name() == "version" ||
+		cmd.Name() == cobra.ShellCompRequestCmd ||
+		cmd.Name() == cobra.ShellCompNoDescRequestCmd {
 		return true
 	}
 	// 認証不要なコマンドのサブコマンド
 	if cmd.Parent() != nil &&
-		cmd.Parent().Name() == "env" {
+		(cmd.Parent().Name() == "env" || cmd.Parent().Name() == "context" || cmd.Parent().Name() == "audit" || cmd.Parent().Name() == "completion" || cmd.Parent().Name() == "plugin") {
 		return true
 	}
 	return false
 }
 
-// checkProfile はプロファイルの確認のみを行うプライベート関数
+// checkProfile はプロファイルを フラグ > 環境変数 > コンテキスト の順に解決し、グローバル変数 profile にセットする
 func checkProfile(cmd *cobra.Command) error {
-	// プロファイルがすでに指定されている場合は案内を出して終了
-	if profile != "" {
-		cmd.Println("🔍 -Pオプションで指定されたプロファイル '" + profile + "' を使用します")
-		return nil
+	setting := profileSetting(cmd)
+	// 使用するプロファイルの表示は進捗メッセージと同様に --quiet で抑制する
+	notice := func(message string) {
+		if common.CurrentLogLevel() >= common.LogLevelNormal {
+			cmd.Println(message)
+		}
 	}
-	// 環境変数からプロファイル取得を試みる
-	envProfile := os.Getenv("AWS_PROFILE")
-	if envProfile == "" {
+	switch setting.Source {
+	case config.SourceFlag:
+		notice("🔍 -Pオプションで指定されたプロファイル '" + setting.Value + "' を使用します")
+	case config.SourceEnv:
+		notice("🔍 環境変数 AWS_PROFILE の値 '" + setting.Value + "' を使用します")
+	case config.SourceContext:
+		notice("🔍 コンテキスト '" + setting.Origin + "' のプロファイル '" + setting.Value + "' を使用します")
+	default:
+		// LocalStack などでアクセスキーを環境変数で渡している場合はプロファイルなしで実行する
+		if aws.HasStaticCredentials() {
+			notice("🔍 環境変数のアクセスキー (AWS_ACCESS_KEY_ID) を使用します")
+			profile = ""
+			return nil
+		}
 		// プロファイルが見つからない場合はエラー
 		cmd.SilenceUsage = true // エラー時のUsage表示を抑制
-		return errors.New("❌ エラー: プロファイルが指定されていません。-Pオプションまたは AWS_PROFILE 環境変数を指定してください")
+		return common.InvalidInputf("❌ エラー: プロファイルが指定されていません。-Pオプション、AWS_PROFILE 環境変数、またはコンテキストの profile を指定してください")
 	}
-	cmd.Println("🔍 環境変数 AWS_PROFILE の値 '" + envProfile + "' を使用します")
+	profile = setting.Value
 	return nil
 }
 
@@ -83,31 +201,89 @@ func init() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	RootCmd.PersistentFlags().StringVarP(&region, "region", "R", "ap-northeast-1", "AWSリージョン")
+	RootCmd.PersistentFlags().StringVarP(&region, "region", "R", "", "AWSリージョン (デフォルト: "+DefaultRegion+")")
 	RootCmd.PersistentFlags().StringVarP(&profile, "profile", "P", "", "AWSプロファイル")
+	RootCmd.PersistentFlags().StringVar(&endpointURL, "endpoint-url", "", "AWS APIのエンドポイントURL (LocalStack など)")
+	RootCmd.PersistentFlags().StringVar(&profilesFlag, "profiles", "", "複数プロファイルで並列実行 (カンマ区切り、prod-* のようなグロブも可)")
+	RootCmd.PersistentFlags().StringVar(&profilesFromFlag, "profiles-from", "", "並列実行するプロファイル名を1行ずつ記載したファイル")
+	RootCmd.PersistentFlags().StringVar(&outputFormat, "output", string(common.OutputFormatTable), "出力形式 (table|json|yaml|csv|tsv)")
+	RootCmd.PersistentFlags().IntVar(&concurrency, "concurrency", 0, "並列処理の最大同時実行数 (0はコマンドごとの既定値)")
+	RootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "進捗メッセージを表示しない（結果・警告・エラーのみ表示）")
+	RootCmd.PersistentFlags().BoolVarP(&verboseFlag, "verbose", "v", false, "AWS APIの呼び出しとレイテンシも表示する")
+	RootCmd.PersistentFlags().BoolVar(&debugFlag, "debug", false, "AWS SDKのリクエスト・レスポンスのログと、終了時にAPI呼び出しの集計を表示する")
+	RootCmd.PersistentFlags().StringVar(&langFlag, "lang", "", "表示言語 (ja|en)。未指定の場合は AWSTK_LANG, LANG の順に判定")
 
-	// コマンド実行前に共通でプロファイルチェックとawsCtx設定を行う
+	// コマンド実行前に共通で出力形式・プロファイルチェックとawsCtx設定を行う
 	RootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// 出力レベルを設定（以降のメッセージから有効にする）
+		level, err := resolveLogLevel()
+		if err != nil {
+			return err
+		}
+		common.SetLogLevel(level)
+
+		// 出力形式を設定（認証不要なコマンドでも有効にする）
+		format, err := common.ParseOutputFormat(outputFormat)
+		if err != nil {
+			return err
+		}
+		common.SetOutputFormat(format)
+
+		if concurrency < 0 {
+			return common.InvalidInputf("❌ エラー: --concurrency には0以上の値を指定してください")
+		}
+		common.SetConcurrency(concurrency)
+
+		// 設定ファイルを読み込み、リージョンを フラグ > 環境変数 > コンテキスト > デフォルト の順に解決
+		if err := loadConfigFile(); err != nil {
+			return err
+		}
+		region = regionSetting(cmd).Value
+		endpointURL = endpointSetting(cmd).Value
+
 		// 認証が不要なコマンドはスキップ
 		if isAuthNotRequired(cmd) {
 			return nil
 		}
 
+		// 複数アカウント実行モードでは、サブコマンドの代わりにプロファイルごとの並列実行を行う
+		if isMultiAccount() {
+			if cmd.Flags().Changed("profile") {
+				cmd.SilenceUsage = true
+				return common.InvalidInputf("❌ エラー: --profiles/--profiles-from と -P は同時に指定できません")
+			}
+			if f := cmd.Flags().Lookup("plan-out"); f != nil && f.Changed {
+				cmd.SilenceUsage = true
+				return common.InvalidInputf("❌ エラー: --profiles/--profiles-from と --plan-out は同時に指定できません")
+			}
+			cmd.Run = nil
+			cmd.RunE = func(cmd *cobra.Command, args []string) error {
+				return runMultiAccount(cmd)
+			}
+			return nil
+		}
+
 		// プロファイルチェック
-		err := checkProfile(cmd)
+		err = checkProfile(cmd)
 		if err != nil {
 			return err
 		}
 
 		// awsCtxを設定
-		awsCtx := aws.Context{Region: region, Profile: profile}
+		awsCtx := awsContext(profile, region, endpointURL)
 
 		// AWS設定を読み込み
-		awsCfg, err = aws.LoadAwsConfig(awsCtx)
+		awsCfg, err = aws.LoadAwsConfig(cmd.Context(), awsCtx)
 		if err != nil {
 			return fmt.Errorf("aws設定の読み込みエラー: %w", err)
 		}
 
+		// 変更系API呼び出しを監査ログに記録する
+		attachAuditRecorder()
+
+		// -v / --debug の場合はAPI呼び出しを計測する
+		attachTracer()
+
 		return nil
 	}
 }
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+
+	"awstk/internal/service/common"
+
+	"github.com/spf13/cobra"
+)
+
+func TestWrapInputErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "正常", args: []string{"sub", "a"}, want: common.ExitOK},
+		{name: "未知のコマンド", args: []string{"unknown"}, want: common.ExitInvalidInput},
+		{name: "未知のフラグ", args: []string{"sub", "a", "--bogus"}, want: common.ExitInvalidInput},
+		{name: "引数の数が不正", args: []string{"sub"}, want: common.ExitInvalidInput},
+		{name: "必須フラグの指定漏れ", args: []string{"req"}, want: common.ExitInvalidInput},
+		{name: "コマンドが返したエラー", args: []string{"fail"}, want: common.ExitNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := &cobra.Command{Use: "root", SilenceErrors: true, SilenceUsage: true}
+			root.AddCommand(
+				&cobra.Command{Use: "sub", Args: cobra.ExactArgs(1), Run: func(*cobra.Command, []string) {}},
+				&cobra.Command{Use: "fail", RunE: func(*cobra.Command, []string) error {
+					return common.NotFoundf("対象がありません")
+				}},
+			)
+			req := &cobra.Command{Use: "req", Run: func(*cobra.Command, []string) {}}
+			req.Flags().String("name", "", "")
+			_ = req.MarkFlagRequired("name")
+			root.AddCommand(req)
+			wrapInputErrors(root)
+
+			root.SetArgs(tt.args)
+			err := root.Execute()
+			if err != nil {
+				err = cobraInputError(err)
+			}
+			if got := common.ExitCode(err); got != tt.want {
+				t.Errorf("ExitCode() = %d, want %d (error = %v)", got, tt.want, err)
+			}
+		})
+	}
+}
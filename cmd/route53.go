@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/spf13/cobra"
 
+	"awstk/internal/service/common"
 	route53Service "awstk/internal/service/route53"
 )
 
@@ -36,7 +37,7 @@ var route53LsCmd = &cobra.Command{
 	Short: "ホストゾーン一覧を表示",
 	Long:  `アカウント内のすべてのRoute53ホストゾーンを一覧表示します。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return route53Service.ListHostedZones(route53Client)
+		return route53Service.ListHostedZones(cmd.Context(), route53Client)
 	},
 }
 
@@ -53,20 +54,21 @@ var route53DeleteCmd = &cobra.Command{
 
 【使用例】
   ` + AppName + ` route53 delete example.com
-  ` + AppName + ` route53 delete --id Z1234567890ABC`,
+  ` + AppName + ` route53 delete --id Z1234567890ABC
+  ` + AppName + ` route53 delete example.com --dry-run
+  ` + AppName + ` route53 delete example.com --plan-out plan.json`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		identifier := args[0]
 		force, _ := cmd.Flags().GetBool("force")
-		dryRun, _ := cmd.Flags().GetBool("dry-run")
 
-		opts := route53Service.DeleteOptions{
-			UseId:  useId,
-			Force:  force,
-			DryRun: dryRun,
+		actions, err := route53Service.DeleteActions(cmd.Context(), route53Client, identifier, route53Service.DeleteOptions{UseId: useId})
+		if err != nil {
+			return err
 		}
-
-		return route53Service.DeleteHostedZone(route53Client, identifier, opts)
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		return runPlan(cmd, plan, !force)
 	},
 }
 
@@ -78,5 +80,5 @@ func init() {
 	// delete command flags
 	route53DeleteCmd.Flags().BoolVarP(&useId, "id", "i", false, "引数をホストゾーンIDとして扱う（デフォルト：ドメイン名）")
 	route53DeleteCmd.Flags().BoolP("force", "f", false, "確認プロンプトをスキップ")
-	route53DeleteCmd.Flags().BoolP("dry-run", "d", false, "削除対象を表示するのみ（実際には削除しない）")
+	addPlanFlags(route53DeleteCmd)
 }
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"awstk/internal/runbook"
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
+	"awstk/internal/service/group"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/spf13/cobra"
+)
+
+var (
+	runVars      []string
+	runDryRun    bool
+	runResume    bool
+	runStatePath string
+)
+
+// RunCmd represents the run command
+var RunCmd = &cobra.Command{
+	Use:   "run <runbook.yaml>",
+	Short: "runbook に定義した操作を順に実行するコマンド",
+	Long: `runbook（YAML）に定義した awstk の操作を上から順に実行します。
+各ステップはシェルを介さず、awstk の各コマンドと同じ処理を直接呼び出します。
+
+  name: dev-shutdown
+  vars:
+    env: dev
+  on_failure: abort            # continue | abort | rollback（ステップごとにも指定可）
+  steps:
+    - name: stop-api
+      action: ecs.stop
+      with: {id: "${env}-cluster/api", min: 1, max: 2, wait: true}
+    - name: stop-db
+      action: aurora.stop
+      with: {id: "${env}-cluster"}
+      when: ${env} != prd
+      on_failure: rollback
+    - action: sleep
+      with: {duration: 30s}
+
+アクション:
+  ec2.start / ec2.stop, rds.start / rds.stop, aurora.start / aurora.stop,
+  ecs.start / ecs.stop, canary.start / canary.stop      with: {id, wait, timeout}（ecs は min, max も指定可）
+  schedule.enable / schedule.disable                     with: {id}
+  group.start / group.stop                               with: {group, noWait, timeout}
+  cfn.start / cfn.stop                                   with: {stack}
+  sleep                                                  with: {duration}
+
+${name} は vars（--var key=value で上書き）の値に置き換えます。
+when は "a == b"、"a != b"、または値のみ（空・false・0・no 以外なら実行）を指定できます。
+on_failure: rollback では、完了済みのステップを逆順に取り消します（start ↔ stop、enable ↔ disable）。
+取り消される ecs.stop には、起動し直すときのキャパシティとして min, max の指定が必要です。
+実行状態は状態ファイルに保存され、失敗した場合は --resume で失敗したステップから再開できます
+（前回の実行時の変数を引き継ぎ、--var で上書きできます）。
+
+例:
+  ` + AppName + ` run dev-shutdown.yaml
+  ` + AppName + ` run dev-shutdown.yaml --var env=stg --dry-run
+  ` + AppName + ` run dev-shutdown.yaml --resume`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rb, err := runbook.Load(args[0])
+		if err != nil {
+			return fmt.Errorf("❌ エラー: %w", err)
+		}
+		statePath := runStatePath
+		if statePath == "" {
+			statePath, err = runbook.DefaultStatePath(args[0])
+			if err != nil {
+				return fmt.Errorf("❌ エラー: %w", err)
+			}
+		}
+		opts := runbook.Options{Vars: runVars, StatePath: statePath, Resume: runResume}
+
+		actions := runbookActions()
+		if runDryRun {
+			if err := runbook.PrintPlan(rb, actions, opts); err != nil {
+				return fmt.Errorf("❌ エラー: %w", err)
+			}
+			return nil
+		}
+
+		printAwsContextWithInfo("Runbook", rb.Name)
+		if err := runbook.Execute(cmd.Context(), rb, actions, opts); err != nil {
+			return fmt.Errorf("❌ runbook の実行でエラー: %w", err)
+		}
+		common.Progressln("\n✅ runbook のすべてのステップが完了しました")
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// groupWaitTimeout は with の timeout を省略した場合の起動・停止の完了待ちの時間
+const groupWaitTimeout = group.DefaultTimeoutSeconds * time.Second
+
+// runResourceArgs はリソースを起動・停止するアクションの引数
+type runResourceArgs struct {
+	Id      string `json:"id"`
+	Min     int    `json:"min"`
+	Max     int    `json:"max"`
+	Wait    bool   `json:"wait"`
+	Timeout int    `json:"timeout"`
+}
+
+// runGroupArgs はリソースグループを起動・停止するアクションの引数
+type runGroupArgs struct {
+	Group   string `json:"group"`
+	NoWait  bool   `json:"noWait"`
+	Timeout int    `json:"timeout"`
+}
+
+// runStackArgs はスタックのリソースを起動・停止するアクションの引数
+type runStackArgs struct {
+	Stack string `json:"stack"`
+}
+
+// runSleepArgs は待機するアクションの引数
+type runSleepArgs struct {
+	Duration string `json:"duration"`
+}
+
+// runbookActions は runbook から呼び出せるアクションを返す
+func runbookActions() runbook.Registry {
+	var actions []runbook.Action
+	for _, resourceType := range group.Types() {
+		start, stop := resourceType+".start", resourceType+".stop"
+		if resourceType == group.TypeSchedule {
+			start, stop = resourceType+".enable", resourceType+".disable"
+		}
+		startAction := runbook.NewAction(start, stop, resourceAction(resourceType, false))
+		stopAction := runbook.NewAction(stop, start, resourceAction(resourceType, true))
+		startAction.WaitTimeout, stopAction.WaitTimeout = groupWaitTimeout, groupWaitTimeout
+		if resourceType == group.TypeEcs {
+			// 停止前のキャパシティは記録しないため、取り消し（起動）時のキャパシティを明示させる
+			stopAction.UndoRequires = []string{"min", "max"}
+		}
+		actions = append(actions, startAction, stopAction)
+	}
+	groupStart := runbook.NewAction("group.start", "group.stop", groupAction(false))
+	groupStop := runbook.NewAction("group.stop", "group.start", groupAction(true))
+	groupStart.WaitTimeout, groupStop.WaitTimeout = groupWaitTimeout, groupWaitTimeout
+	actions = append(actions,
+		groupStart,
+		groupStop,
+		runbook.NewAction("cfn.start", "cfn.stop", stackAction(false)),
+		runbook.NewAction("cfn.stop", "cfn.start", stackAction(true)),
+		runbook.NewAction("sleep", "", sleepAction),
+	)
+	return runbook.NewRegistry(actions...)
+}
+
+// resourceAction はリソースを1件起動・停止するアクションを返す
+func resourceAction(resourceType string, stop bool) func(ctx context.Context, args runResourceArgs) error {
+	return func(ctx context.Context, args runResourceArgs) error {
+		r, err := group.NewResource(resourceType, args.Id, args.Min, args.Max)
+		if err != nil {
+			return err
+		}
+		clients := groupClients()
+		apply := group.StartResource
+		if stop {
+			apply = group.StopResource
+		}
+		if err := apply(ctx, clients, r); err != nil {
+			return err
+		}
+		if !args.Wait {
+			return nil
+		}
+		return group.Wait(ctx, clients, r, stop, args.Timeout)
+	}
+}
+
+// groupAction は設定ファイルのリソースグループを起動・停止するアクションを返す
+func groupAction(stop bool) func(ctx context.Context, args runGroupArgs) error {
+	return func(ctx context.Context, args runGroupArgs) error {
+		def, err := configFile.Group(args.Group)
+		if err != nil {
+			return common.NotFoundf("%v", err)
+		}
+		clients := groupClients()
+		g, err := group.Resolve(ctx, clients, args.Group, def)
+		if err != nil {
+			return err
+		}
+		opts := group.Options{NoWait: args.NoWait, TimeoutSeconds: args.Timeout}
+		if stop {
+			return group.Stop(ctx, clients, g, opts)
+		}
+		return group.Start(ctx, clients, g, opts)
+	}
+}
+
+// stackAction は CloudFormation スタックのリソースを起動・停止するアクションを返す
+func stackAction(stop bool) func(ctx context.Context, args runStackArgs) error {
+	return func(ctx context.Context, args runStackArgs) error {
+		if args.Stack == "" {
+			return common.InvalidInputf("stack を指定してください")
+		}
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+		ec2Client := ec2.NewFromConfig(awsCfg)
+		rdsClient := rds.NewFromConfig(awsCfg)
+		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+		if stop {
+			return cfn.StopAllStackResources(ctx, cfnClient, ec2Client, rdsClient, aasClient, args.Stack)
+		}
+		return cfn.StartAllStackResources(ctx, cfnClient, ec2Client, rdsClient, aasClient, args.Stack)
+	}
+}
+
+// sleepAction は指定した時間だけ待機する
+func sleepAction(ctx context.Context, args runSleepArgs) error {
+	d, err := time.ParseDuration(args.Duration)
+	if err != nil {
+		return common.InvalidInputf("duration が不正です (e.g., 30s, 5m): %s", args.Duration)
+	}
+	common.Progressf("⏳ %s 待機します...\n", d)
+	select {
+	case <-ctx.Done():
+		return common.WaitCanceled(ctx)
+	case <-time.After(d):
+	}
+	return nil
+}
+
+func init() {
+	RootCmd.AddCommand(RunCmd)
+	RunCmd.Flags().StringArrayVar(&runVars, "var", nil, "runbook の変数を上書きする（key=value、複数指定可）")
+	RunCmd.Flags().BoolVarP(&runDryRun, "dry-run", "d", false, "変数と条件を解決した実行計画を表示するのみ（実際には実行しない）")
+	RunCmd.Flags().BoolVar(&runResume, "resume", false, "前回失敗したステップから再開する")
+	RunCmd.Flags().StringVar(&runStatePath, "state", "", "状態ファイルのパス（省略時は ~/.local/state/awstk/runbooks/<runbook名>.json）")
+	_ = RunCmd.MarkFlagFilename("state", "json")
+	RunCmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return []string{"yaml", "yml"}, cobra.ShellCompDirectiveFilterFileExt
+	}
+}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"awstk/internal/aws"
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	s3svc "awstk/internal/service/s3"
+	"awstk/internal/service/tagging"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -23,7 +26,7 @@ var S3Cmd = &cobra.Command{
 		}
 
 		// S3用クライアント生成
-		s3Client = s3.NewFromConfig(awsCfg)
+		s3Client = s3.NewFromConfig(awsCfg, aws.S3PathStyle)
 
 		return nil
 	},
@@ -56,39 +59,45 @@ S3パスを指定した場合、デフォルトでファイルサイズが表示
 
 		if len(args) == 0 {
 			// 引数がない場合はバケット一覧表示
-			buckets, err := s3svc.ListS3Buckets(s3Client)
+			buckets, err := s3svc.ListS3Buckets(cmdCobra.Context(), s3Client)
 			if err != nil {
-				return common.FormatListError("S3バケット", err)
+				return common.FormatListError(i18n.T("resource.s3_bucket"), err)
 			}
-			if len(buckets) == 0 {
-				fmt.Println(common.FormatEmptyMessage("S3バケット"))
+			if len(buckets) == 0 && !common.IsMachineReadable() {
+				fmt.Println(common.FormatEmptyMessage(i18n.T("resource.s3_bucket")))
 				return nil
 			}
 
 			// 空バケットのみ表示する場合
 			if emptyOnly {
-				emptyBuckets, err := s3svc.FilterEmptyBuckets(s3Client, buckets)
+				emptyBuckets, err := s3svc.FilterEmptyBuckets(cmdCobra.Context(), s3Client, buckets)
 				if err != nil {
 					return fmt.Errorf("❌ 空バケットのチェックでエラー: %w", err)
 				}
+				if common.IsMachineReadable() {
+					return common.RenderRecords(emptyBuckets)
+				}
 				common.PrintSimpleList(common.ListOutput{
 					Title:        "空のS3バケット一覧",
 					Items:        emptyBuckets,
-					ResourceName: "バケット",
+					ResourceName: i18n.T("resource.bucket"),
 					ShowCount:    false,
 				})
 			} else {
+				if common.IsMachineReadable() {
+					return common.RenderRecords(buckets)
+				}
 				common.PrintSimpleList(common.ListOutput{
-					Title:        "S3バケット一覧",
+					Title:        common.GenerateFilteredTitle(i18n.T("resource.s3_bucket")),
 					Items:        buckets,
-					ResourceName: "バケット",
+					ResourceName: i18n.T("resource.bucket"),
 					ShowCount:    false,
 				})
 			}
 		} else {
 			// 引数がある場合は指定S3パスをツリー形式で表示
 			s3Path := args[0]
-			err := s3svc.ListS3TreeView(s3Client, s3Path, showTime)
+			err := s3svc.ListS3TreeView(cmdCobra.Context(), s3Client, s3Path, showTime)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
@@ -121,9 +130,9 @@ var s3GunzipCmd = &cobra.Command{
 			outDir = "./outputs/"
 		}
 
-		fmt.Printf("S3パス: %s\n出力先: %s\n", s3Path, outDir)
+		common.Progressf("S3パス: %s\n出力先: %s\n", s3Path, outDir)
 
-		if err := s3svc.DownloadAndExtractGzFiles(s3Client, s3Path, outDir); err != nil {
+		if err := s3svc.DownloadAndExtractGzFiles(cmdCobra.Context(), s3Client, s3Path, outDir); err != nil {
 			return fmt.Errorf("❌ gunzip失敗: %w", err)
 		}
 		return nil
@@ -138,7 +147,7 @@ var s3AvailCmd = &cobra.Command{
 	Long:  `指定した複数のS3バケット名が利用可能か（未作成か）を判定します。\n\n【使い方】\n  ` + AppName + ` s3 avail bucket1 bucket2 ...\n\n【出力例】\n  [404] my-bucket-1: 利用可能\n  [200] my-bucket-2: 利用不可（すでに存在）\n  [403] my-bucket-3: 利用不可（存在するがアクセス権限なし）`,
 	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
-		return s3svc.CheckAndDisplayBucketsAvailability(s3Client, args)
+		return s3svc.CheckAndDisplayBucketsAvailability(cmdCobra.Context(), s3Client, args)
 	},
 	SilenceUsage: true,
 }
@@ -148,32 +157,32 @@ var s3CleanupCmd = &cobra.Command{
 	Use:   "cleanup",
 	Short: "S3バケットを削除するコマンド",
 	Long: `指定したキーワードを含むS3バケットを削除します。
+--tag を指定するとタグの条件に一致するバケットを対象にします（-f と組み合わせた場合は両方に一致するもの）。
 
 例:
-  ` + AppName + ` s3 cleanup -f "test-bucket" -P my-profile`,
+  ` + AppName + ` s3 cleanup -f "test-bucket" -P my-profile
+  ` + AppName + ` s3 cleanup --tag env=dev --tag owner=team-a -P my-profile
+  ` + AppName + ` s3 cleanup -f "test-bucket" --dry-run`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filter, _ := cmd.Flags().GetString("filter")
 
 		printAwsContextWithInfo("検索文字列", filter)
 
 		// フィルターに一致するバケットを取得
-		buckets, err := s3svc.GetS3BucketsByFilter(s3Client, filter)
+		buckets, err := s3svc.GetS3BucketsByFilter(cmd.Context(), s3Client, filter)
 		if err != nil {
 			return fmt.Errorf("❌ S3バケット一覧取得エラー: %w", err)
 		}
-
-		if len(buckets) == 0 {
-			fmt.Printf("フィルター '%s' に一致するS3バケットが見つかりませんでした\n", filter)
-			return nil
+		buckets, err = filterByTag(cmd.Context(), tagging.ResourceS3Bucket, buckets)
+		if err != nil {
+			return err
 		}
 
-		// バケットを削除
-		err = s3svc.CleanupS3Buckets(s3Client, buckets)
-		if err != nil {
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(s3svc.CleanupActions(buckets)...)
+		if err := runPlan(cmd, plan, false); err != nil {
 			return fmt.Errorf("❌ S3バケット削除エラー: %w", err)
 		}
-
-		fmt.Println("✅ S3バケットの削除が完了しました")
 		return nil
 	},
 	SilenceUsage: true,
@@ -196,5 +205,7 @@ func init() {
 
 	// cleanup コマンドのフラグ
 	s3CleanupCmd.Flags().StringP("filter", "f", "", "削除対象のフィルターパターン")
-	_ = s3CleanupCmd.MarkFlagRequired("filter")
+	addTagFlag(s3CleanupCmd)
+	addPlanFlags(s3CleanupCmd)
+	s3CleanupCmd.MarkFlagsOneRequired("filter", "tag")
 }
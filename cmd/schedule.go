@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"awstk/internal/service/common"
 	"awstk/internal/service/schedule"
 	"fmt"
 
@@ -36,25 +37,34 @@ var scheduleLsCmd = &cobra.Command{
   ` + AppName + ` schedule ls --type rule       # EventBridge Rulesのみ表示
   ` + AppName + ` schedule ls --type scheduler  # EventBridge Schedulerのみ表示`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// クライアント生成
-		eventBridgeClient := eventbridge.NewFromConfig(awsCfg)
-		schedulerClient := scheduler.NewFromConfig(awsCfg)
-
 		// オプション設定
 		opts := schedule.ListOptions{
 			Type: scheduleType,
 		}
 
+		regions, err := resolveTargetRegions(cmd)
+		if err != nil {
+			return err
+		}
+		if regions != nil {
+			return schedule.ListSchedulesInRegions(cmd.Context(), regions, func(r string) (schedule.EventBridgeAPI, schedule.SchedulerAPI) {
+				cfg := regionConfig(r)
+				return eventbridge.NewFromConfig(cfg), scheduler.NewFromConfig(cfg)
+			}, opts)
+		}
+
+		// クライアント生成
+		eventBridgeClient := eventbridge.NewFromConfig(awsCfg)
+		schedulerClient := scheduler.NewFromConfig(awsCfg)
+
 		// スケジュール一覧取得
-		schedules, err := schedule.ListSchedules(eventBridgeClient, schedulerClient, opts)
+		schedules, err := schedule.ListSchedules(cmd.Context(), eventBridgeClient, schedulerClient, opts)
 		if err != nil {
 			return fmt.Errorf("スケジュール一覧の取得に失敗: %w", err)
 		}
 
 		// 表示
-		schedule.DisplaySchedules(schedules)
-
-		return nil
+		return schedule.DisplaySchedules(schedules)
 	},
 	SilenceUsage: true,
 }
@@ -83,7 +93,7 @@ var scheduleTriggerCmd = &cobra.Command{
 		}
 
 		// スケジュール実行
-		return schedule.TriggerSchedule(eventBridgeClient, schedulerClient, name, opts)
+		return schedule.TriggerSchedule(cmd.Context(), eventBridgeClient, schedulerClient, name, opts)
 	},
 	SilenceUsage: true,
 }
@@ -106,12 +116,12 @@ var scheduleEnableCmd = &cobra.Command{
 		// 単一指定またはフィルター指定の確認
 		if len(args) == 1 && enableFilter == "" {
 			// 単一スケジュールの有効化
-			return schedule.EnableSchedule(eventBridgeClient, schedulerClient, args[0])
+			return schedule.EnableSchedule(cmd.Context(), eventBridgeClient, schedulerClient, args[0])
 		} else if len(args) == 0 && enableFilter != "" {
 			// フィルターによる一括有効化
-			return schedule.EnableSchedulesWithFilter(eventBridgeClient, schedulerClient, enableFilter)
+			return schedule.EnableSchedulesWithFilter(cmd.Context(), eventBridgeClient, schedulerClient, enableFilter)
 		} else {
-			return fmt.Errorf("スケジュール名またはフィルターのいずれか一方を指定してください")
+			return common.InvalidInputf("スケジュール名またはフィルターのいずれか一方を指定してください")
 		}
 	},
 	SilenceUsage: true,
@@ -135,12 +145,12 @@ var scheduleDisableCmd = &cobra.Command{
 		// 単一指定またはフィルター指定の確認
 		if len(args) == 1 && disableFilter == "" {
 			// 単一スケジュールの無効化
-			return schedule.DisableSchedule(eventBridgeClient, schedulerClient, args[0])
+			return schedule.DisableSchedule(cmd.Context(), eventBridgeClient, schedulerClient, args[0])
 		} else if len(args) == 0 && disableFilter != "" {
 			// フィルターによる一括無効化
-			return schedule.DisableSchedulesWithFilter(eventBridgeClient, schedulerClient, disableFilter)
+			return schedule.DisableSchedulesWithFilter(cmd.Context(), eventBridgeClient, schedulerClient, disableFilter)
 		} else {
-			return fmt.Errorf("スケジュール名またはフィルターのいずれか一方を指定してください")
+			return common.InvalidInputf("スケジュール名またはフィルターのいずれか一方を指定してください")
 		}
 	},
 	SilenceUsage: true,
@@ -155,10 +165,12 @@ func init() {
 
 	// フラグ定義
 	scheduleLsCmd.Flags().StringVarP(&scheduleType, "type", "t", "all", "表示タイプ (all|rule|scheduler)")
+	addRegionsFlag(scheduleLsCmd)
 
 	// trigger サブコマンドのフラグ
 	scheduleTriggerCmd.Flags().IntVar(&triggerTimeout, "timeout", 90, "実行待機時間（秒）")
 	scheduleTriggerCmd.Flags().BoolVar(&triggerNoWait, "no-wait", false, "実行を待たずに終了")
+	scheduleTriggerCmd.ValidArgsFunction = completeScheduleNames
 
 	// enable サブコマンドのフラグ
 	scheduleEnableCmd.Flags().StringVarP(&enableFilter, "filter", "f", "", "有効化するスケジュールのフィルターパターン")
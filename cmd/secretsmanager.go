@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"awstk/internal/service/common"
 	secretsmgrSvc "awstk/internal/service/secretsmanager"
 	"encoding/json"
 	"fmt"
@@ -28,20 +29,25 @@ var secretsmanagerCmd = &cobra.Command{
 }
 
 var secretsmanagerGetCmd = &cobra.Command{
-	Use:   "get <secret-name>",
+	Use:   "get [secret-name]",
 	Short: "Secrets Managerからシークレット値を取得するコマンド",
 	Long: `指定したSecrets Managerのシークレット名またはARNから値を取得し、JSON形式で出力します。
+シークレット名を省略した場合は、シークレット一覧から選択できます。
 
 例:
   ` + AppName + ` secrets get my-secret-name
-  ` + AppName + ` secrets get arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:my-secret-abc123`,
-	Args: cobra.ExactArgs(1),
+  ` + AppName + ` secrets get arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:my-secret-abc123
+  ` + AppName + ` secrets get`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		secretName := args[0]
+		secretName, err := resolveSecretName(cmd, args)
+		if err != nil {
+			return err
+		}
 
-		fmt.Printf("🔍 シークレット (%s) の値を取得します...\n", secretName)
+		common.Progressf("🔍 シークレット (%s) の値を取得します...\n", secretName)
 
-		secretMap, err := secretsmgrSvc.GetSecretValues(secretsmanagerClient, secretName)
+		secretMap, err := secretsmgrSvc.GetSecretValues(cmd.Context(), secretsmanagerClient, secretName)
 		if err != nil {
 			return fmt.Errorf("❌ シークレット取得エラー: %w", err)
 		}
@@ -60,26 +66,42 @@ var secretsmanagerGetCmd = &cobra.Command{
 
 // secretsmanagerDeleteCmd represents the delete command
 var secretsmanagerDeleteCmd = &cobra.Command{
-	Use:   "delete <secret-id>",
+	Use:   "delete [secret-id]",
 	Short: "Secrets Managerのシークレットを即時削除します。",
 	Long: `指定したシークレットを復旧期間なしで即時削除します。
 
-この操作は元に戻すことができません。`,
-	Args: cobra.ExactArgs(1),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		secretId := args[0]
+この操作は元に戻すことができません。
+シークレットIDを省略した場合は、シークレット一覧から選択できます。
 
-		if err := secretsmgrSvc.DeleteSecret(secretsmanagerClient, secretId); err != nil {
+例:
+  ` + AppName + ` secrets delete my-secret-name
+  ` + AppName + ` secrets delete my-secret-name --dry-run
+  ` + AppName + ` secrets delete`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		secretId, err := resolveSecretName(cmd, args)
+		if err != nil {
 			return err
 		}
-
-		fmt.Printf("シークレット %s は正常に削除されました。\n", secretId)
-		return nil
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(secretsmgrSvc.DeleteActions([]string{secretId})...)
+		return runPlan(cmd, plan, false)
 	},
 }
 
+// resolveSecretName は引数のシークレット名を返し、省略された場合はシークレット一覧から選択させる
+func resolveSecretName(cmd *cobra.Command, args []string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	return secretsmgrSvc.SelectSecret(cmd.Context(), secretsmanagerClient)
+}
+
 func init() {
 	RootCmd.AddCommand(secretsmanagerCmd)
 	secretsmanagerCmd.AddCommand(secretsmanagerGetCmd)
 	secretsmanagerCmd.AddCommand(secretsmanagerDeleteCmd)
+	addPlanFlags(secretsmanagerDeleteCmd)
+	secretsmanagerGetCmd.ValidArgsFunction = completeSecretNames
+	secretsmanagerDeleteCmd.ValidArgsFunction = completeSecretNames
 }
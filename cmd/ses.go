@@ -32,7 +32,7 @@ var sesVerifyCmd = &cobra.Command{
 			FilePath:  emailFile,
 		}
 
-		result, err := sesSvc.VerifyEmailsFromFile(opts)
+		result, err := sesSvc.VerifyEmailsFromFile(cmd.Context(), opts)
 		if err != nil {
 			return fmt.Errorf("❌ %v", err)
 		}
@@ -1,7 +1,7 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
+	"awstk/internal/service/common"
 	ssmsvc "awstk/internal/service/ssm"
 	"fmt"
 	"strings"
@@ -13,7 +13,6 @@ import (
 
 var ssmInstanceId string
 var ssmParamsPrefix string
-var ssmParamsDryRun bool
 var ssmDeleteForce bool
 var ssmClient *ssm.Client
 
@@ -44,10 +43,10 @@ var ssmSessionStartCmd = &cobra.Command{
   ` + AppName + ` ssm session [-P <aws-profile>]  # インスタンス一覧から選択
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		awsCtx := aws.Context{Region: region, Profile: profile}
+		awsCtx := awsContext(profile, region, endpointURL)
 		ec2Client := ec2.NewFromConfig(awsCfg)
 
-		return ssmsvc.SelectAndStartSession(awsCtx, ec2Client, ssmInstanceId)
+		return ssmsvc.SelectAndStartSession(cmd.Context(), awsCtx, ec2Client, ssmInstanceId)
 	},
 	SilenceUsage: true,
 }
@@ -65,6 +64,7 @@ var ssmPutParamsCmd = &cobra.Command{
   ` + AppName + ` ssm put-params params.csv
   ` + AppName + ` ssm put-params params.json --prefix /myapp/
   ` + AppName + ` ssm put-params params.csv --dry-run
+  ` + AppName + ` ssm put-params params.csv --plan-out plan.json  # SecureString の値も計画ファイルに保存されます
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -72,26 +72,19 @@ var ssmPutParamsCmd = &cobra.Command{
 
 		// ファイル拡張子のバリデーション
 		if !strings.HasSuffix(filePath, ".csv") && !strings.HasSuffix(filePath, ".json") {
-			return fmt.Errorf("❌ サポートされていないファイル形式です。.csv または .json ファイルを指定してください")
+			return common.InvalidInputf("❌ サポートされていないファイル形式です。.csv または .json ファイルを指定してください")
 		}
 
-		opts := ssmsvc.PutParamsOptions{
+		actions, err := ssmsvc.PutActions(ssmsvc.PutParamsOptions{
 			FilePath: filePath,
 			Prefix:   ssmParamsPrefix,
-			DryRun:   ssmParamsDryRun,
-		}
-
-		err := ssmsvc.PutParametersFromFile(ssmClient, opts)
+		})
 		if err != nil {
 			return fmt.Errorf("❌ パラメータの登録に失敗しました: %w", err)
 		}
-
-		if ssmParamsDryRun {
-			fmt.Println("✅ ドライラン完了")
-		} else {
-			fmt.Println("✅ パラメータの登録が完了しました")
-		}
-		return nil
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		return runPlan(cmd, plan, false)
 	},
 	SilenceUsage: true,
 }
@@ -115,24 +108,16 @@ var ssmDeleteParamsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filePath := args[0]
 
-		opts := ssmsvc.DeleteParamsOptions{
+		actions, err := ssmsvc.DeleteActions(ssmsvc.DeleteParamsOptions{
 			FilePath: filePath,
 			Prefix:   ssmParamsPrefix,
-			DryRun:   ssmParamsDryRun,
-			Force:    ssmDeleteForce,
-		}
-
-		err := ssmsvc.DeleteParametersFromFile(ssmClient, opts)
+		})
 		if err != nil {
 			return fmt.Errorf("❌ パラメータの削除に失敗しました: %w", err)
 		}
-
-		if ssmParamsDryRun {
-			fmt.Println("✅ ドライラン完了")
-		} else {
-			fmt.Println("✅ パラメータの削除が完了しました")
-		}
-		return nil
+		plan := common.NewPlan(cmd.CommandPath())
+		plan.Add(actions...)
+		return runPlan(cmd, plan, !ssmDeleteForce)
 	},
 	SilenceUsage: true,
 }
@@ -145,13 +130,14 @@ func init() {
 
 	// session サブコマンドのフラグ
 	ssmSessionStartCmd.Flags().StringVarP(&ssmInstanceId, "instance-id", "i", "", "EC2インスタンスID（省略時は一覧から選択）")
+	_ = ssmSessionStartCmd.RegisterFlagCompletionFunc("instance-id", completeEc2Instances)
 
 	// put-params サブコマンドのフラグ
 	ssmPutParamsCmd.Flags().StringVarP(&ssmParamsPrefix, "prefix", "p", "", "パラメータ名のプレフィックス")
-	ssmPutParamsCmd.Flags().BoolVarP(&ssmParamsDryRun, "dry-run", "d", false, "実際には登録せず、登録内容を確認")
+	addPlanFlags(ssmPutParamsCmd)
 
 	// delete-params サブコマンドのフラグ
 	ssmDeleteParamsCmd.Flags().StringVarP(&ssmParamsPrefix, "prefix", "p", "", "パラメータ名のプレフィックス")
-	ssmDeleteParamsCmd.Flags().BoolVarP(&ssmParamsDryRun, "dry-run", "d", false, "実際には削除せず、削除対象を確認")
+	addPlanFlags(ssmDeleteParamsCmd)
 	ssmDeleteParamsCmd.Flags().BoolVarP(&ssmDeleteForce, "force", "f", false, "確認プロンプトをスキップ")
 }
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/spf13/cobra"
+)
+
+// tagExprs は --tag で指定されたタグの条件
+var tagExprs []string
+
+// addTagFlag はコマンドに --tag フラグを追加する
+func addTagFlag(cmds ...*cobra.Command) {
+	for _, c := range cmds {
+		c.Flags().StringArrayVar(&tagExprs, "tag", nil, "タグで対象を絞り込む (key=value, key!=value, key。複数指定時はすべてに一致するもの)")
+	}
+}
+
+// tagSelector は --tag の指定をタグのセレクターに変換する（未指定の場合は空のセレクターを返す）
+func tagSelector() (tagging.Selector, error) {
+	selector, err := tagging.ParseSelector(tagExprs)
+	if err != nil {
+		return nil, common.InvalidInputf("❌ エラー: %v", err)
+	}
+	return selector, nil
+}
+
+// filterByTag は --tag が指定されている場合に、names のうちタグの条件に一致するものだけを返す
+func filterByTag(ctx context.Context, resourceType string, names []string) ([]string, error) {
+	selector, err := tagSelector()
+	if err != nil {
+		return nil, err
+	}
+	if len(selector) > 0 {
+		common.Progressf("🏷️  タグ: %s\n", selector)
+	}
+	names, err = selector.Filter(ctx, resourceGroupsTaggingClient(), resourceType, names)
+	if err != nil {
+		return nil, fmt.Errorf("❌ エラー: %w", err)
+	}
+	return names, nil
+}
+
+// forEachTagged は --tag に一致するリソースごとに fn を並列で実行する
+// 一致するリソースがない場合は NotFoundError、失敗したリソースがある場合は PartialFailureError を返す
+func forEachTagged(cmd *cobra.Command, resourceType, operation string, opts *common.RunOptions, fn func(ctx context.Context, name string) error) error {
+	return forEachTaggedFiltered(cmd, resourceType, nil, operation, opts, fn)
+}
+
+// forEachTaggedFiltered は --tag に一致するリソースを filter で絞り込んでから forEachTagged と同様に処理する
+// filter が nil の場合は絞り込まない
+func forEachTaggedFiltered(cmd *cobra.Command, resourceType string, filter func(ctx context.Context, names []string) ([]string, error), operation string, opts *common.RunOptions, fn func(ctx context.Context, name string) error) error {
+	selector, err := tagSelector()
+	if err != nil {
+		return err
+	}
+	names, err := selector.Names(cmd.Context(), resourceGroupsTaggingClient(), resourceType)
+	if err != nil {
+		return fmt.Errorf("❌ エラー: %w", err)
+	}
+	if filter != nil {
+		names, err = filter(cmd.Context(), names)
+		if err != nil {
+			return fmt.Errorf("❌ エラー: %w", err)
+		}
+	}
+	if len(names) == 0 {
+		return common.NotFoundf("❌ タグ (%s) に一致するリソースが見つかりませんでした", selector)
+	}
+	common.Progressf("🏷️  タグ (%s) に一致した%d件が対象です\n", selector, len(names))
+
+	results := common.Run(cmd.Context(), names, func(ctx context.Context, name string) (struct{}, error) {
+		return struct{}{}, fn(ctx, name)
+	}, opts)
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ %s: %v\n", names[i], r.Err)
+		}
+	}
+	return common.CollectFailures(operation, names, results)
+}
+
+// resourceGroupsTaggingClient はタグ検索に使うクライアントを返す
+func resourceGroupsTaggingClient() *resourcegroupstaggingapi.Client {
+	return resourcegroupstaggingapi.NewFromConfig(awsCfg)
+}
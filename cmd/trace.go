@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"awstk/internal/aws"
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"fmt"
+	"os"
+	"time"
+)
+
+var (
+	quietFlag   bool
+	verboseFlag bool
+	debugFlag   bool
+)
+
+// tracer は -v / --debug の場合にAWS APIの呼び出しを計測する（それ以外は nil）
+var tracer *aws.Tracer
+
+// resolveLogLevel は --quiet / -v / --debug から出力レベルを決める
+func resolveLogLevel() (common.LogLevel, error) {
+	if quietFlag && (verboseFlag || debugFlag) {
+		return 0, common.InvalidInputf("❌ エラー: --quiet と -v/--debug は同時に指定できません")
+	}
+	switch {
+	case debugFlag:
+		return common.LogLevelDebug, nil
+	case verboseFlag:
+		return common.LogLevelVerbose, nil
+	case quietFlag:
+		return common.LogLevelQuiet, nil
+	}
+	return common.LogLevelNormal, nil
+}
+
+// attachTracer は -v / --debug の場合にAPI呼び出しの計測と、--debug の場合にSDKのログ出力を有効にする
+func attachTracer() {
+	if common.CurrentLogLevel() < common.LogLevelVerbose {
+		return
+	}
+	tracer = &aws.Tracer{
+		OnCall: func(service, operation string, elapsed time.Duration, err error) {
+			if err != nil {
+				common.Verbosef("🔗 %s.%s (%s) ❌ %v\n", service, operation, elapsed.Round(time.Millisecond), err)
+				return
+			}
+			common.Verbosef("🔗 %s.%s (%s)\n", service, operation, elapsed.Round(time.Millisecond))
+		},
+	}
+	tracer.Attach(&awsCfg)
+	if common.CurrentLogLevel() >= common.LogLevelDebug {
+		aws.EnableRequestLogging(&awsCfg, os.Stderr)
+	}
+}
+
+// printAPISummary は --debug の場合にAPIオペレーションごとの呼び出し回数とレイテンシを標準エラー出力に表示する
+func printAPISummary() {
+	if tracer == nil || common.CurrentLogLevel() < common.LogLevelDebug {
+		return
+	}
+	stats := tracer.Stats()
+	if len(stats) == 0 {
+		return
+	}
+
+	var count, errors int
+	var total time.Duration
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.service")},
+		{Header: i18n.T("header.operation")},
+		{Header: i18n.T("header.count")},
+		{Header: i18n.T("header.error")},
+		{Header: i18n.T("header.average")},
+		{Header: i18n.T("header.max")},
+		{Header: i18n.T("header.total")},
+	}
+	data := make([][]string, len(stats))
+	for i, s := range stats {
+		count += s.Count
+		errors += s.Errors
+		total += s.Total
+		data[i] = []string{
+			s.Service,
+			s.Operation,
+			fmt.Sprint(s.Count),
+			fmt.Sprint(s.Errors),
+			s.Average().Round(time.Millisecond).String(),
+			s.Max.Round(time.Millisecond).String(),
+			s.Total.Round(time.Millisecond).String(),
+		}
+	}
+	common.WriteTable(os.Stderr, fmt.Sprintf("📊 AWS API呼び出し: %d回 (エラー %d回, 合計 %s)", count, errors, total.Round(time.Millisecond)), columns, data)
+}
@@ -1,34 +1,44 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
+	"awstk/internal/config"
+	"awstk/internal/service/common"
 )
 
-// resolveStackName はコマンドライン引数または環境変数からスタック名を決定し、グローバル変数 stackName にセットする
+// resolveStackName はコマンドライン引数・環境変数・コンテキストの順にスタック名を決定し、グローバル変数 stackName にセットする
 func resolveStackName() {
-	if stackName != "" {
-		fmt.Println("🔍 -Sオプションで指定されたスタック名 '" + stackName + "' を使用します")
-		return
+	setting := stackSetting(stackName)
+	switch setting.Source {
+	case config.SourceFlag:
+		common.Progressln("🔍 -Sオプションで指定されたスタック名 '" + setting.Value + "' を使用します")
+	case config.SourceEnv:
+		common.Progressln("🔍 環境変数 AWS_STACK_NAME の値 '" + setting.Value + "' を使用します")
+	case config.SourceContext:
+		common.Progressln("🔍 コンテキスト '" + setting.Origin + "' のスタック名 '" + setting.Value + "' を使用します")
 	}
-	envStack := os.Getenv("AWS_STACK_NAME")
-	if envStack != "" {
-		fmt.Println("🔍 環境変数 AWS_STACK_NAME の値 '" + envStack + "' を使用します")
-		stackName = envStack
+	// いずれもなければstackNameは空のまま
+	stackName = setting.Value
+}
+
+// resolveStackNameUnless はリソース名が直接指定されていない場合のみスタック名を解決する
+// -S が明示されている場合は常にスタック名を優先し、環境変数・コンテキストのスタック名は直接指定を上書きしない
+func resolveStackNameUnless(directlySpecified bool) {
+	if directlySpecified && stackName == "" {
+		return
 	}
-	// どちらもなければstackNameは空のまま
+	resolveStackName()
 }
 
 // printAwsContext はAWSコンテキスト情報を表示する共通関数
 func printAwsContext() {
-	fmt.Printf("Profile: %s\n", profile)
-	fmt.Printf("Region: %s\n", region)
+	common.Progressf("Profile: %s\n", profile)
+	common.Progressf("Region: %s\n", region)
 }
 
 // printAwsContextWithInfo はAWSコンテキスト情報と追加情報を表示する共通関数
 func printAwsContextWithInfo(infoLabel string, infoValue string) {
 	printAwsContext()
-	fmt.Printf("%s: %s\n", infoLabel, infoValue)
+	common.Progressf("%s: %s\n", infoLabel, infoValue)
 }
 
 // ValidateStackSelection は位置引数とオプションの排他チェックを行います
@@ -36,11 +46,11 @@ func ValidateStackSelection(args []string, hasOptions bool) error {
 	hasArgs := len(args) > 0
 
 	if !hasArgs && !hasOptions {
-		return fmt.Errorf("❌ エラー: スタック名またはオプションを指定してください")
+		return common.InvalidInputf("❌ エラー: スタック名またはオプションを指定してください")
 	}
 
 	if hasArgs && hasOptions {
-		return fmt.Errorf("❌ エラー: スタック名とオプションは同時に指定できません")
+		return common.InvalidInputf("❌ エラー: スタック名とオプションは同時に指定できません")
 	}
 
 	return nil
@@ -58,11 +68,11 @@ func ValidateExclusiveOptions(requireOne, exclusive bool, options ...bool) error
 	}
 
 	if requireOne && count == 0 {
-		return fmt.Errorf("❌ エラー: いずれかのオプションを指定してください")
+		return common.InvalidInputf("❌ エラー: いずれかのオプションを指定してください")
 	}
 
 	if exclusive && count > 1 {
-		return fmt.Errorf("❌ エラー: オプションは同時に指定できません")
+		return common.InvalidInputf("❌ エラー: オプションは同時に指定できません")
 	}
 
 	return nil
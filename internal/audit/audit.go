@@ -0,0 +1,192 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// PathEnvName は監査ログの出力先を上書きする環境変数名
+const PathEnvName = "AWSTK_AUDIT_LOG"
+
+// 操作結果
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// mutatingPrefixes はリソースを変更するAPIオペレーション名の接頭辞
+var mutatingPrefixes = []string{
+	"Create", "Delete", "Put", "Update", "Modify", "Start", "Stop", "Run", "Execute",
+	"Enable", "Disable", "Register", "Deregister", "Reboot", "Terminate",
+	"Tag", "Untag", "Set", "Send", "Change", "Invoke",
+	"Restore", "Reset", "Attach", "Detach", "Associate", "Disassociate", "Cancel",
+	"Revoke", "Authorize", "Add", "Remove", "Copy", "Import", "Publish", "Promote",
+	"Failover", "Rotate", "Purge", "Replace", "Abort", "Complete", "Upload",
+	"Accept", "Reject", "Allocate", "Release", "Apply", "BatchDelete",
+}
+
+// Entry は監査ログの1行
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Account   string    `json:"account,omitempty"`
+	Region    string    `json:"region,omitempty"`
+	Profile   string    `json:"profile,omitempty"`
+	Command   string    `json:"command"`
+	Service   string    `json:"service"`
+	Operation string    `json:"operation"`
+	Resource  string    `json:"resource,omitempty"`
+	Result    string    `json:"result"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// DefaultPath は監査ログのデフォルトパスを返します
+// XDG_STATE_HOME が設定されていればそれを優先します
+func DefaultPath() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "awstk", "audit.jsonl"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("ホームディレクトリの取得に失敗: %w", err)
+	}
+	return filepath.Join(home, ".local", "state", "awstk", "audit.jsonl"), nil
+}
+
+// IsMutating はAPIオペレーションがリソースを変更するものかを判定します
+func IsMutating(operation string) bool {
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Recorder はAWS SDKのミドルウェアとして変更系APIの呼び出しを監査ログに追記します
+type Recorder struct {
+	Path    string
+	Profile string
+	Command string
+	// AccountId は最初の記録時に一度だけ呼ばれ、アカウントIDを解決します（nilの場合は記録しない）
+	AccountId func(ctx context.Context) string
+
+	mu          sync.Mutex
+	accountOnce sync.Once
+	account     string
+	warnOnce    sync.Once
+}
+
+// Attach はAWS設定に監査ミドルウェアを追加します
+// 追加後の設定から生成したすべてのクライアントの呼び出しが記録対象になります
+func (r *Recorder) Attach(cfg *aws.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, r.addMiddleware)
+}
+
+// addMiddleware はInitializeステップに監査ミドルウェアを登録します
+// Initializeステップはリトライより外側のため、1回の呼び出しにつき1回だけ記録されます
+func (r *Recorder) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwstkAudit",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if !IsMutating(operation) {
+				return next.HandleInitialize(ctx, in)
+			}
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			r.record(ctx, awsmiddleware.GetServiceID(ctx), operation, awsmiddleware.GetRegion(ctx), in.Parameters, err)
+			return out, metadata, err
+		}), middleware.After)
+}
+
+// record は呼び出し結果を監査ログに追記します
+// 書き込みに失敗しても本来の処理は止めず、警告を1回だけ表示します
+func (r *Recorder) record(ctx context.Context, serviceId, operation, region string, params any, callErr error) {
+	account := r.resolveAccount(ctx)
+	service := normalizeService(serviceId)
+
+	base := Entry{
+		Time:      time.Now(),
+		Account:   account,
+		Region:    region,
+		Profile:   r.Profile,
+		Command:   r.Command,
+		Service:   service,
+		Operation: operation,
+		Result:    ResultSuccess,
+	}
+	if callErr != nil {
+		base.Result = ResultFailure
+		base.Error = callErr.Error()
+	}
+
+	// 複数リソースを対象とする呼び出しはリソースごとに1行記録する
+	resources := ResourceArns(service, region, account, params)
+	if len(resources) == 0 {
+		resources = []string{""}
+	}
+	entries := make([]Entry, len(resources))
+	for i, resource := range resources {
+		entries[i] = base
+		entries[i].Resource = resource
+	}
+
+	if err := r.append(entries); err != nil {
+		r.warnOnce.Do(func() {
+			fmt.Fprintf(os.Stderr, "⚠️  監査ログの書き込みに失敗しました: %v\n", err)
+		})
+	}
+}
+
+// resolveAccount はアカウントIDを初回のみ解決してキャッシュします
+func (r *Recorder) resolveAccount(ctx context.Context) string {
+	r.accountOnce.Do(func() {
+		if r.AccountId != nil {
+			r.account = r.AccountId(ctx)
+		}
+	})
+	return r.account
+}
+
+// append はエントリをJSONLとしてファイル末尾に追記します
+func (r *Recorder) append(entries []Entry) error {
+	var buf strings.Builder
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(buf.String()); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// normalizeService はSDKのサービスID (e.g., "CloudWatch Logs") を小文字・空白なしに揃えます
+func normalizeService(serviceId string) string {
+	return strings.ToLower(strings.ReplaceAll(serviceId, " ", ""))
+}
@@ -0,0 +1,242 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// doerFunc はテスト用のHTTPクライアント
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "audit.jsonl")
+
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			status, body := http.StatusNoContent, ""
+			switch {
+			case req.Method == http.MethodGet:
+				status, body = http.StatusOK, "<ListAllMyBucketsResult></ListAllMyBucketsResult>"
+			case strings.Contains(req.URL.Host, "locked-bucket") || strings.Contains(req.URL.Path, "locked-bucket"):
+				status, body = http.StatusForbidden, "<Error><Code>AccessDenied</Code><Message>denied</Message></Error>"
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	accountCalls := 0
+	recorder := &Recorder{
+		Path:    path,
+		Profile: "dev",
+		Command: "awstk s3 cleanup -f test",
+		AccountId: func(ctx context.Context) string {
+			accountCalls++
+			return "123456789012"
+		},
+	}
+	recorder.Attach(&cfg)
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) { o.RetryMaxAttempts = 1 })
+
+	// 参照系のAPIは記録されない
+	if _, err := client.ListBuckets(t.Context(), &s3.ListBucketsInput{}); err != nil {
+		t.Fatalf("ListBuckets() error = %v", err)
+	}
+	if _, err := client.DeleteBucket(t.Context(), &s3.DeleteBucketInput{Bucket: aws.String("test-bucket")}); err != nil {
+		t.Fatalf("DeleteBucket() error = %v", err)
+	}
+	if _, err := client.DeleteBucket(t.Context(), &s3.DeleteBucketInput{Bucket: aws.String("locked-bucket")}); err == nil {
+		t.Fatal("DeleteBucket() error = nil, want error")
+	}
+
+	entries, err := Read(path, Filter{})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2: %+v", len(entries), entries)
+	}
+	if accountCalls != 1 {
+		t.Errorf("アカウントIDの解決回数 = %d, want 1", accountCalls)
+	}
+
+	want := Entry{
+		Account:   "123456789012",
+		Region:    "ap-northeast-1",
+		Profile:   "dev",
+		Command:   "awstk s3 cleanup -f test",
+		Service:   "s3",
+		Operation: "DeleteBucket",
+		Resource:  "arn:aws:s3:::test-bucket",
+		Result:    ResultSuccess,
+	}
+	got := entries[0]
+	got.Time = time.Time{}
+	if got != want {
+		t.Errorf("entries[0] = %+v, want %+v", got, want)
+	}
+	if entries[1].Result != ResultFailure || entries[1].Error == "" || entries[1].Resource != "arn:aws:s3:::locked-bucket" {
+		t.Errorf("entries[1] = %+v, want failure with error", entries[1])
+	}
+}
+
+func TestIsMutating(t *testing.T) {
+	tests := []struct {
+		operation string
+		want      bool
+	}{
+		{operation: "DeleteBucket", want: true},
+		{operation: "RebootDBInstance", want: true},
+		{operation: "TerminateInstances", want: true},
+		{operation: "RestoreDBClusterFromSnapshot", want: true},
+		{operation: "AttachRolePolicy", want: true},
+		{operation: "DisassociateAddress", want: true},
+		{operation: "CancelRotateSecret", want: true},
+		{operation: "RevokeSecurityGroupIngress", want: true},
+		{operation: "AuthorizeSecurityGroupIngress", want: true},
+		{operation: "ResetDBParameterGroup", want: true},
+		{operation: "BatchDeleteImage", want: true},
+		{operation: "DescribeDBInstances", want: false},
+		{operation: "ListBuckets", want: false},
+		{operation: "GetParameter", want: false},
+		{operation: "BatchGetImage", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			if got := IsMutating(tt.operation); got != tt.want {
+				t.Errorf("IsMutating(%q) = %v, want %v", tt.operation, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceArns(t *testing.T) {
+	tests := []struct {
+		name    string
+		service string
+		region  string
+		params  any
+		want    []string
+	}{
+		{
+			name:    "EC2は対象インスタンスごとにARNを返す",
+			service: "ec2",
+			region:  "ap-northeast-1",
+			params:  &ec2.StopInstancesInput{InstanceIds: []string{"i-1", "i-2"}},
+			want:    []string{"arn:aws:ec2:ap-northeast-1:123456789012:instance/i-1", "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-2"},
+		},
+		{
+			name:    "RDSクラスター",
+			service: "rds",
+			region:  "ap-northeast-1",
+			params:  &rds.StopDBClusterInput{DBClusterIdentifier: aws.String("staging-db")},
+			want:    []string{"arn:aws:rds:ap-northeast-1:123456789012:cluster:staging-db"},
+		},
+		{
+			name:    "中国リージョンのパーティション",
+			service: "s3",
+			region:  "cn-north-1",
+			params:  &s3.DeleteBucketInput{Bucket: aws.String("b")},
+			want:    []string{"arn:aws-cn:s3:::b"},
+		},
+		{
+			name:    "対象を特定できない場合は空",
+			service: "ec2",
+			region:  "ap-northeast-1",
+			params:  &ec2.StopInstancesInput{},
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResourceArns(tt.service, tt.region, "123456789012", tt.params)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResourceArns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	entries := []Entry{
+		{Time: now.Add(-48 * time.Hour), Service: "s3", Operation: "DeleteBucket", Resource: "arn:aws:s3:::old", Result: ResultSuccess},
+		{Time: now.Add(-1 * time.Hour), Service: "rds", Operation: "StopDBCluster", Resource: "arn:aws:rds:ap-northeast-1:1:cluster:staging", Result: ResultSuccess},
+		{Time: now.Add(-1 * time.Hour), Service: "cloudwatchlogs", Operation: "DeleteLogGroup", Resource: "arn:aws:logs:ap-northeast-1:1:log-group:/a", Result: ResultFailure},
+	}
+
+	since, err := ParseSince("24h", now)
+	if err != nil {
+		t.Fatalf("ParseSince() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{name: "期間で絞り込み", filter: Filter{Since: since}, want: 2},
+		{name: "サービス名で絞り込み", filter: Filter{ResourceType: "RDS"}, want: 1},
+		{name: "ARNのサービス名でも絞り込める", filter: Filter{ResourceType: "logs"}, want: 1},
+		{name: "オペレーションの部分一致", filter: Filter{Operation: "delete"}, want: 2},
+		{name: "失敗のみ", filter: Filter{FailedOnly: true}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 0
+			for _, e := range entries {
+				if tt.filter.Match(e) {
+					got++
+				}
+			}
+			if got != tt.want {
+				t.Errorf("一致件数 = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "", want: time.Time{}},
+		{value: "24h", want: now.Add(-24 * time.Hour)},
+		{value: "7d", want: now.AddDate(0, 0, -7)},
+		{value: "2025-01-01", want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "yesterday", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseSince(tt.value, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSince() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseSince() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter は監査ログの検索条件
+type Filter struct {
+	Since        time.Time // この時刻以降のエントリのみ（ゼロ値は全期間）
+	ResourceType string    // サービス名 (e.g., s3, rds, logs)。ARNのサービス部分とも照合する
+	Operation    string    // オペレーション名の部分一致（大文字小文字を区別しない）
+	FailedOnly   bool      // 失敗したエントリのみ
+}
+
+// Match はエントリが検索条件に一致するかを判定します
+func (f Filter) Match(e Entry) bool {
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if f.ResourceType != "" {
+		rt := strings.ToLower(f.ResourceType)
+		if rt != e.Service && rt != ServiceOf(e.Resource) {
+			return false
+		}
+	}
+	if f.Operation != "" && !strings.Contains(strings.ToLower(e.Operation), strings.ToLower(f.Operation)) {
+		return false
+	}
+	if f.FailedOnly && e.Result != ResultFailure {
+		return false
+	}
+	return true
+}
+
+// Read は監査ログを読み込み、検索条件に一致するエントリを記録順に返します
+// ファイルが存在しない場合は空のスライスを返し、解析できない行は読み飛ばします
+func Read(path string, filter Filter) ([]Entry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Entry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("監査ログ %s の読み込みに失敗: %w", path, err)
+	}
+	defer f.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if filter.Match(e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("監査ログ %s の読み込みに失敗: %w", path, err)
+	}
+	return entries, nil
+}
+
+// ParseSince は --since の値を基準時刻に変換します
+// 24h / 30m のような期間（日数は 7d のように指定可能）、または 2006-01-02 / RFC3339 形式の日時を受け付けます
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("--since の形式が不正です: %s (例: 24h, 7d, 2006-01-02)", value)
+}
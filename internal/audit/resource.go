@@ -0,0 +1,178 @@
+package audit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ResourceArns はAPIの入力パラメータから操作対象リソースのARNを組み立てます
+// service は小文字・空白なしのサービスID (e.g., "s3", "cloudwatchlogs") を渡します
+// ARNを特定できない場合は空のスライスを返します
+func ResourceArns(service, region, account string, params any) []string {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	p := partition(region)
+	arn := func(svc, rgn, resource string) string {
+		return fmt.Sprintf("arn:%s:%s:%s:%s:%s", p, svc, rgn, account, resource)
+	}
+	keepArn := func(id string, build func(string) string) string {
+		if strings.HasPrefix(id, "arn:") {
+			return id
+		}
+		return build(id)
+	}
+
+	var arns []string
+	switch service {
+	case "s3":
+		for _, b := range stringValues(v, "Bucket") {
+			arns = append(arns, fmt.Sprintf("arn:%s:s3:::%s", p, b))
+		}
+	case "ec2":
+		for _, id := range stringValues(v, "InstanceIds") {
+			arns = append(arns, arn("ec2", region, "instance/"+id))
+		}
+	case "rds":
+		for _, id := range stringValues(v, "DBInstanceIdentifier") {
+			arns = append(arns, keepArn(id, func(s string) string { return arn("rds", region, "db:"+s) }))
+		}
+		for _, id := range stringValues(v, "DBClusterIdentifier") {
+			arns = append(arns, keepArn(id, func(s string) string { return arn("rds", region, "cluster:"+s) }))
+		}
+	case "ecr":
+		for _, name := range stringValues(v, "RepositoryName") {
+			arns = append(arns, arn("ecr", region, "repository/"+name))
+		}
+	case "cloudwatchlogs":
+		for _, name := range stringValues(v, "LogGroupName") {
+			arns = append(arns, arn("logs", region, "log-group:"+name))
+		}
+	case "cloudformation":
+		for _, name := range stringValues(v, "StackName") {
+			arns = append(arns, keepArn(name, func(s string) string { return arn("cloudformation", region, "stack/"+s) }))
+		}
+	case "secretsmanager":
+		for _, id := range stringValues(v, "SecretId") {
+			arns = append(arns, keepArn(id, func(s string) string { return arn("secretsmanager", region, "secret:"+s) }))
+		}
+	case "synthetics":
+		for _, name := range stringValues(v, "Name") {
+			arns = append(arns, arn("synthetics", region, "canary:"+name))
+		}
+	case "cloudfront":
+		for _, id := range append(stringValues(v, "DistributionId"), stringValues(v, "Id")...) {
+			arns = append(arns, arn("cloudfront", "", "distribution/"+id))
+		}
+	case "route53":
+		for _, id := range append(stringValues(v, "HostedZoneId"), stringValues(v, "Id")...) {
+			arns = append(arns, fmt.Sprintf("arn:%s:route53:::hostedzone/%s", p, strings.TrimPrefix(id, "/hostedzone/")))
+		}
+	case "applicationautoscaling":
+		// ECSサービスのResourceIdは service/<クラスター名>/<サービス名> 形式
+		for _, id := range stringValues(v, "ResourceId") {
+			if strings.HasPrefix(id, "service/") {
+				arns = append(arns, arn("ecs", region, id))
+			}
+		}
+	case "ecs":
+		clusters := stringValues(v, "Cluster")
+		if len(clusters) == 1 {
+			for _, s := range stringValues(v, "Service") {
+				arns = append(arns, keepArn(s, func(s string) string { return arn("ecs", region, "service/"+clusters[0]+"/"+s) }))
+			}
+			for _, t := range stringValues(v, "Task") {
+				arns = append(arns, keepArn(t, func(s string) string { return arn("ecs", region, "task/"+clusters[0]+"/"+s) }))
+			}
+		}
+	case "ssm":
+		for _, name := range append(stringValues(v, "Name"), stringValues(v, "Names")...) {
+			arns = append(arns, arn("ssm", region, "parameter/"+strings.TrimPrefix(name, "/")))
+		}
+		for _, target := range stringValues(v, "Target") {
+			arns = append(arns, arn("ec2", region, "instance/"+target))
+		}
+	case "eventbridge":
+		for _, name := range stringValues(v, "Name") {
+			arns = append(arns, arn("events", region, "rule/"+name))
+		}
+	case "scheduler":
+		group := "default"
+		if groups := stringValues(v, "GroupName"); len(groups) == 1 {
+			group = groups[0]
+		}
+		for _, name := range stringValues(v, "Name") {
+			arns = append(arns, arn("scheduler", region, "schedule/"+group+"/"+name))
+		}
+	case "iam":
+		for _, name := range stringValues(v, "RoleName") {
+			arns = append(arns, arn("iam", "", "role/"+name))
+		}
+	}
+	if len(arns) > 0 {
+		return arns
+	}
+
+	// 未対応のサービスは、ARNを値に持つフィールドをそのまま使う
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if strings.HasSuffix(t.Field(i).Name, "Arn") {
+			arns = append(arns, stringValues(v, t.Field(i).Name)...)
+		}
+	}
+	return arns
+}
+
+// ServiceOf はARNからサービス名を取り出します
+func ServiceOf(arn string) string {
+	parts := strings.SplitN(arn, ":", 4)
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[2]
+}
+
+// stringValues は構造体フィールドの値を文字列のスライスとして取り出します
+// *string / string / []string のフィールドに対応し、空文字は除外します
+func stringValues(v reflect.Value, name string) []string {
+	f := v.FieldByName(name)
+	if !f.IsValid() {
+		return nil
+	}
+	switch {
+	case f.Kind() == reflect.Pointer && f.Type().Elem().Kind() == reflect.String:
+		if f.IsNil() || f.Elem().String() == "" {
+			return nil
+		}
+		return []string{f.Elem().String()}
+	case f.Kind() == reflect.String:
+		if f.String() == "" {
+			return nil
+		}
+		return []string{f.String()}
+	case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.String:
+		var values []string
+		for i := 0; i < f.Len(); i++ {
+			if s := f.Index(i).String(); s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// partition はリージョンからARNのパーティションを判定します
+func partition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	default:
+		return "aws"
+	}
+}
@@ -10,14 +10,27 @@ import (
 // LoadAwsConfig は認証情報からAWS設定を読み込む
 // オプションで指定されたRegion, Profileを優先してAWS設定を読み込む
 // いずれも指定されなければconfig.LoadDefaultConfigで環境変数AWS_PROFILE等から設定を読み込む
-func LoadAwsConfig(ctx Context) (aws.Config, error) {
+// エンドポイントが指定されている場合は、全サービス共通・サービスごとのエンドポイントを設定する
+func LoadAwsConfig(ctx context.Context, awsCtx Context) (aws.Config, error) {
 	opts := make([]func(*config.LoadOptions) error, 0)
 
-	if ctx.Profile != "" {
-		opts = append(opts, config.WithSharedConfigProfile(ctx.Profile))
+	if awsCtx.Profile != "" {
+		opts = append(opts, config.WithSharedConfigProfile(awsCtx.Profile))
 	}
-	if ctx.Region != "" {
-		opts = append(opts, config.WithRegion(ctx.Region))
+	if awsCtx.Region != "" {
+		opts = append(opts, config.WithRegion(awsCtx.Region))
 	}
-	return config.LoadDefaultConfig(context.Background(), opts...)
+	if awsCtx.EndpointURL != "" {
+		opts = append(opts, config.WithBaseEndpoint(awsCtx.EndpointURL))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return aws.Config{}, err
+	}
+
+	// サービスごとのエンドポイントは、環境変数・共有設定ファイルより優先されるよう設定ソースの先頭に追加する
+	if len(awsCtx.Endpoints) > 0 {
+		cfg.ConfigSources = append([]interface{}{endpointSource(awsCtx.Endpoints)}, cfg.ConfigSources...)
+	}
+	return cfg, nil
 }
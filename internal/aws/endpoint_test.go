@@ -0,0 +1,94 @@
+package aws_test
+
+import (
+	"awstk/internal/aws"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func TestServiceEndpointsFromEnv(t *testing.T) {
+	got := aws.ServiceEndpointsFromEnv([]string{
+		"AWSTK_ENDPOINT_S3=http://localhost:4566",
+		"AWSTK_ENDPOINT_CLOUDWATCH_LOGS=http://localhost:4567",
+		"AWSTK_ENDPOINT_URL=http://localhost:9999", // 全サービス共通の指定は含めない
+		"AWSTK_ENDPOINT_ECR=",
+		"AWS_ENDPOINT_URL_S3=http://other",
+	})
+	want := map[string]string{"S3": "http://localhost:4566", "CLOUDWATCH_LOGS": "http://localhost:4567"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceEndpointsFromEnv() = %v, want %v", got, want)
+	}
+}
+
+func TestLoadAwsConfig_Endpoints(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_PROFILE", "")
+	// AWS_ENDPOINT_URL は空でも設定されているとSDKがサービスごとの指定を見なくなるため、未設定にする（終了時に t.Setenv が元に戻す）
+	t.Setenv("AWS_ENDPOINT_URL", "")
+	os.Unsetenv("AWS_ENDPOINT_URL")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	tests := []struct {
+		name          string
+		awsCtx        aws.Context
+		wantS3        string
+		wantLogs      string
+		wantPathStyle bool
+	}{
+		{name: "指定なし"},
+		{
+			name:          "全サービス共通",
+			awsCtx:        aws.Context{EndpointURL: "http://localhost:4566"},
+			wantS3:        "http://localhost:4566",
+			wantLogs:      "http://localhost:4566",
+			wantPathStyle: true,
+		},
+		{
+			name:          "サービスごとの指定を優先",
+			awsCtx:        aws.Context{EndpointURL: "http://localhost:4566", Endpoints: map[string]string{aws.ServiceKey("cloudwatch-logs"): "http://localhost:5000"}},
+			wantS3:        "http://localhost:4566",
+			wantLogs:      "http://localhost:5000",
+			wantPathStyle: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.awsCtx.Region = "us-east-1"
+			cfg, err := aws.LoadAwsConfig(t.Context(), tt.awsCtx)
+			if err != nil {
+				t.Fatalf("LoadAwsConfig() error = %v", err)
+			}
+
+			s3Opts := s3.NewFromConfig(cfg, aws.S3PathStyle).Options()
+			if got := awssdk.ToString(s3Opts.BaseEndpoint); got != tt.wantS3 {
+				t.Errorf("S3 BaseEndpoint = %q, want %q", got, tt.wantS3)
+			}
+			if s3Opts.UsePathStyle != tt.wantPathStyle {
+				t.Errorf("S3 UsePathStyle = %v, want %v", s3Opts.UsePathStyle, tt.wantPathStyle)
+			}
+			logsOpts := cloudwatchlogs.NewFromConfig(cfg).Options()
+			if got := awssdk.ToString(logsOpts.BaseEndpoint); got != tt.wantLogs {
+				t.Errorf("CloudWatch Logs BaseEndpoint = %q, want %q", got, tt.wantLogs)
+			}
+		})
+	}
+}
+
+func TestContextEndpointFor(t *testing.T) {
+	c := aws.Context{EndpointURL: "http://localhost:4566", Endpoints: map[string]string{"SSM": "http://localhost:5000"}}
+	if got := c.EndpointFor("ssm"); got != "http://localhost:5000" {
+		t.Errorf("EndpointFor(ssm) = %q", got)
+	}
+	if got := c.EndpointFor("ecs"); got != "http://localhost:4566" {
+		t.Errorf("EndpointFor(ecs) = %q", got)
+	}
+}
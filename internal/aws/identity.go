@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// Identity は認証情報に紐づくAWSアカウントの情報
+type Identity struct {
+	AccountId string
+	Arn       string
+	Alias     string // アカウントエイリアス（未設定・取得権限なしの場合は空）
+}
+
+// GetAccountId はSTS GetCallerIdentityでアカウントIDのみを取得する
+func GetAccountId(ctx context.Context, cfg aws.Config) (string, error) {
+	out, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("アカウント情報の取得に失敗: %w", err)
+	}
+	return aws.ToString(out.Account), nil
+}
+
+// GetIdentity はSTS GetCallerIdentityでアカウントIDを取得し、可能であればアカウントエイリアスも取得する
+func GetIdentity(ctx context.Context, cfg aws.Config) (Identity, error) {
+	out, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return Identity{}, fmt.Errorf("アカウント情報の取得に失敗: %w", err)
+	}
+	identity := Identity{
+		AccountId: aws.ToString(out.Account),
+		Arn:       aws.ToString(out.Arn),
+	}
+
+	// エイリアスは表示用のため、取得できなくてもエラーにしない
+	aliases, err := iam.NewFromConfig(cfg).ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+	if err == nil && len(aliases.AccountAliases) > 0 {
+		identity.Alias = aliases.AccountAliases[0]
+	}
+	return identity, nil
+}
+
+// Label は表示用のアカウントラベルを返す (e.g., 123456789012 (my-alias))
+func (i Identity) Label() string {
+	if i.Alias == "" {
+		return i.AccountId
+	}
+	return fmt.Sprintf("%s (%s)", i.AccountId, i.Alias)
+}
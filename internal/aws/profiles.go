@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+)
+
+// ListProfiles は ~/.aws/config と ~/.aws/credentials に定義されたプロファイル名をソートして返す
+// AWS_CONFIG_FILE / AWS_SHARED_CREDENTIALS_FILE が設定されていればそちらを参照する
+func ListProfiles() ([]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("ホームディレクトリの取得に失敗: %w", err)
+	}
+	configPath := envOrDefault("AWS_CONFIG_FILE", filepath.Join(home, ".aws", "config"))
+	credentialsPath := envOrDefault("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(home, ".aws", "credentials"))
+
+	seen := make(map[string]struct{})
+	for _, file := range []struct {
+		path     string
+		isConfig bool
+	}{
+		{configPath, true},
+		{credentialsPath, false},
+	} {
+		names, err := readProfileSections(file.path, file.isConfig)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			seen[name] = struct{}{}
+		}
+	}
+
+	profiles := make([]string, 0, len(seen))
+	for name := range seen {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return profiles, nil
+}
+
+// MatchProfiles はプロファイル名またはグロブパターン（*, ?, [...]）をプロファイル名の一覧に展開する
+// パターン以外の名前はそのまま採用し、重複は指定順を保って除外する
+func MatchProfiles(patterns []string) ([]string, error) {
+	var available []string
+	var result []string
+	seen := make(map[string]struct{})
+	add := func(name string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		result = append(result, name)
+	}
+
+	for _, pattern := range patterns {
+		if !strings.ContainsAny(pattern, "*?[") {
+			add(pattern)
+			continue
+		}
+		if available == nil {
+			profiles, err := ListProfiles()
+			if err != nil {
+				return nil, err
+			}
+			available = profiles
+		}
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("不正なプロファイルパターンです: %s: %w", pattern, err)
+		}
+		matched := false
+		for _, name := range available {
+			if g.Match(name) {
+				matched = true
+				add(name)
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("パターン '%s' に一致するプロファイルがありません", pattern)
+		}
+	}
+	return result, nil
+}
+
+// ReadProfilesFile はプロファイル名（またはパターン）を1行ずつ記載したファイルを読み込む
+// 空行と # で始まる行は無視する
+func ReadProfilesFile(filePath string) ([]string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("プロファイル一覧ファイルの読み込みに失敗: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var profiles []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		profiles = append(profiles, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("プロファイル一覧ファイルの読み込みに失敗: %w", err)
+	}
+	return profiles, nil
+}
+
+// readProfileSections は設定ファイルのセクション名からプロファイル名を取り出す
+// config では [profile name] / [default]、credentials では [name] の形式
+func readProfileSections(filePath string, isConfig bool) ([]string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s の読み込みに失敗: %w", filePath, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+			continue
+		}
+		section := strings.TrimSpace(line[1 : len(line)-1])
+		if isConfig {
+			switch {
+			case section == "default":
+			case strings.HasPrefix(section, "profile "):
+				section = strings.TrimSpace(strings.TrimPrefix(section, "profile "))
+			default:
+				// sso-session などプロファイル以外のセクション
+				continue
+			}
+		}
+		if section != "" {
+			names = append(names, section)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s の読み込みに失敗: %w", filePath, err)
+	}
+	return names, nil
+}
+
+func envOrDefault(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,67 @@
+package aws_test
+
+import (
+	"awstk/internal/aws"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMatchProfiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	credentialsPath := filepath.Join(dir, "credentials")
+	config := "[default]\nregion = ap-northeast-1\n[profile prod-a]\n[profile prod-b]\n[profile dev]\n[sso-session corp]\n"
+	credentials := "[prod-c]\naws_access_key_id = x\n"
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(credentialsPath, []byte(credentials), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_CONFIG_FILE", configPath)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", credentialsPath)
+
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+		wantErr  bool
+	}{
+		{name: "名前はそのまま採用", patterns: []string{"dev", "unknown"}, want: []string{"dev", "unknown"}},
+		{name: "グロブをconfigとcredentialsから展開", patterns: []string{"prod-*"}, want: []string{"prod-a", "prod-b", "prod-c"}},
+		{name: "重複は指定順を保って除外", patterns: []string{"prod-b", "prod-*"}, want: []string{"prod-b", "prod-a", "prod-c"}},
+		{name: "sso-sessionはプロファイルとして扱わない", patterns: []string{"*"}, want: []string{"default", "dev", "prod-a", "prod-b", "prod-c"}},
+		{name: "一致しないパターンはエラー", patterns: []string{"stg-*"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := aws.MatchProfiles(tt.patterns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MatchProfiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchProfiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadProfilesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.txt")
+	body := "# 本番アカウント\nprod-a\n\n  prod-b  \n"
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := aws.ReadProfilesFile(path)
+	if err != nil {
+		t.Fatalf("ReadProfilesFile() error = %v", err)
+	}
+	want := []string{"prod-a", "prod-b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadProfilesFile() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package aws
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/logging"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// APIStat はAPIオペレーションごとの呼び出し回数とレイテンシの集計
+type APIStat struct {
+	Service   string
+	Operation string
+	Count     int
+	Errors    int
+	Total     time.Duration
+	Max       time.Duration
+}
+
+// Average は1回あたりの平均レイテンシを返します
+func (s APIStat) Average() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// Tracer はAWS SDKのミドルウェアとしてAPIの呼び出し回数とレイテンシを計測します
+type Tracer struct {
+	// OnCall は呼び出しが完了するたびに呼ばれます（nilの場合は呼ばない）
+	OnCall func(service, operation string, elapsed time.Duration, err error)
+
+	mu    sync.Mutex
+	stats map[string]*APIStat
+}
+
+// Attach はAWS設定に計測用のミドルウェアを追加します
+func (t *Tracer) Attach(cfg *aws.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, t.addMiddleware)
+}
+
+// addMiddleware はInitializeステップに計測用のミドルウェアを登録します
+// Initializeステップはリトライより外側のため、リトライを含めた1回の呼び出しとして計測されます
+func (t *Tracer) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwstkTrace",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			t.record(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), time.Since(start), err)
+			return out, metadata, err
+		}), middleware.After)
+}
+
+// record は呼び出し結果を集計する
+func (t *Tracer) record(service, operation string, elapsed time.Duration, err error) {
+	t.mu.Lock()
+	if t.stats == nil {
+		t.stats = map[string]*APIStat{}
+	}
+	key := service + "." + operation
+	stat, ok := t.stats[key]
+	if !ok {
+		stat = &APIStat{Service: service, Operation: operation}
+		t.stats[key] = stat
+	}
+	stat.Count++
+	stat.Total += elapsed
+	stat.Max = max(stat.Max, elapsed)
+	if err != nil {
+		stat.Errors++
+	}
+	t.mu.Unlock()
+
+	if t.OnCall != nil {
+		t.OnCall(service, operation, elapsed, err)
+	}
+}
+
+// Stats はサービス名・オペレーション名の順に並べた集計結果を返します
+func (t *Tracer) Stats() []APIStat {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	stats := make([]APIStat, 0, len(t.stats))
+	for _, stat := range t.stats {
+		stats = append(stats, *stat)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Service != stats[j].Service {
+			return stats[i].Service < stats[j].Service
+		}
+		return stats[i].Operation < stats[j].Operation
+	})
+	return stats
+}
+
+// EnableRequestLogging はAWS SDKのリクエスト・リトライ・レスポンス（ボディを除く）のログを w に出力するよう設定します
+func EnableRequestLogging(cfg *aws.Config, w io.Writer) {
+	cfg.ClientLogMode |= aws.LogRequest | aws.LogRetries | aws.LogResponse
+	cfg.Logger = logging.NewStandardLogger(w)
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// doerFunc はテスト用のHTTPクライアント
+type doerFunc func(*http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestTracer(t *testing.T) {
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient: doerFunc(func(req *http.Request) (*http.Response, error) {
+			status, body := http.StatusNoContent, ""
+			switch {
+			case req.Method == http.MethodGet:
+				status, body = http.StatusOK, "<ListAllMyBucketsResult></ListAllMyBucketsResult>"
+			case strings.Contains(req.URL.Host, "locked-bucket") || strings.Contains(req.URL.Path, "locked-bucket"):
+				status, body = http.StatusForbidden, "<Error><Code>AccessDenied</Code><Message>denied</Message></Error>"
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		}),
+	}
+	var calls []string
+	tracer := &Tracer{OnCall: func(service, operation string, _ time.Duration, _ error) {
+		calls = append(calls, service+"."+operation)
+	}}
+	tracer.Attach(&cfg)
+	var logs bytes.Buffer
+	EnableRequestLogging(&cfg, &logs)
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) { o.RetryMaxAttempts = 1 })
+
+	for range 2 {
+		if _, err := client.ListBuckets(t.Context(), &s3.ListBucketsInput{}); err != nil {
+			t.Fatalf("ListBuckets() error = %v", err)
+		}
+	}
+	if _, err := client.DeleteBucket(t.Context(), &s3.DeleteBucketInput{Bucket: aws.String("locked-bucket")}); err == nil {
+		t.Fatal("DeleteBucket() error = nil, want error")
+	}
+
+	stats := tracer.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("len(Stats()) = %d, want 2: %+v", len(stats), stats)
+	}
+	if got := stats[0]; got.Operation != "DeleteBucket" || got.Count != 1 || got.Errors != 1 {
+		t.Errorf("Stats()[0] = %+v, want DeleteBucket Count=1 Errors=1", got)
+	}
+	if got := stats[1]; got.Operation != "ListBuckets" || got.Count != 2 || got.Errors != 0 {
+		t.Errorf("Stats()[1] = %+v, want ListBuckets Count=2 Errors=0", got)
+	}
+	if len(calls) != 3 {
+		t.Errorf("OnCall の呼び出し回数 = %d, want 3", len(calls))
+	}
+	if !strings.Contains(logs.String(), "GET /") {
+		t.Errorf("リクエストのログが出力されていない: %q", logs.String())
+	}
+}
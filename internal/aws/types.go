@@ -2,6 +2,8 @@ package aws
 
 // Context AwsContext は認証情報を保持
 type Context struct {
-	Profile string
-	Region  string
+	Profile     string
+	Region      string
+	EndpointURL string            // 全サービス共通のエンドポイントURL（LocalStack など）
+	Endpoints   map[string]string // サービスごとのエンドポイントURL（キーは ServiceKey で正規化したサービス名）
 }
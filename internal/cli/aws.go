@@ -19,6 +19,13 @@ func ExecuteAwsCommand(ctx aws.Context, args []string) error {
 		args = append(args, "--region", ctx.Region)
 	}
 
+	// エンドポイントが指定されている場合、引数に追加（args[0] はサービス名）
+	if len(args) > 0 {
+		if endpoint := ctx.EndpointFor(args[0]); endpoint != "" {
+			args = append(args, "--endpoint-url", endpoint)
+		}
+	}
+
 	cmd := exec.Command("aws", args...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
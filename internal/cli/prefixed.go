@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+)
+
+// PrefixedOptions は出力に接頭辞を付けてコマンドを実行する際のオプション
+type PrefixedOptions struct {
+	Prefix string      // 各行の先頭に付ける文字列
+	Stdout io.Writer   // 標準出力の書き込み先
+	Stderr io.Writer   // 標準エラー出力の書き込み先
+	Mu     *sync.Mutex // 並列実行時に行単位で出力を直列化するためのロック
+
+	// RawStdout が true の場合、標準出力には接頭辞を付けない（JSONなどをそのまま受け取る場合）
+	RawStdout bool
+}
+
+// RunPrefixed はコマンドを実行し、標準出力・標準エラー出力の各行に接頭辞を付けて書き出す
+// コマンドが起動できた場合は終了コードを返し、起動自体に失敗した場合はエラーを返す
+func RunPrefixed(ctx context.Context, name string, args []string, opts PrefixedOptions) (int, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return -1, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return -1, err
+	}
+	if err := cmd.Start(); err != nil {
+		return -1, fmt.Errorf("コマンドの起動に失敗: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		prefix := opts.Prefix
+		if opts.RawStdout {
+			prefix = ""
+		}
+		copyPrefixed(opts.Stdout, stdout, prefix, opts.Mu)
+	}()
+	go func() {
+		defer wg.Done()
+		copyPrefixed(opts.Stderr, stderr, opts.Prefix, opts.Mu)
+	}()
+	wg.Wait()
+
+	err = cmd.Wait()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
+
+// copyPrefixed は r から読み込んだ各行に接頭辞を付けて w に書き出す
+func copyPrefixed(w io.Writer, r io.Reader, prefix string, mu *sync.Mutex) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if mu != nil {
+			mu.Lock()
+		}
+		_, _ = fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+		if mu != nil {
+			mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Package completion はシェル補完で使うリソース名の短期キャッシュを提供します
+//
+// タブ補完のたびにAWS APIを呼ぶと応答が遅くなるため、取得した名前の一覧を
+// プロファイル・リージョンごとにディスクへ保存し、有効期限内はそれを返します。
+package completion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultTTL はキャッシュの有効期限の既定値
+const DefaultTTL = 2 * time.Minute
+
+// TTLEnvName はキャッシュの有効期限を上書きする環境変数名 (e.g., "30s", "0" で無効)
+const TTLEnvName = "AWSTK_COMPLETION_CACHE_TTL"
+
+// Key はキャッシュのキー
+type Key struct {
+	Profile string
+	Region  string
+	Kind    string // リソースの種類 (e.g., "stacks", "ecs-services/my-cluster")
+}
+
+// entry はキャッシュファイルの内容
+type entry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Values    []string  `json:"values"`
+}
+
+// Cache はリソース名の一覧をディスクに保存する短期キャッシュ
+type Cache struct {
+	Dir string        // 保存先ディレクトリ
+	TTL time.Duration // 有効期限（0以下の場合はキャッシュしない）
+	Now func() time.Time
+}
+
+// DefaultDir はキャッシュのデフォルトの保存先を返します
+// XDG_CACHE_HOME が設定されていればそれを優先します
+func DefaultDir() (string, error) {
+	if dir := os.Getenv("XDG_CACHE_HOME"); dir != "" {
+		return filepath.Join(dir, "awstk", "completion"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("ホームディレクトリの取得に失敗: %w", err)
+	}
+	return filepath.Join(home, ".cache", "awstk", "completion"), nil
+}
+
+// New はデフォルトの保存先と、環境変数 AWSTK_COMPLETION_CACHE_TTL で指定された有効期限でキャッシュを生成します
+func New() (*Cache, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	ttl := DefaultTTL
+	if v := os.Getenv(TTLEnvName); v != "" {
+		if v == "0" {
+			ttl = 0
+		} else if ttl, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("%s の値が不正です: %w", TTLEnvName, err)
+		}
+	}
+	return &Cache{Dir: dir, TTL: ttl}, nil
+}
+
+// Get は有効期限内のキャッシュがあればそれを返し、なければ fetch で取得してキャッシュに保存します
+// キャッシュの読み書きに失敗しても fetch の結果は返します
+func (c *Cache) Get(key Key, fetch func() ([]string, error)) ([]string, error) {
+	path := c.path(key)
+	if c.TTL > 0 {
+		if values, ok := c.load(path); ok {
+			return values, nil
+		}
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if c.TTL > 0 {
+		_ = c.save(path, values)
+	}
+	return values, nil
+}
+
+// path はキーに対応するキャッシュファイルのパスを返す
+// 名前に含まれる "/" などはエスケープし、プロファイル・リージョンごとのディレクトリに分ける
+func (c *Cache) path(key Key) string {
+	return filepath.Join(c.Dir, url.PathEscape(key.Profile), url.PathEscape(key.Region), url.PathEscape(key.Kind)+".json")
+}
+
+// load は有効期限内のキャッシュを読み込む
+func (c *Cache) load(path string) ([]string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+	if c.now().Sub(e.FetchedAt) >= c.TTL {
+		return nil, false
+	}
+	return e.Values, true
+}
+
+// save はキャッシュを一時ファイルに書き込んでから置き換える（並行する補完が途中の内容を読まないように）
+func (c *Cache) save(path string, values []string) error {
+	data, err := json.Marshal(entry{FetchedAt: c.now(), Values: values})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (c *Cache) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
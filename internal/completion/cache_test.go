@@ -0,0 +1,112 @@
+package completion
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCacheGet(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &Cache{Dir: t.TempDir(), TTL: time.Minute, Now: func() time.Time { return now }}
+	key := Key{Profile: "dev", Region: "ap-northeast-1", Kind: "stacks"}
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"stack-a", "stack-b"}, nil
+	}
+
+	tests := []struct {
+		name      string
+		advance   time.Duration
+		wantCalls int
+	}{
+		{name: "初回は取得する", wantCalls: 1},
+		{name: "有効期限内はキャッシュを返す", advance: 30 * time.Second, wantCalls: 1},
+		{name: "有効期限切れは取得し直す", advance: time.Minute, wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.advance)
+			got, err := c.Get(key, fetch)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if !slices.Equal(got, []string{"stack-a", "stack-b"}) {
+				t.Errorf("Get() = %v", got)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("fetch の呼び出し回数 = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCacheGet_KeySeparation(t *testing.T) {
+	c := &Cache{Dir: t.TempDir(), TTL: time.Minute}
+	keys := []Key{
+		{Profile: "dev", Region: "ap-northeast-1", Kind: "stacks"},
+		{Profile: "prod", Region: "ap-northeast-1", Kind: "stacks"},
+		{Profile: "dev", Region: "us-east-1", Kind: "stacks"},
+		{Profile: "dev", Region: "ap-northeast-1", Kind: "ecs-services/my-cluster"},
+	}
+
+	// プロファイル・リージョン・種類が異なればキャッシュを共有しない
+	for i, key := range keys {
+		value := key.Profile + "/" + key.Region + "/" + key.Kind
+		if _, err := c.Get(key, func() ([]string, error) { return []string{value}, nil }); err != nil {
+			t.Fatalf("Get(%d) error = %v", i, err)
+		}
+	}
+	for _, key := range keys {
+		got, _ := c.Get(key, func() ([]string, error) { return nil, errors.New("キャッシュされていません") })
+		if want := key.Profile + "/" + key.Region + "/" + key.Kind; !slices.Equal(got, []string{want}) {
+			t.Errorf("Get(%+v) = %v, want [%s]", key, got, want)
+		}
+	}
+}
+
+func TestCacheGet_Errors(t *testing.T) {
+	key := Key{Profile: "dev", Region: "ap-northeast-1", Kind: "stacks"}
+
+	t.Run("取得エラーはキャッシュしない", func(t *testing.T) {
+		c := &Cache{Dir: t.TempDir(), TTL: time.Minute}
+		if _, err := c.Get(key, func() ([]string, error) { return nil, errors.New("denied") }); err == nil {
+			t.Fatal("Get() error = nil, want error")
+		}
+		if _, err := os.Stat(c.path(key)); !os.IsNotExist(err) {
+			t.Errorf("エラー時にキャッシュファイルが作成されています: %v", err)
+		}
+	})
+
+	t.Run("壊れたキャッシュは取得し直す", func(t *testing.T) {
+		c := &Cache{Dir: t.TempDir(), TTL: time.Minute}
+		path := c.path(key)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("{broken"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.Get(key, func() ([]string, error) { return []string{"stack-a"}, nil })
+		if err != nil || !slices.Equal(got, []string{"stack-a"}) {
+			t.Errorf("Get() = (%v, %v), want [stack-a]", got, err)
+		}
+	})
+
+	t.Run("TTLが0ならキャッシュしない", func(t *testing.T) {
+		c := &Cache{Dir: t.TempDir(), TTL: 0}
+		calls := 0
+		for range 2 {
+			_, _ = c.Get(key, func() ([]string, error) { calls++; return []string{"stack-a"}, nil })
+		}
+		if calls != 2 {
+			t.Errorf("fetch の呼び出し回数 = %d, want 2", calls)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package config_test
+
+import (
+	"awstk/internal/config"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfig = `current-context: dev
+contexts:
+  dev:
+    profile: dev-profile
+    region: us-east-1
+    stack: dev-stack
+    ecs:
+      cluster: dev-cluster
+  prod:
+    profile: prod-profile
+`
+
+func writeConfig(t *testing.T, dir, body string) string {
+	t.Helper()
+	path := filepath.Join(dir, config.LocalFileName)
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("設定ファイルの作成に失敗: %v", err)
+	}
+	return path
+}
+
+func TestFindPath(t *testing.T) {
+	root := t.TempDir()
+	path := writeConfig(t, root, testConfig)
+	sub := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(sub)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	got, err := config.FindPath()
+	if err != nil {
+		t.Fatalf("FindPath() error = %v", err)
+	}
+	if got != path {
+		t.Errorf("FindPath() = %q, want %q", got, path)
+	}
+}
+
+func TestActive(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), testConfig)
+
+	tests := []struct {
+		name     string
+		envValue string
+		wantName string
+		wantErr  bool
+	}{
+		{name: "current-contextを使用", wantName: "dev"},
+		{name: "環境変数で上書き", envValue: "prod", wantName: "prod"},
+		{name: "存在しないコンテキスト", envValue: "staging", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(config.ContextEnvName, tt.envValue)
+			file, err := config.LoadFile(path)
+			if err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+			name, _, err := file.Active()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Active() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if name != tt.wantName {
+				t.Errorf("Active() name = %q, want %q", name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestUseAndSave(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), testConfig)
+	t.Setenv(config.ContextEnvName, "")
+
+	file, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if err := file.Use("missing"); err == nil {
+		t.Error("Use() に存在しないコンテキストを指定してもエラーになりませんでした")
+	}
+	if err := file.Use("prod"); err != nil {
+		t.Fatalf("Use() error = %v", err)
+	}
+	if err := file.Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	reloaded, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if reloaded.CurrentContext != "prod" {
+		t.Errorf("CurrentContext = %q, want %q", reloaded.CurrentContext, "prod")
+	}
+	if got := reloaded.Contexts["dev"].Ecs.Cluster; got != "dev-cluster" {
+		t.Errorf("dev の ecs.cluster = %q, want %q", got, "dev-cluster")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Setenv("AWSTK_TEST_REGION", "eu-west-1")
+
+	tests := []struct {
+		name       string
+		candidates []config.Candidate
+		wantValue  string
+		wantSource config.Source
+	}{
+		{
+			name: "フラグが最優先",
+			candidates: []config.Candidate{
+				config.FromFlag("-R", "us-west-2"),
+				config.FromEnv("AWSTK_TEST_REGION"),
+				config.FromContext("dev", "us-east-1"),
+			},
+			wantValue:  "us-west-2",
+			wantSource: config.SourceFlag,
+		},
+		{
+			name: "フラグ未指定なら環境変数",
+			candidates: []config.Candidate{
+				config.FromFlag("-R", ""),
+				config.FromEnv("AWSTK_TEST_REGION"),
+				config.FromContext("dev", "us-east-1"),
+			},
+			wantValue:  "eu-west-1",
+			wantSource: config.SourceEnv,
+		},
+		{
+			name: "環境変数未設定ならコンテキスト",
+			candidates: []config.Candidate{
+				config.FromEnv("AWSTK_TEST_UNSET"),
+				config.FromContext("dev", "us-east-1"),
+				config.Default("ap-northeast-1"),
+			},
+			wantValue:  "us-east-1",
+			wantSource: config.SourceContext,
+		},
+		{
+			name: "いずれもなければデフォルト",
+			candidates: []config.Candidate{
+				config.FromContext("dev", ""),
+				config.Default("ap-northeast-1"),
+			},
+			wantValue:  "ap-northeast-1",
+			wantSource: config.SourceDefault,
+		},
+		{
+			name:       "候補がすべて空",
+			candidates: []config.Candidate{config.FromFlag("-S", "")},
+			wantSource: config.SourceNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := config.Resolve("region", tt.candidates...)
+			if got.Value != tt.wantValue || got.Source != tt.wantSource {
+				t.Errorf("Resolve() = (%q, %q), want (%q, %q)", got.Value, got.Source, tt.wantValue, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestGroup(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), `groups:
+  dev:
+    tiers:
+      - name: db
+        resources:
+          - {type: aurora, id: dev-cluster}
+      - name: app
+        resources:
+          - {type: ecs, id: dev-cluster/api, min: 1, max: 3}
+          - {type: ec2, tag: [env=dev]}
+  batch:
+    tiers: []
+`)
+	file, err := config.LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if got := file.GroupNames(); len(got) != 2 || got[0] != "batch" || got[1] != "dev" {
+		t.Errorf("GroupNames() = %v, want [batch dev]", got)
+	}
+
+	g, err := file.Group("dev")
+	if err != nil {
+		t.Fatalf("Group() error = %v", err)
+	}
+	if len(g.Tiers) != 2 || g.Tiers[1].Resources[0].Max != 3 || g.Tiers[1].Resources[1].Tag[0] != "env=dev" {
+		t.Errorf("Group() = %+v", g)
+	}
+	if _, err := file.Group("missing"); err == nil {
+		t.Error("Group() に存在しないグループを指定してもエラーになりませんでした")
+	}
+}
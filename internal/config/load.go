@@ -0,0 +1,175 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// LocalFileName はリポジトリローカルの設定ファイル名
+	LocalFileName = ".awstk.yaml"
+	// ContextEnvName はアクティブなコンテキストを上書きする環境変数名
+	ContextEnvName = "AWSTK_CONTEXT"
+)
+
+// GlobalPath はユーザー共通の設定ファイルパスを返します
+// XDG_CONFIG_HOME が設定されていればそれを優先します
+func GlobalPath() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "awstk", "config.yaml"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("ホームディレクトリの取得に失敗: %w", err)
+	}
+	return filepath.Join(home, ".config", "awstk", "config.yaml"), nil
+}
+
+// FindPath は読み込む設定ファイルのパスを返します
+// カレントディレクトリから親方向に .awstk.yaml を探し、見つからなければグローバル設定を返します
+// どちらも存在しない場合は空文字を返します
+func FindPath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("カレントディレクトリの取得に失敗: %w", err)
+	}
+	for {
+		candidate := filepath.Join(dir, LocalFileName)
+		if fileExists(candidate) {
+			return candidate, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	global, err := GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	if fileExists(global) {
+		return global, nil
+	}
+	return "", nil
+}
+
+// Load は設定ファイルを探して読み込みます
+// 設定ファイルが存在しない場合は空の設定を返します
+func Load() (*File, error) {
+	path, err := FindPath()
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return &File{}, nil
+	}
+	return LoadFile(path)
+}
+
+// LoadFile は指定したパスの設定ファイルを読み込みます
+func LoadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("設定ファイルの読み込みに失敗: %w", err)
+	}
+	file := &File{}
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("設定ファイル %s の解析に失敗: %w", path, err)
+	}
+	file.Path = path
+	if file.CurrentContext != "" && file.Contexts[file.CurrentContext] == nil {
+		return nil, fmt.Errorf("設定ファイル %s の current-context '%s' が contexts に定義されていません", path, file.CurrentContext)
+	}
+	return file, nil
+}
+
+// Save は設定ファイルを読み込み元のパスに書き戻します
+func (f *File) Save() error {
+	if f.Path == "" {
+		return errors.New("設定ファイルのパスが不明です")
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(f); err != nil {
+		return fmt.Errorf("設定ファイルの変換に失敗: %w", err)
+	}
+	if err := os.WriteFile(f.Path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("設定ファイルの書き込みに失敗: %w", err)
+	}
+	return nil
+}
+
+// ActiveName はアクティブなコンテキスト名を返します
+// 環境変数 AWSTK_CONTEXT が設定されていれば current-context より優先します
+func (f *File) ActiveName() string {
+	if name := os.Getenv(ContextEnvName); name != "" {
+		return name
+	}
+	return f.CurrentContext
+}
+
+// Active はアクティブなコンテキストを返します
+// コンテキストが選択されていない場合は nil を返します
+func (f *File) Active() (string, *Context, error) {
+	name := f.ActiveName()
+	if name == "" {
+		return "", nil, nil
+	}
+	c, ok := f.Contexts[name]
+	if !ok || c == nil {
+		return "", nil, fmt.Errorf("コンテキスト '%s' が見つかりません", name)
+	}
+	return name, c, nil
+}
+
+// Use はカレントコンテキストを切り替えます（保存は行いません）
+func (f *File) Use(name string) error {
+	if _, ok := f.Contexts[name]; !ok {
+		return fmt.Errorf("コンテキスト '%s' が見つかりません (利用可能: %v)", name, f.Names())
+	}
+	f.CurrentContext = name
+	return nil
+}
+
+// Names はコンテキスト名をソートして返します
+func (f *File) Names() []string {
+	names := make([]string, 0, len(f.Contexts))
+	for name := range f.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Group は名前を指定してグループの定義を返します
+func (f *File) Group(name string) (*Group, error) {
+	g, ok := f.Groups[name]
+	if !ok || g == nil {
+		return nil, fmt.Errorf("グループ '%s' が設定ファイルに定義されていません (利用可能: %v)", name, f.GroupNames())
+	}
+	return g, nil
+}
+
+// GroupNames はグループ名をソートして返します
+func (f *File) GroupNames() []string {
+	names := make([]string, 0, len(f.Groups))
+	for name := range f.Groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
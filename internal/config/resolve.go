@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Resolve は候補のうち最初に値が設定されているものを採用します
+// 候補は flag > 環境変数 > コンテキスト > デフォルト の順に渡してください
+func Resolve(key string, candidates ...Candidate) Setting {
+	for _, c := range candidates {
+		if c.Value != "" {
+			return Setting{Key: key, Value: c.Value, Source: c.Source, Origin: c.Origin}
+		}
+	}
+	return Setting{Key: key}
+}
+
+// FromFlag はフラグで指定された値の候補を返します
+func FromFlag(name, value string) Candidate {
+	return Candidate{Value: value, Source: SourceFlag, Origin: name}
+}
+
+// FromEnv は環境変数の値の候補を返します
+func FromEnv(name string) Candidate {
+	return Candidate{Value: os.Getenv(name), Source: SourceEnv, Origin: name}
+}
+
+// FromContext はコンテキストの値の候補を返します
+func FromContext(name, value string) Candidate {
+	return Candidate{Value: value, Source: SourceContext, Origin: name}
+}
+
+// Default は組み込みのデフォルト値の候補を返します
+func Default(value string) Candidate {
+	return Candidate{Value: value, Source: SourceDefault}
+}
+
+// SourceLabel は取得元を表示用の文字列で返します
+func (s Setting) SourceLabel() string {
+	switch s.Source {
+	case SourceFlag:
+		return fmt.Sprintf("フラグ (%s)", s.Origin)
+	case SourceEnv:
+		return fmt.Sprintf("環境変数 (%s)", s.Origin)
+	case SourceContext:
+		return fmt.Sprintf("コンテキスト (%s)", s.Origin)
+	case SourceDefault:
+		return "デフォルト"
+	default:
+		return "-"
+	}
+}
@@ -0,0 +1,97 @@
+package config
+
+// File は設定ファイル（.awstk.yaml / ~/.config/awstk/config.yaml）の内容
+type File struct {
+	CurrentContext string              `yaml:"current-context,omitempty"`
+	Contexts       map[string]*Context `yaml:"contexts,omitempty"`
+	Audit          Audit               `yaml:"audit,omitempty"`
+	Plugins        map[string]Plugin   `yaml:"plugins,omitempty"`
+	Groups         map[string]*Group   `yaml:"groups,omitempty"`
+
+	// Path は読み込み元のファイルパス（ファイルが存在しない場合は空）
+	Path string `yaml:"-"`
+}
+
+// Context は名前付きコンテキストの設定値
+type Context struct {
+	Profile string `yaml:"profile,omitempty"`
+	Region  string `yaml:"region,omitempty"`
+	Stack   string `yaml:"stack,omitempty"`
+	// EndpointURL は全サービス共通のエンドポイントURL（LocalStack など）
+	EndpointURL string `yaml:"endpoint-url,omitempty"`
+	// Endpoints はサービスごとのエンドポイントURL（キーはサービス名 e.g., s3, cloudwatch-logs）
+	Endpoints  map[string]string `yaml:"endpoints,omitempty"`
+	Ecs        EcsContext        `yaml:"ecs,omitempty"`
+	CloudFront CloudFrontContext `yaml:"cloudfront,omitempty"`
+}
+
+// EcsContext はECSコマンドのデフォルト値
+type EcsContext struct {
+	Cluster   string `yaml:"cluster,omitempty"`
+	Service   string `yaml:"service,omitempty"`
+	Container string `yaml:"container,omitempty"`
+}
+
+// CloudFrontContext はCloudFrontコマンドのデフォルト値
+type CloudFrontContext struct {
+	Distribution string `yaml:"distribution,omitempty"`
+}
+
+// Audit は監査ログの設定
+type Audit struct {
+	Path     string `yaml:"path,omitempty"`     // 監査ログの出力先（未指定時は ~/.local/state/awstk/audit.jsonl）
+	Disabled bool   `yaml:"disabled,omitempty"` // 監査ログを記録しない
+}
+
+// Plugin はプラグイン（PATH 上の awstk-<name>）ごとの設定
+type Plugin struct {
+	ContextStdin bool `yaml:"context-stdin,omitempty"` // 標準入力に実行コンテキストのJSONを渡す
+}
+
+// Group は group コマンドでまとめて起動・停止するリソースのグループ
+// Tiers は起動する順に並べる（停止時は逆順に処理する）
+type Group struct {
+	Tiers []GroupTier `yaml:"tiers"`
+}
+
+// GroupTier は同時に起動・停止するリソースの段階
+type GroupTier struct {
+	Name      string          `yaml:"name,omitempty"`
+	Resources []GroupResource `yaml:"resources"`
+}
+
+// GroupResource はグループに含めるリソース（id と tag のどちらか一方を指定する）
+type GroupResource struct {
+	Type string   `yaml:"type"`          // ec2, rds, aurora, ecs, canary, schedule
+	Id   string   `yaml:"id,omitempty"`  // リソースID（ECSサービスは <クラスター名>/<サービス名>）
+	Tag  []string `yaml:"tag,omitempty"` // タグの条件（key=value, key!=value, key）
+	Min  int      `yaml:"min,omitempty"` // ECSサービスの最小キャパシティ（省略時は1）
+	Max  int      `yaml:"max,omitempty"` // ECSサービスの最大キャパシティ（省略時は2）
+}
+
+// Source は設定値の取得元の種類
+type Source string
+
+const (
+	SourceFlag    Source = "flag"
+	SourceEnv     Source = "env"
+	SourceContext Source = "context"
+	SourceDefault Source = "default"
+	SourceNone    Source = ""
+)
+
+// Setting は解決済みの設定値とその取得元
+type Setting struct {
+	Key    string // 設定項目名 (e.g., profile)
+	Value  string // 解決された値（未設定の場合は空）
+	Source Source // 取得元の種類
+	Origin string // 取得元の詳細（フラグ名・環境変数名・コンテキスト名）
+}
+
+// Candidate は設定値の候補
+// Resolve には優先度の高い順に渡す
+type Candidate struct {
+	Value  string
+	Source Source
+	Origin string
+}
@@ -0,0 +1,236 @@
+// Package i18n はユーザー向けメッセージのカタログ（言語ごとのバンドル）を管理します
+//
+// メッセージは "header.name" のようなキーで参照し、現在の言語のバンドルに
+// キーがない場合は既定言語（日本語）、それもない場合はキーそのものを返します。
+// 日本語・英語のバンドルは組み込みで、Register / LoadDir で言語を追加・上書きできます。
+package i18n
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Lang は表示言語
+type Lang string
+
+const (
+	Ja Lang = "ja"
+	En Lang = "en"
+
+	// Default は言語が指定されていない場合の表示言語
+	Default = Ja
+	// EnvName は表示言語を指定する環境変数名
+	EnvName = "AWSTK_LANG"
+)
+
+//go:embed locales/*.yaml
+var localeFS embed.FS
+
+var (
+	mu      sync.RWMutex
+	bundles = map[Lang]map[string]string{}
+	current = Default
+)
+
+func init() {
+	entries, err := localeFS.ReadDir("locales")
+	if err != nil {
+		panic(err)
+	}
+	for _, e := range entries {
+		data, err := localeFS.ReadFile("locales/" + e.Name())
+		if err != nil {
+			panic(err)
+		}
+		if err := loadBundle(langOfFile(e.Name()), data); err != nil {
+			panic(fmt.Sprintf("組み込みメッセージ %s の読み込みに失敗: %v", e.Name(), err))
+		}
+	}
+}
+
+// Register は言語 lang のメッセージを登録します（既存のキーは上書きされます）
+func Register(lang Lang, messages map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+	bundle, ok := bundles[lang]
+	if !ok {
+		bundle = map[string]string{}
+		bundles[lang] = bundle
+	}
+	for key, msg := range messages {
+		bundle[key] = msg
+	}
+}
+
+// LoadDir はディレクトリ内の <言語>.yaml をすべて読み込んで登録します
+// ディレクトリが存在しない場合は何もしません
+func LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("メッセージディレクトリ %s の読み込みに失敗: %w", dir, err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || (filepath.Ext(e.Name()) != ".yaml" && filepath.Ext(e.Name()) != ".yml") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("メッセージファイル %s の読み込みに失敗: %w", path, err)
+		}
+		if err := loadBundle(langOfFile(e.Name()), data); err != nil {
+			return fmt.Errorf("メッセージファイル %s の解析に失敗: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Languages は登録されている言語を名前順に返します
+func Languages() []Lang {
+	mu.RLock()
+	defer mu.RUnlock()
+	langs := make([]Lang, 0, len(bundles))
+	for lang := range bundles {
+		langs = append(langs, lang)
+	}
+	sort.Slice(langs, func(i, j int) bool { return langs[i] < langs[j] })
+	return langs
+}
+
+// SetLang は表示言語を設定します
+func SetLang(lang Lang) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = lang
+}
+
+// Current は現在の表示言語を返します
+func Current() Lang {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// Resolve は表示言語を フラグ > AWSTK_LANG > LANG の順に決定します
+// フラグ・AWSTK_LANG で未登録の言語が指定された場合はエラーを返します
+// LANG は C / POSIX / 未設定の場合に既定言語、未登録の言語の場合は英語とみなします
+func Resolve(flag string) (Lang, error) {
+	for _, value := range []string{flag, os.Getenv(EnvName)} {
+		if value == "" {
+			continue
+		}
+		lang, ok := Parse(value)
+		if !ok {
+			return Default, fmt.Errorf("未対応の言語です: %s (%s のいずれかを指定してください)", value, joinLangs(Languages()))
+		}
+		return lang, nil
+	}
+
+	value := os.Getenv("LANG")
+	if value == "" || value == "C" || value == "POSIX" || strings.HasPrefix(value, "C.") {
+		return Default, nil
+	}
+	if lang, ok := Parse(value); ok {
+		return lang, nil
+	}
+	return En, nil
+}
+
+// Parse は "en" や "ja_JP.UTF-8" のような値を登録済みの言語に変換します
+func Parse(value string) (Lang, bool) {
+	name := strings.ToLower(value)
+	if i := strings.IndexAny(name, "_-.@"); i >= 0 {
+		name = name[:i]
+	}
+	lang := Lang(name)
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := bundles[lang]
+	return lang, ok
+}
+
+// T はキーに対応する現在の言語のメッセージを返します
+func T(key string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if msg, ok := bundles[current][key]; ok {
+		return msg
+	}
+	if msg, ok := bundles[Default][key]; ok {
+		return msg
+	}
+	return key
+}
+
+// Tf はキーに対応するメッセージをフォーマットとして args を埋め込んだ文字列を返します
+func Tf(key string, args ...any) string {
+	return fmt.Sprintf(T(key), args...)
+}
+
+// Lookup は現在の言語のバンドルにキーがあればそのメッセージを返します（既定言語へのフォールバックはしません）
+func Lookup(key string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	msg, ok := bundles[current][key]
+	return msg, ok
+}
+
+// loadBundle はYAMLのメッセージ定義を読み込み、ネストしたキーを "." で連結して登録します
+func loadBundle(lang Lang, data []byte) error {
+	var tree map[string]any
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return err
+	}
+	messages := map[string]string{}
+	if err := flatten("", tree, messages); err != nil {
+		return err
+	}
+	Register(lang, messages)
+	return nil
+}
+
+// flatten はネストしたマップを "a.b.c" 形式のキーに展開します
+func flatten(prefix string, tree map[string]any, out map[string]string) error {
+	for key, value := range tree {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case string:
+			out[key] = v
+		case map[string]any:
+			if err := flatten(key, v, out); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("キー %s の値は文字列である必要があります", key)
+		}
+	}
+	return nil
+}
+
+// langOfFile はファイル名（ja.yaml など）から言語を取り出します
+func langOfFile(name string) Lang {
+	return Lang(strings.ToLower(strings.TrimSuffix(name, filepath.Ext(name))))
+}
+
+// joinLangs は言語一覧を "|" 区切りの文字列にします
+func joinLangs(langs []Lang) string {
+	names := make([]string, len(langs))
+	for i, lang := range langs {
+		names[i] = string(lang)
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,124 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useLang はテスト中だけ表示言語を切り替える
+func useLang(t *testing.T, lang Lang) {
+	t.Helper()
+	prev := Current()
+	SetLang(lang)
+	t.Cleanup(func() { SetLang(prev) })
+}
+
+// TestBundlesHaveSameKeys はコマンドのヘルプ以外のメッセージが日本語・英語で揃っていることを確認する
+func TestBundlesHaveSameKeys(t *testing.T) {
+	for key := range bundles[Ja] {
+		if _, ok := bundles[En][key]; !ok {
+			t.Errorf("英語のメッセージ %s が未定義です", key)
+		}
+	}
+	for key := range bundles[En] {
+		if strings.HasPrefix(key, "cmd.") || key == "cmd" {
+			continue
+		}
+		if _, ok := bundles[Ja][key]; !ok {
+			t.Errorf("日本語のメッセージ %s が未定義です", key)
+		}
+	}
+}
+
+func TestT(t *testing.T) {
+	Register(Ja, map[string]string{"test.only_ja": "日本語のみ"})
+	t.Cleanup(func() { delete(bundles[Ja], "test.only_ja") })
+
+	tests := []struct {
+		name string
+		lang Lang
+		key  string
+		want string
+	}{
+		{name: "日本語", lang: Ja, key: "header.name", want: "名前"},
+		{name: "英語", lang: En, key: "header.name", want: "Name"},
+		{name: "英語にないキーは日本語にフォールバック", lang: En, key: "test.only_ja", want: "日本語のみ"},
+		{name: "未定義のキーはキーそのもの", lang: En, key: "test.undefined", want: "test.undefined"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useLang(t, tt.lang)
+			if got := T(tt.key); got != tt.want {
+				t.Errorf("T(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+
+	useLang(t, En)
+	if got := Tf("list.total", 3); got != "Total: 3" {
+		t.Errorf("Tf() = %q, want %q", got, "Total: 3")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		lang    string
+		want    Lang
+		wantErr bool
+	}{
+		{name: "未指定は日本語", want: Ja},
+		{name: "LANGが英語", lang: "en_US.UTF-8", want: En},
+		{name: "LANGが日本語", lang: "ja_JP.UTF-8", want: Ja},
+		{name: "LANGがCは既定言語", lang: "C.UTF-8", want: Ja},
+		{name: "LANGが未対応の言語は英語", lang: "fr_FR.UTF-8", want: En},
+		{name: "AWSTK_LANGはLANGより優先", env: "ja", lang: "en_US.UTF-8", want: Ja},
+		{name: "フラグは環境変数より優先", flag: "en", env: "ja", want: En},
+		{name: "未対応の言語を明示するとエラー", flag: "fr", want: Ja, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvName, tt.env)
+			t.Setenv("LANG", tt.lang)
+			got, err := Resolve(tt.flag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	content := "header:\n  name: \"Nom\"\ncmd:\n  short: \"Outil CLI\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "fr.yaml"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadDir(dir); err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	t.Cleanup(func() { delete(bundles, "fr") })
+
+	lang, ok := Parse("fr_FR.UTF-8")
+	if !ok || lang != "fr" {
+		t.Fatalf("Parse() = %q, %v, want fr", lang, ok)
+	}
+	useLang(t, lang)
+	if got := T("header.name"); got != "Nom" {
+		t.Errorf("T() = %q, want %q", got, "Nom")
+	}
+	if got := T("header.status"); got != "ステータス" {
+		t.Errorf("未翻訳のキー = %q, want 日本語にフォールバック", got)
+	}
+
+	if err := LoadDir(filepath.Join(dir, "missing")); err != nil {
+		t.Errorf("存在しないディレクトリで LoadDir() error = %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package mcp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Schema はツールの引数を表すJSON Schema（MCPで使う範囲のサブセット）
+type Schema struct {
+	Type                 string             `json:"type"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+}
+
+// SchemaFor はオプション構造体の型からJSON Schemaを生成します
+//
+// プロパティ名は json タグから取り、omitempty の付いたフィールドは任意、それ以外は必須とします。
+// desc タグは説明、enum タグ（カンマ区切り）は取り得る値になります。json:"-" のフィールドは含めません。
+func SchemaFor(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("引数の型は構造体である必要があります: %s", t)
+	}
+
+	noAdditional := false
+	schema := &Schema{
+		Type:                 "object",
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: &noAdditional,
+	}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, omitempty, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+
+		prop, err := schemaForType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+		}
+		prop.Description = field.Tag.Get("desc")
+		if enum := field.Tag.Get("enum"); enum != "" {
+			prop.Enum = strings.Split(enum, ",")
+		}
+		schema.Properties[name] = prop
+		if !omitempty {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema, nil
+}
+
+// jsonName は json タグからプロパティ名と omitempty の有無を返す（json:"-" の場合は ok=false）
+func jsonName(field reflect.StructField) (name string, omitempty bool, ok bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, strings.Contains(","+opts+",", ",omitempty,"), true
+}
+
+// schemaForType はフィールドの型に対応するスキーマを返す
+func schemaForType(t reflect.Type) (*Schema, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Slice:
+		items, err := schemaForType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	default:
+		return nil, fmt.Errorf("未対応の型です: %s", t)
+	}
+}
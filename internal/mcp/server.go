@@ -0,0 +1,263 @@
+// Package mcp は Model Context Protocol (MCP) のサーバーを提供します
+//
+// 標準入出力上で改行区切りの JSON-RPC 2.0 メッセージをやり取りし、
+// 登録したツールの一覧取得 (tools/list) と呼び出し (tools/call) に応答します。
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+)
+
+// SupportedProtocolVersions は対応するMCPのプロトコルバージョン（新しい順）
+var SupportedProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// JSON-RPC のエラーコード
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// maxMessageSize は1メッセージ（1行）の最大サイズ
+const maxMessageSize = 16 * 1024 * 1024
+
+// Annotations はクライアントに伝えるツールの性質
+type Annotations struct {
+	ReadOnlyHint    bool `json:"readOnlyHint"`    // AWSリソースを変更しない
+	DestructiveHint bool `json:"destructiveHint"` // 元に戻せない変更を行う可能性がある
+}
+
+// Tool はMCPクライアントから呼び出せるツール
+type Tool struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	InputSchema *Schema     `json:"inputSchema"`
+	Annotations Annotations `json:"annotations"`
+
+	call func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+// NewTool は引数の構造体 A からスキーマを生成してツールを作成します
+// 呼び出し時の引数は A にデコードして fn に渡し、fn の戻り値をJSONにしてクライアントへ返します
+// A がスキーマに変換できない型の場合は panic します（ツール定義の誤りのため）
+func NewTool[A any](name, description string, annotations Annotations, fn func(ctx context.Context, args A) (any, error)) Tool {
+	schema, err := SchemaFor(reflect.TypeFor[A]())
+	if err != nil {
+		panic(fmt.Sprintf("mcp: ツール %s の引数: %v", name, err))
+	}
+	return Tool{
+		Name:        name,
+		Description: description,
+		InputSchema: schema,
+		Annotations: annotations,
+		call: func(ctx context.Context, raw json.RawMessage) (any, error) {
+			var args A
+			if len(raw) > 0 && !bytes.Equal(raw, []byte("null")) {
+				dec := json.NewDecoder(bytes.NewReader(raw))
+				dec.DisallowUnknownFields()
+				if err := dec.Decode(&args); err != nil {
+					return nil, &invalidParamsError{err: err}
+				}
+			}
+			return fn(ctx, args)
+		},
+	}
+}
+
+// invalidParamsError はツールの引数が不正であることを表すエラー
+type invalidParamsError struct {
+	err error
+}
+
+func (e *invalidParamsError) Error() string {
+	return fmt.Sprintf("引数が不正です: %v", e.err)
+}
+
+// Server はMCPサーバー
+type Server struct {
+	Name    string
+	Version string
+	tools   []Tool
+}
+
+// NewServer はツールを登録したサーバーを生成します
+func NewServer(name, version string, tools ...Tool) *Server {
+	return &Server{Name: name, Version: version, tools: tools}
+}
+
+// request は JSON-RPC のリクエスト（id がなければ通知）
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response は JSON-RPC のレスポンス
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// content はツールの実行結果に含めるテキスト
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// callResult は tools/call の結果
+type callResult struct {
+	Content []content `json:"content"`
+	IsError bool      `json:"isError,omitempty"`
+}
+
+// Serve は r から1行ずつメッセージを読み、応答を w に書き込みます
+// r が EOF に達するか ctx がキャンセルされると終了します
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if resp := s.handle(ctx, line); resp != nil {
+			if err := enc.Encode(resp); err != nil {
+				return fmt.Errorf("レスポンスの書き込みに失敗: %w", err)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// handle は1件のメッセージを処理し、返すべきレスポンスを返す（通知の場合は nil）
+func (s *Server) handle(ctx context.Context, line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		if !json.Valid(line) {
+			return errorResponse(nil, codeParseError, err.Error())
+		}
+		return errorResponse(nil, codeInvalidRequest, err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, codeInvalidRequest, "jsonrpc 2.0 のリクエストではありません")
+	}
+	if req.ID == nil {
+		// 通知（notifications/initialized など）には応答しない
+		return nil
+	}
+
+	switch req.Method {
+	case "initialize":
+		return resultResponse(req.ID, s.initialize(req.Params))
+	case "ping":
+		return resultResponse(req.ID, struct{}{})
+	case "tools/list":
+		return resultResponse(req.ID, map[string]any{"tools": s.tools})
+	case "tools/call":
+		result, rpcErr := s.callTool(ctx, req.Params)
+		if rpcErr != nil {
+			return &response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+		}
+		return resultResponse(req.ID, result)
+	default:
+		return errorResponse(req.ID, codeMethodNotFound, "未対応のメソッドです: "+req.Method)
+	}
+}
+
+// initialize はクライアントが要求したプロトコルバージョンに対応していればそれを、そうでなければ最新のものを返す
+func (s *Server) initialize(params json.RawMessage) map[string]any {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	_ = json.Unmarshal(params, &p)
+
+	version := SupportedProtocolVersions[0]
+	if slices.Contains(SupportedProtocolVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{"tools": map[string]any{}},
+		"serverInfo":      map[string]string{"name": s.Name, "version": s.Version},
+	}
+}
+
+// callTool はツールを実行する
+// ツールの実行エラーはプロトコルのエラーではなく isError の結果として返し、クライアント（モデル）が内容を読めるようにする
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (*callResult, *rpcError) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	idx := slices.IndexFunc(s.tools, func(t Tool) bool { return t.Name == p.Name })
+	if idx < 0 {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "未登録のツールです: " + p.Name}
+	}
+
+	value, err := s.tools[idx].call(ctx, p.Arguments)
+	if err != nil {
+		var invalid *invalidParamsError
+		if errors.As(err, &invalid) {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return &callResult{Content: []content{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+
+	text, err := marshalText(value)
+	if err != nil {
+		return &callResult{Content: []content{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	return &callResult{Content: []content{{Type: "text", Text: text}}}, nil
+}
+
+// marshalText はツールの戻り値をテキストにする（文字列はそのまま、それ以外はインデント付きJSON）
+func marshalText(v any) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return "", fmt.Errorf("結果のJSON変換に失敗: %w", err)
+	}
+	return string(bytes.TrimRight(buf.Bytes(), "\n")), nil
+}
+
+func resultResponse(id json.RawMessage, result any) *response {
+	return &response{JSONRPC: "2.0", ID: id, Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *response {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
@@ -0,0 +1,174 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type testArgs struct {
+	Stacks      []string `json:"stacks,omitempty" desc:"スタック名"`
+	Type        string   `json:"type" enum:"all,rule"`
+	All         bool     `json:"all,omitempty"`
+	Limit       int      `json:"limit,omitempty"`
+	ShowDetails bool     `json:"-"`
+}
+
+func TestSchemaFor(t *testing.T) {
+	schema, err := SchemaFor(reflect.TypeFor[testArgs]())
+	if err != nil {
+		t.Fatalf("SchemaFor() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		prop string
+		want Schema
+	}{
+		{name: "文字列の配列", prop: "stacks", want: Schema{Type: "array", Items: &Schema{Type: "string"}, Description: "スタック名"}},
+		{name: "enumタグ", prop: "type", want: Schema{Type: "string", Enum: []string{"all", "rule"}}},
+		{name: "真偽値", prop: "all", want: Schema{Type: "boolean"}},
+		{name: "整数", prop: "limit", want: Schema{Type: "integer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := schema.Properties[tt.prop]
+			if !ok {
+				t.Fatalf("プロパティ %s がありません", tt.prop)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Properties[%s] = %+v, want %+v", tt.prop, *got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := schema.Properties["ShowDetails"]; ok {
+		t.Error(`json:"-" のフィールドがスキーマに含まれています`)
+	}
+	if !slices.Equal(schema.Required, []string{"type"}) {
+		t.Errorf("Required = %v, want [type]", schema.Required)
+	}
+
+	if _, err := SchemaFor(reflect.TypeFor[string]()); err == nil {
+		t.Error("構造体以外で SchemaFor() error = nil")
+	}
+}
+
+// serve はメッセージを1行ずつ送り、応答をIDごとにまとめて返す
+func serve(t *testing.T, s *Server, messages ...string) map[string]response {
+	t.Helper()
+	var out bytes.Buffer
+	if err := s.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	responses := map[string]response{}
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var resp struct {
+			response
+			Result json.RawMessage `json:"result"`
+		}
+		if err := dec.Decode(&resp); err != nil {
+			t.Fatalf("レスポンスのデコードに失敗: %v", err)
+		}
+		resp.response.Result = resp.Result
+		responses[string(resp.ID)] = resp.response
+	}
+	return responses
+}
+
+func TestServer(t *testing.T) {
+	var called testArgs
+	s := NewServer("awstk", "test",
+		NewTool("echo", "引数をそのまま返す", Annotations{ReadOnlyHint: true}, func(_ context.Context, args testArgs) (any, error) {
+			called = args
+			return args, nil
+		}),
+		NewTool("fail", "常に失敗する", Annotations{}, func(context.Context, testArgs) (any, error) {
+			return nil, errors.New("スタックが見つかりません")
+		}),
+	)
+
+	responses := serve(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"echo","arguments":{"type":"rule","stacks":["a"]}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"fail","arguments":{}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"echo","arguments":{"unknown":true}}}`,
+		`{"jsonrpc":"2.0","id":6,"method":"tools/call","params":{"name":"missing"}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"resources/list"}`,
+		`{broken`,
+	)
+
+	if len(responses) != 8 {
+		t.Errorf("レスポンス数 = %d, want 8（通知には応答しない）", len(responses))
+	}
+
+	t.Run("initialize は要求されたバージョンを返す", func(t *testing.T) {
+		var result struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		_ = json.Unmarshal(responses["1"].Result.(json.RawMessage), &result)
+		if result.ProtocolVersion != "2025-03-26" {
+			t.Errorf("protocolVersion = %q", result.ProtocolVersion)
+		}
+	})
+
+	t.Run("tools/list はスキーマと注釈を返す", func(t *testing.T) {
+		var result struct {
+			Tools []Tool `json:"tools"`
+		}
+		_ = json.Unmarshal(responses["2"].Result.(json.RawMessage), &result)
+		if len(result.Tools) != 2 || result.Tools[0].Name != "echo" || !result.Tools[0].Annotations.ReadOnlyHint {
+			t.Fatalf("tools = %+v", result.Tools)
+		}
+		if result.Tools[0].InputSchema.Properties["type"] == nil {
+			t.Error("inputSchema に type がありません")
+		}
+	})
+
+	t.Run("tools/call は引数をデコードして結果をテキストで返す", func(t *testing.T) {
+		if called.Type != "rule" || !slices.Equal(called.Stacks, []string{"a"}) {
+			t.Errorf("引数 = %+v", called)
+		}
+		var result callResult
+		_ = json.Unmarshal(responses["3"].Result.(json.RawMessage), &result)
+		if result.IsError || len(result.Content) != 1 || !strings.Contains(result.Content[0].Text, `"rule"`) {
+			t.Errorf("result = %+v", result)
+		}
+	})
+
+	t.Run("ツールのエラーは isError で返す", func(t *testing.T) {
+		var result callResult
+		_ = json.Unmarshal(responses["4"].Result.(json.RawMessage), &result)
+		if !result.IsError || result.Content[0].Text != "スタックが見つかりません" {
+			t.Errorf("result = %+v", result)
+		}
+	})
+
+	errorCodes := []struct {
+		name string
+		id   string
+		code int
+	}{
+		{name: "未知の引数", id: "5", code: codeInvalidParams},
+		{name: "未登録のツール", id: "6", code: codeInvalidParams},
+		{name: "未対応のメソッド", id: "7", code: codeMethodNotFound},
+		{name: "不正なJSON", id: "null", code: codeParseError},
+	}
+	for _, tt := range errorCodes {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, ok := responses[tt.id]
+			if !ok || resp.Error == nil || resp.Error.Code != tt.code {
+				t.Errorf("response = %+v, want error code %d", resp, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Package plugin は PATH 上の awstk-<name> 実行ファイルをサブコマンドとして扱うプラグイン機構を提供します
+//
+// git や kubectl と同様に、awstk-hello という実行ファイルがあれば `awstk hello` で呼び出せます。
+// プラグインには解決済みのプロファイル・リージョンなどを環境変数（必要なら標準入力のJSON）で渡します。
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Prefix はプラグインの実行ファイル名の接頭辞
+const Prefix = "awstk-"
+
+// windowsExts はWindowsでプラグインとして扱う拡張子
+var windowsExts = []string{".exe", ".bat", ".cmd"}
+
+// Plugin は PATH 上で見つかったプラグイン
+type Plugin struct {
+	Name string // サブコマンド名（接頭辞と拡張子を除いた名前）
+	Path string // 実行ファイルのパス
+}
+
+// Discover は PATH 形式のディレクトリ一覧からプラグインを探します
+// 同じ名前のプラグインが複数ある場合は、PATH で先に見つかったものを使います（名前順で返します）
+func Discover(pathList string) []Plugin {
+	var plugins []Plugin
+	seen := map[string]bool{}
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: path})
+		}
+	}
+	slices.SortFunc(plugins, func(a, b Plugin) int { return strings.Compare(a.Name, b.Name) })
+	return plugins
+}
+
+// pluginName はファイル名からサブコマンド名を返す（プラグインでなければ ok=false）
+func pluginName(fileName string) (string, bool) {
+	name, ok := strings.CutPrefix(fileName, Prefix)
+	if !ok {
+		return "", false
+	}
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if !slices.Contains(windowsExts, ext) {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	// サブコマンド名として使えない名前（空・フラグと紛らわしいもの・空白を含むもの）は無視する
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t") {
+		return "", false
+	}
+	return name, true
+}
+
+// isExecutable は実行可能な通常ファイル（またはそれへのシンボリックリンク）かどうかを判定する
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// Context はプラグインに渡す実行コンテキスト
+type Context struct {
+	Profile    string `json:"profile,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stack      string `json:"stack,omitempty"`
+	Output     string `json:"output"`
+	Context    string `json:"context,omitempty"`    // アクティブな .awstk.yaml のコンテキスト名
+	Version    string `json:"version"`              // awstk のバージョン
+	Executable string `json:"executable,omitempty"` // awstk の実行ファイルのパス（プラグインから awstk を呼び出す場合に使う）
+}
+
+// Env はプラグインに渡す環境変数を返します
+// AWS SDK・AWS CLI がそのまま使えるよう、プロファイルとリージョンは AWS_PROFILE / AWS_REGION にも設定します
+func (c Context) Env() []string {
+	env := []string{
+		"AWSTK_PROFILE=" + c.Profile,
+		"AWSTK_REGION=" + c.Region,
+		"AWSTK_STACK=" + c.Stack,
+		"AWSTK_OUTPUT=" + c.Output,
+		"AWSTK_VERSION=" + c.Version,
+		"AWSTK_BIN=" + c.Executable,
+	}
+	if c.Context != "" {
+		env = append(env, "AWSTK_CONTEXT="+c.Context)
+	}
+	if c.Profile != "" {
+		env = append(env, "AWS_PROFILE="+c.Profile)
+	}
+	if c.Region != "" {
+		env = append(env, "AWS_REGION="+c.Region)
+	}
+	return env
+}
+
+// Run はプラグインを実行します
+// contextStdin が true の場合は標準入力にコンテキストのJSONを1行渡し、false の場合は awstk の標準入力をそのまま渡します
+// プラグインが0以外の終了コードで終了した場合は *exec.ExitError を返します
+func Run(ctx context.Context, p Plugin, args []string, c Context, contextStdin bool) error {
+	cmd := exec.CommandContext(ctx, p.Path, args...)
+	cmd.Env = append(os.Environ(), c.Env()...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if contextStdin {
+		data, err := json.Marshal(c)
+		if err != nil {
+			return fmt.Errorf("コンテキストのJSON変換に失敗: %w", err)
+		}
+		cmd.Stdin = bytes.NewReader(append(data, '\n'))
+	}
+	return cmd.Run()
+}
+
+// ExtractFlags は args から awstk が解釈するフラグ（names で指定した fs のフラグ）を取り除いて fs に設定し、残りの引数を返します
+// "--" 以降の引数は解釈せずにそのまま残します（"--" 自体は取り除きます）
+// 値を取るフラグのみ対象とし、--name value / --name=value / -n value / -n=value の形式に対応します
+func ExtractFlags(fs *pflag.FlagSet, names []string, args []string) ([]string, error) {
+	rest := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(rest, args[i+1:]...), nil
+		}
+
+		flag, value, hasValue := lookupFlag(fs, names, arg)
+		if flag == nil {
+			rest = append(rest, arg)
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, errors.New("フラグ " + arg + " に値が指定されていません")
+			}
+			i++
+			value = args[i]
+		}
+		if err := fs.Set(flag.Name, value); err != nil {
+			return nil, fmt.Errorf("フラグ %s の値が不正です: %w", arg, err)
+		}
+	}
+	return rest, nil
+}
+
+// lookupFlag は引数が names に含まれるフラグであればそのフラグと "=" 以降の値を返す
+func lookupFlag(fs *pflag.FlagSet, names []string, arg string) (*pflag.Flag, string, bool) {
+	var flag *pflag.Flag
+	key, value, hasValue := strings.Cut(arg, "=")
+	switch {
+	case strings.HasPrefix(key, "--"):
+		flag = fs.Lookup(key[2:])
+	case strings.HasPrefix(key, "-") && len(key) == 2:
+		flag = fs.ShorthandLookup(key[1:])
+	}
+	if flag == nil || !slices.Contains(names, flag.Name) {
+		return nil, "", false
+	}
+	return flag, value, hasValue
+}
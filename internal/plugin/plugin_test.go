@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func writeScript(t *testing.T, dir, name, body string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("実行権限で判定するため Unix 系のみ")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	hello := writeScript(t, first, "awstk-hello", "", 0o755)
+	writeScript(t, second, "awstk-hello", "", 0o755) // PATH で後ろにあるため使われない
+	deploy := writeScript(t, second, "awstk-deploy", "", 0o755)
+	writeScript(t, first, "awstk-noexec", "", 0o644)
+	writeScript(t, first, "other-tool", "", 0o755)
+	if err := os.Mkdir(filepath.Join(first, "awstk-dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := Discover(strings.Join([]string{first, "", filepath.Join(first, "missing"), second}, string(os.PathListSeparator)))
+	want := []Plugin{{Name: "deploy", Path: deploy}, {Name: "hello", Path: hello}}
+	if !slices.Equal(got, want) {
+		t.Errorf("Discover() = %v, want %v", got, want)
+	}
+}
+
+func TestExtractFlags(t *testing.T) {
+	names := []string{"profile", "region", "stack"}
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		profile string
+		region  string
+		wantErr bool
+	}{
+		{name: "フラグなし", args: []string{"deploy", "--force"}, want: []string{"deploy", "--force"}},
+		{name: "短縮形と長い形式", args: []string{"-P", "dev", "deploy", "--region=us-east-1"}, want: []string{"deploy"}, profile: "dev", region: "us-east-1"},
+		{name: "対象外のフラグはそのまま渡す", args: []string{"-o", "json", "-P=dev"}, want: []string{"-o", "json"}, profile: "dev"},
+		{name: "-- 以降は解釈しない", args: []string{"-P", "dev", "--", "-R", "x"}, want: []string{"-R", "x"}, profile: "dev"},
+		{name: "値がない", args: []string{"deploy", "-P"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			profile := fs.StringP("profile", "P", "", "")
+			region := fs.StringP("region", "R", "", "")
+			fs.StringP("stack", "S", "", "")
+			fs.StringP("output", "o", "", "")
+
+			got, err := ExtractFlags(fs, names, tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ExtractFlags() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ExtractFlags() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ExtractFlags() = %q, want %q", got, tt.want)
+			}
+			if *profile != tt.profile || *region != tt.region {
+				t.Errorf("profile, region = %q, %q, want %q, %q", *profile, *region, tt.profile, tt.region)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("シェルスクリプトを使うため Unix 系のみ")
+	}
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	p := Plugin{Name: "hello", Path: writeScript(t, dir, "awstk-hello",
+		`{ echo "$AWSTK_PROFILE $AWS_REGION $AWSTK_STACK $AWSTK_OUTPUT $*"; cat; } > "`+out+`"; exit 3`, 0o755)}
+	c := Context{Profile: "dev", Region: "us-east-1", Stack: "my-stack", Output: "json", Version: "test"}
+
+	err := Run(t.Context(), p, []string{"a", "b"}, c, true)
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 3 {
+		t.Fatalf("Run() error = %v, want exit status 3", err)
+	}
+
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || lines[0] != "dev us-east-1 my-stack json a b" {
+		t.Fatalf("プラグインの出力 = %q", data)
+	}
+	if !strings.Contains(lines[1], `"profile":"dev"`) || !strings.Contains(lines[1], `"stack":"my-stack"`) {
+		t.Errorf("標準入力のコンテキスト = %s", lines[1])
+	}
+}
@@ -0,0 +1,95 @@
+package runbook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Action は runbook のステップから呼び出せる操作
+type Action struct {
+	Name string
+	// Undo は rollback 時に同じ引数で実行する取り消し用のアクション名（空の場合は取り消さない）
+	Undo string
+	// UndoRequires は rollback で取り消すステップの with に明示が必要な引数
+	// （省略すると取り消し時に既定値が使われ、実行前の状態に戻らない引数）
+	UndoRequires []string
+	// WaitTimeout は with の timeout を省略した場合の完了待ちの時間（待機しないアクションは0）
+	// Ctrl-C で中断されたあとの取り消しで、待機が終わるまでコンテキストを打ち切らないために使う
+	WaitTimeout time.Duration
+
+	run   func(ctx context.Context, with map[string]any) error
+	check func(with map[string]any) error
+}
+
+// NewAction は with の内容を A にデコードして fn を呼び出すアクションを生成します
+// A に存在しないキーを with に指定した場合はエラーになります
+func NewAction[A any](name, undo string, fn func(ctx context.Context, args A) error) Action {
+	return Action{
+		Name: name,
+		Undo: undo,
+		run: func(ctx context.Context, with map[string]any) error {
+			args, err := decodeArgs[A](with)
+			if err != nil {
+				return err
+			}
+			return fn(ctx, args)
+		},
+		check: func(with map[string]any) error {
+			_, err := decodeArgs[A](with)
+			return err
+		},
+	}
+}
+
+// decodeArgs は with の内容を JSON を経由して A にデコードする
+func decodeArgs[A any](with map[string]any) (A, error) {
+	var args A
+	if len(with) == 0 {
+		return args, nil
+	}
+	raw, err := json.Marshal(with)
+	if err != nil {
+		return args, fmt.Errorf("with の変換に失敗: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&args); err != nil {
+		return args, fmt.Errorf("with が不正です: %w", err)
+	}
+	return args, nil
+}
+
+// Registry はアクション名からアクションへの対応
+type Registry map[string]Action
+
+// NewRegistry はアクションを登録した Registry を生成します
+func NewRegistry(actions ...Action) Registry {
+	registry := make(Registry, len(actions))
+	for _, action := range actions {
+		registry[action.Name] = action
+	}
+	return registry
+}
+
+// Names は登録されているアクション名をソートして返します
+func (r Registry) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Actions は登録されているアクションを名前順に返します
+func (r Registry) Actions() []Action {
+	actions := make([]Action, 0, len(r))
+	for _, name := range r.Names() {
+		actions = append(actions, r[name])
+	}
+	return actions
+}
@@ -0,0 +1,275 @@
+package runbook
+
+import (
+	"awstk/internal/service/common"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Options は runbook の実行オプション
+type Options struct {
+	Vars []string // --var で指定した key=value
+	// StatePath は状態ファイルのパス（空の場合は保存しない）
+	StatePath string
+	// Resume が true の場合は状態ファイルを読み込み、完了していないステップから再開する
+	Resume bool
+}
+
+// PrintPlan は変数と条件を解決した実行計画を表示します（--dry-run 用）
+func PrintPlan(rb *Runbook, actions Registry, opts Options) error {
+	if err := rb.Validate(actions); err != nil {
+		return common.InvalidInputf("%v", err)
+	}
+	vars, state, err := prepare(rb, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("📋 runbook '%s' の実行計画 (%d ステップ):\n", rb.Name, len(rb.Steps))
+	for i, step := range rb.Steps {
+		fmt.Printf("\n  %s\n", step.label(i))
+		fmt.Printf("     action:     %s\n", step.Action)
+
+		action := actions[step.Action]
+		with, err := expandWith(step.With, vars)
+		if err != nil {
+			return common.InvalidInputf("%s: %v", step.label(i), err)
+		}
+		if err := action.check(with); err != nil {
+			return common.InvalidInputf("%s: %v", step.label(i), err)
+		}
+		if len(with) > 0 {
+			args, _ := json.Marshal(with)
+			fmt.Printf("     with:       %s\n", args)
+		}
+		if step.When != "" {
+			ok, err := evalWhen(step.When, vars)
+			if err != nil {
+				return common.InvalidInputf("%s: when: %v", step.label(i), err)
+			}
+			result := "実行する"
+			if !ok {
+				result = "スキップする"
+			}
+			fmt.Printf("     when:       %s → %s\n", step.When, result)
+		}
+		onFailure := step.onFailure(rb)
+		if onFailure == OnFailureRollback && action.Undo != "" {
+			onFailure += " (取り消し: " + action.Undo + ")"
+		}
+		fmt.Printf("     on_failure: %s\n", onFailure)
+		if state != nil && done(state.Steps[i].Status) {
+			fmt.Printf("     ⏭️  前回の実行で完了済み (%s)\n", state.Steps[i].Status)
+		}
+	}
+	return nil
+}
+
+// Execute は runbook のステップを順に実行します
+// 失敗したステップの on_failure に応じて、次のステップに進む・中断する・完了済みのステップを取り消します
+func Execute(ctx context.Context, rb *Runbook, actions Registry, opts Options) error {
+	if err := rb.Validate(actions); err != nil {
+		return common.InvalidInputf("%v", err)
+	}
+	vars, state, err := prepare(rb, opts)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		state = newState(rb, vars)
+	}
+
+	var results []common.ItemResult
+	for i, step := range rb.Steps {
+		label := step.label(i)
+		if done(state.Steps[i].Status) {
+			common.Progressf("\n⏭️  [%d/%d] %s は前回の実行で完了済みです\n", i+1, len(rb.Steps), label)
+			continue
+		}
+		common.Progressf("\n▶️  [%d/%d] %s (%s)\n", i+1, len(rb.Steps), label, step.Action)
+
+		err := runStep(ctx, rb.Steps[i], actions, vars)
+		if errors.Is(err, errSkipped) {
+			common.Progressln("⏭️  条件を満たさないためスキップします")
+			state.Steps[i] = StepState{Name: label, Action: step.Action, Status: StatusSkipped}
+			if err := state.save(opts.StatePath); err != nil {
+				return err
+			}
+			continue
+		}
+		results = append(results, common.ItemResult{Item: label, Err: err})
+		if err == nil {
+			common.Progressf("✅ %s が完了しました\n", label)
+			state.Steps[i] = StepState{Name: label, Action: step.Action, Status: StatusSucceeded}
+			if err := state.save(opts.StatePath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		common.Warnf("❌ %s が失敗しました: %v\n", label, err)
+		state.Steps[i] = StepState{Name: label, Action: step.Action, Status: StatusFailed, Error: err.Error()}
+		state.Status = StatusFailed
+
+		switch step.onFailure(rb) {
+		case OnFailureContinue:
+			if err := state.save(opts.StatePath); err != nil {
+				return err
+			}
+			continue
+		case OnFailureRollback:
+			rollbackErr := rollback(ctx, rb, actions, vars, state, i)
+			if rollbackErr == nil {
+				state.Status = StatusRolledBack
+			}
+			if err := state.save(opts.StatePath); err != nil {
+				return err
+			}
+			if rollbackErr != nil {
+				return fmt.Errorf("%s が失敗し、ロールバックも失敗しました: %w", label, rollbackErr)
+			}
+			return fmt.Errorf("%s が失敗したため完了済みのステップをロールバックしました: %w", label, err)
+		default:
+			if err := state.save(opts.StatePath); err != nil {
+				return err
+			}
+			if i < len(rb.Steps)-1 {
+				common.Progressln("⏭️  以降のステップは実行しません")
+			}
+			if opts.StatePath != "" {
+				common.Progressln("💡 原因を解消したあと --resume を指定すると、このステップから再開できます")
+			}
+			return fmt.Errorf("%s が失敗しました: %w", label, err)
+		}
+	}
+
+	if err := partialFailure(results); err != nil {
+		return err
+	}
+	state.Status = StatusSucceeded
+	return state.save(opts.StatePath)
+}
+
+// errSkipped は when の条件を満たさずステップを実行しなかったことを表す
+var errSkipped = errors.New("skipped")
+
+// runStep は変数を展開してステップのアクションを実行する
+func runStep(ctx context.Context, step Step, actions Registry, vars map[string]string) error {
+	ok, err := evalWhen(step.When, vars)
+	if err != nil {
+		return fmt.Errorf("when: %w", err)
+	}
+	if !ok {
+		return errSkipped
+	}
+	with, err := expandWith(step.With, vars)
+	if err != nil {
+		return err
+	}
+	return actions[step.Action].run(ctx, with)
+}
+
+// rollback は failed より前の完了済みステップを逆順に取り消す
+// 取り消し用のアクションがないステップはそのままにする
+// Ctrl-C で中断された場合も取り消せるよう、キャンセル済みのコンテキストはステップごとに復元処理用のコンテキストに切り替える
+func rollback(ctx context.Context, rb *Runbook, actions Registry, vars map[string]string, state *State, failed int) error {
+	canceled := ctx.Err() != nil
+	common.Progressln("\n↩️  完了済みのステップをロールバックします")
+	for i := failed - 1; i >= 0; i-- {
+		if state.Steps[i].Status != StatusSucceeded {
+			continue
+		}
+		step := rb.Steps[i]
+		undo := actions[step.Action].Undo
+		if undo == "" {
+			common.Progressf("ℹ️  %s は取り消せないためそのままにします\n", step.label(i))
+			continue
+		}
+		undoStep := step
+		undoStep.Action, undoStep.When = undo, ""
+		if err := runUndoStep(ctx, undoStep, actions, vars, canceled); err != nil {
+			common.Warnf("❌ %s の取り消し (%s) に失敗しました: %v\n", step.label(i), undo, err)
+			return fmt.Errorf("%s の取り消しに失敗: %w", step.label(i), err)
+		}
+		common.Progressf("↩️  %s を取り消しました (%s)\n", step.label(i), undo)
+		state.Steps[i].Status = StatusRolledBack
+	}
+	return nil
+}
+
+// runUndoStep は取り消し用のステップを実行する
+// 中断後の場合は、取り消しの完了待ち（with の timeout またはアクションの既定値）と復元処理の時間を許可したコンテキストで実行する
+func runUndoStep(ctx context.Context, step Step, actions Registry, vars map[string]string, canceled bool) error {
+	if !canceled {
+		return runStep(ctx, step, actions, vars)
+	}
+	wait := actions[step.Action].WaitTimeout
+	if with, err := expandWith(step.With, vars); err == nil {
+		if seconds := timeoutSeconds(with); seconds > 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), common.RestoreTimeout+wait)
+	defer cancel()
+	return runStep(ctx, step, actions, vars)
+}
+
+// timeoutSeconds は with に指定された timeout（秒）を返す（指定がない場合は0）
+func timeoutSeconds(with map[string]any) int {
+	var args struct {
+		Timeout int `json:"timeout"`
+	}
+	raw, err := json.Marshal(with)
+	if err != nil || json.Unmarshal(raw, &args) != nil {
+		return 0
+	}
+	return args.Timeout
+}
+
+// prepare は変数を解決し、再開する場合は状態ファイルを読み込む
+// 再開する場合は前回の実行時の変数を使う
+func prepare(rb *Runbook, opts Options) (map[string]string, *State, error) {
+	if !opts.Resume {
+		vars, err := MergeVars(rb.Vars, opts.Vars)
+		if err != nil {
+			return nil, nil, common.InvalidInputf("%v", err)
+		}
+		return vars, nil, nil
+	}
+
+	state, err := LoadState(opts.StatePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	if state.Checksum != rb.Checksum || len(state.Steps) != len(rb.Steps) {
+		return nil, nil, common.InvalidInputf("runbook が前回の実行後に変更されているため再開できません: %s", rb.Path)
+	}
+	if state.Status == StatusSucceeded {
+		return nil, nil, common.InvalidInputf("前回の実行はすべてのステップが完了しています")
+	}
+	// 前回の実行時の変数に --var の指定を上書きする
+	vars, err := MergeVars(state.Vars, opts.Vars)
+	if err != nil {
+		return nil, nil, common.InvalidInputf("%v", err)
+	}
+	state.Vars = vars
+	return vars, state, nil
+}
+
+// done はステップが完了済み（再開時に実行しない）かを判定する
+func done(status string) bool {
+	return status == StatusSucceeded || status == StatusSkipped
+}
+
+// partialFailure は on_failure: continue で失敗したステップがあれば PartialFailureError を返す
+func partialFailure(results []common.ItemResult) error {
+	for _, r := range results {
+		if r.Err != nil {
+			return &common.PartialFailureError{Operation: "runbook のステップ", Results: results}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package runbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"awstk/internal/service/common"
+)
+
+// recordingActions は呼び出されたアクションを記録し、fail に含まれるIDで失敗するアクションを返す
+func recordingActions(calls *[]string, fail map[string]bool) Registry {
+	type args struct {
+		Id string `json:"id"`
+	}
+	action := func(name string) func(ctx context.Context, a args) error {
+		return func(ctx context.Context, a args) error {
+			*calls = append(*calls, name+":"+a.Id)
+			if fail[a.Id] {
+				return errors.New("failed")
+			}
+			return nil
+		}
+	}
+	return NewRegistry(
+		NewAction("ecs.start", "ecs.stop", action("ecs.start")),
+		NewAction("ecs.stop", "ecs.start", action("ecs.stop")),
+		NewAction("notify", "", action("notify")),
+	)
+}
+
+// writeRunbook は runbook をファイルに書き込んで読み込む
+func writeRunbook(t *testing.T, content string) *Runbook {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "runbook.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rb, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return rb
+}
+
+const testRunbook = `
+vars:
+  env: dev
+steps:
+  - name: api
+    action: ecs.stop
+    with: {id: "${env}/api"}
+  - name: worker
+    action: ecs.stop
+    with: {id: "${env}/worker"}
+    on_failure: %s
+  - name: notify
+    action: notify
+    with: {id: "${env}"}
+    when: ${env} != prd
+`
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name       string
+		onFailure  string
+		fail       map[string]bool
+		vars       []string
+		wantCalls  []string
+		wantErr    bool
+		wantStatus []string
+	}{
+		{
+			name:       "すべてのステップを実行",
+			onFailure:  OnFailureAbort,
+			wantCalls:  []string{"ecs.stop:dev/api", "ecs.stop:dev/worker", "notify:dev"},
+			wantStatus: []string{StatusSucceeded, StatusSucceeded, StatusSucceeded},
+		},
+		{
+			name:       "条件を満たさないステップはスキップ",
+			onFailure:  OnFailureAbort,
+			vars:       []string{"env=prd"},
+			wantCalls:  []string{"ecs.stop:prd/api", "ecs.stop:prd/worker"},
+			wantStatus: []string{StatusSucceeded, StatusSucceeded, StatusSkipped},
+		},
+		{
+			name:       "abort は以降のステップを実行しない",
+			onFailure:  OnFailureAbort,
+			fail:       map[string]bool{"dev/worker": true},
+			wantCalls:  []string{"ecs.stop:dev/api", "ecs.stop:dev/worker"},
+			wantErr:    true,
+			wantStatus: []string{StatusSucceeded, StatusFailed, StatusPending},
+		},
+		{
+			name:       "continue は次のステップに進む",
+			onFailure:  OnFailureContinue,
+			fail:       map[string]bool{"dev/worker": true},
+			wantCalls:  []string{"ecs.stop:dev/api", "ecs.stop:dev/worker", "notify:dev"},
+			wantErr:    true,
+			wantStatus: []string{StatusSucceeded, StatusFailed, StatusSucceeded},
+		},
+		{
+			name:       "rollback は完了済みのステップを取り消す",
+			onFailure:  OnFailureRollback,
+			fail:       map[string]bool{"dev/worker": true},
+			wantCalls:  []string{"ecs.stop:dev/api", "ecs.stop:dev/worker", "ecs.start:dev/api"},
+			wantErr:    true,
+			wantStatus: []string{StatusRolledBack, StatusFailed, StatusPending},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rb := writeRunbook(t, fmtRunbook(tt.onFailure))
+			statePath := filepath.Join(t.TempDir(), "state.json")
+			var calls []string
+
+			err := Execute(t.Context(), rb, recordingActions(&calls, tt.fail), Options{Vars: tt.vars, StatePath: statePath})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("呼び出し = %v, want %v", calls, tt.wantCalls)
+			}
+			state, err := LoadState(statePath)
+			if err != nil {
+				t.Fatalf("LoadState() error = %v", err)
+			}
+			var statuses []string
+			for _, s := range state.Steps {
+				statuses = append(statuses, s.Status)
+			}
+			if !reflect.DeepEqual(statuses, tt.wantStatus) {
+				t.Errorf("ステップの状態 = %v, want %v", statuses, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestExecuteRollbackAfterCancel(t *testing.T) {
+	type args struct {
+		Id string `json:"id"`
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	var calls []string
+	actions := NewRegistry(
+		NewAction("ecs.start", "ecs.stop", func(ctx context.Context, a args) error {
+			calls = append(calls, "ecs.start:"+a.Id)
+			return ctx.Err()
+		}),
+		NewAction("ecs.stop", "ecs.start", func(ctx context.Context, a args) error {
+			calls = append(calls, "ecs.stop:"+a.Id)
+			if a.Id == "dev/worker" {
+				// 実行中に Ctrl-C で中断された場合を再現する
+				cancel()
+				return ctx.Err()
+			}
+			return nil
+		}),
+		NewAction("notify", "", func(ctx context.Context, a args) error { return nil }),
+	)
+
+	err := Execute(ctx, writeRunbook(t, fmtRunbook(OnFailureRollback)), actions, Options{})
+	if err == nil || !strings.Contains(err.Error(), "ロールバックしました") {
+		t.Fatalf("Execute() error = %v, want ロールバック成功のエラー", err)
+	}
+	want := []string{"ecs.stop:dev/api", "ecs.stop:dev/worker", "ecs.start:dev/api"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("呼び出し = %v, want %v", calls, want)
+	}
+}
+
+func TestExecuteRollbackAfterCancelWaitsForUndo(t *testing.T) {
+	type args struct {
+		Id      string `json:"id"`
+		Wait    bool   `json:"wait"`
+		Timeout int    `json:"timeout"`
+	}
+
+	tests := []struct {
+		name        string
+		with        string
+		waitTimeout time.Duration
+		want        time.Duration
+	}{
+		{name: "with の timeout を待機できる", with: "{id: db, wait: true, timeout: 600}", want: common.RestoreTimeout + 600*time.Second},
+		{name: "timeout を省略した場合はアクションの既定値", with: "{id: db, wait: true}", waitTimeout: 20 * time.Minute, want: common.RestoreTimeout + 20*time.Minute},
+		{name: "待機しないアクション", with: "{id: db}", want: common.RestoreTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			var remaining time.Duration
+			start := NewAction("rds.start", "rds.stop", func(ctx context.Context, a args) error {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return errors.New("no deadline")
+				}
+				remaining = time.Until(deadline)
+				return ctx.Err()
+			})
+			start.WaitTimeout = tt.waitTimeout
+			actions := NewRegistry(
+				start,
+				NewAction("rds.stop", "rds.start", func(ctx context.Context, a args) error {
+					return nil
+				}),
+				NewAction("notify", "", func(ctx context.Context, a args) error {
+					// 実行中に Ctrl-C で中断された場合を再現する
+					cancel()
+					return ctx.Err()
+				}),
+			)
+			rb := writeRunbook(t, fmt.Sprintf(`
+on_failure: rollback
+steps:
+  - action: rds.stop
+    with: %s
+  - action: notify
+`, tt.with))
+
+			err := Execute(ctx, rb, actions, Options{})
+			if err == nil || !strings.Contains(err.Error(), "ロールバックしました") {
+				t.Fatalf("Execute() error = %v, want ロールバック成功のエラー", err)
+			}
+			// 取り消しの完了待ちが終わるまでコンテキストの期限を延ばす
+			if remaining > tt.want || remaining < tt.want-time.Minute {
+				t.Errorf("取り消しのコンテキストの残り時間 = %v, want %v", remaining, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteContinuePartialFailure(t *testing.T) {
+	rb := writeRunbook(t, fmtRunbook(OnFailureContinue))
+	var calls []string
+	err := Execute(t.Context(), rb, recordingActions(&calls, map[string]bool{"dev/worker": true}), Options{})
+	var partial *common.PartialFailureError
+	if !errors.As(err, &partial) {
+		t.Fatalf("Execute() error = %v, want *common.PartialFailureError", err)
+	}
+	if got := len(partial.Failed()); got != 1 {
+		t.Errorf("失敗したステップ数 = %d, want 1", got)
+	}
+}
+
+func TestExecuteResume(t *testing.T) {
+	rb := writeRunbook(t, fmtRunbook(OnFailureAbort))
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	var calls []string
+	if err := Execute(t.Context(), rb, recordingActions(&calls, map[string]bool{"stg/worker": true}), Options{Vars: []string{"env=stg"}, StatePath: statePath}); err == nil {
+		t.Fatal("Execute() error = nil, want error")
+	}
+
+	// 再開時は前回の変数を引き継ぎ、失敗したステップから実行する
+	calls = nil
+	if err := Execute(t.Context(), rb, recordingActions(&calls, nil), Options{StatePath: statePath, Resume: true}); err != nil {
+		t.Fatalf("Execute(resume) error = %v", err)
+	}
+	want := []string{"ecs.stop:stg/worker", "notify:stg"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("再開後の呼び出し = %v, want %v", calls, want)
+	}
+
+	// 完了済みの実行は再開できない
+	err := Execute(t.Context(), rb, recordingActions(&calls, nil), Options{StatePath: statePath, Resume: true})
+	var invalid *common.InvalidInputError
+	if !errors.As(err, &invalid) {
+		t.Errorf("Execute(resume) error = %v, want *common.InvalidInputError", err)
+	}
+}
+
+func TestExecuteResumeChangedRunbook(t *testing.T) {
+	rb := writeRunbook(t, fmtRunbook(OnFailureAbort))
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	var calls []string
+	_ = Execute(t.Context(), rb, recordingActions(&calls, map[string]bool{"dev/worker": true}), Options{StatePath: statePath})
+
+	changed := writeRunbook(t, fmtRunbook(OnFailureContinue))
+	err := Execute(t.Context(), changed, recordingActions(&calls, nil), Options{StatePath: statePath, Resume: true})
+	var invalid *common.InvalidInputError
+	if !errors.As(err, &invalid) {
+		t.Errorf("Execute(resume) error = %v, want *common.InvalidInputError", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var calls []string
+	actions := recordingActions(&calls, nil)
+	stop := actions["ecs.stop"]
+	stop.UndoRequires = []string{"min", "max"}
+	actions["ecs.stop"] = stop
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "steps がない", content: "name: empty\n"},
+		{name: "未対応のアクション", content: "steps:\n  - action: lambda.invoke\n"},
+		{name: "不正な on_failure", content: "steps:\n  - action: notify\n    on_failure: retry\n"},
+		{name: "ステップ名の重複", content: "steps:\n  - {name: a, action: notify}\n  - {name: a, action: notify}\n"},
+		{name: "rollback で取り消すステップに必須の引数がない", content: "steps:\n  - {action: ecs.stop, with: {id: a, min: 1}}\n  - {action: notify, on_failure: rollback}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := writeRunbook(t, tt.content).Validate(actions); err == nil {
+				t.Error("Validate() error = nil, want error")
+			}
+		})
+	}
+
+	valid := []string{
+		"steps:\n  - {action: ecs.stop, with: {id: a, min: 1, max: 2}}\n  - {action: notify, on_failure: rollback}\n",
+		"steps:\n  - {action: notify, on_failure: rollback}\n  - {action: ecs.stop, with: {id: a}}\n",
+	}
+	for _, content := range valid {
+		if err := writeRunbook(t, content).Validate(actions); err != nil {
+			t.Errorf("Validate() error = %v, want nil", err)
+		}
+	}
+}
+
+func fmtRunbook(onFailure string) string {
+	return fmt.Sprintf(testRunbook, onFailure)
+}
@@ -0,0 +1,138 @@
+// Package runbook は awstk の操作を順に実行する runbook（YAML）の読み込みと実行を提供します
+//
+// 各ステップは登録済みのアクション（サービス層の関数）を呼び出し、
+// 変数の展開・実行条件・失敗時の動作（continue / abort / rollback）と、
+// 失敗したステップから再開するための状態ファイルを扱います。
+package runbook
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 失敗時の動作
+const (
+	OnFailureAbort    = "abort"    // 以降のステップを実行せずに終了する（既定）
+	OnFailureContinue = "continue" // 失敗を記録して次のステップに進む
+	OnFailureRollback = "rollback" // 完了済みのステップを逆順に取り消して終了する
+)
+
+// Runbook は runbook ファイルの内容
+type Runbook struct {
+	Name      string            `yaml:"name"`
+	Vars      map[string]string `yaml:"vars"`
+	OnFailure string            `yaml:"on_failure"` // ステップで省略した場合の失敗時の動作
+	Steps     []Step            `yaml:"steps"`
+
+	// Path は読み込み元のファイルパス
+	Path string `yaml:"-"`
+	// Checksum は読み込んだ内容のSHA-256（再開時に runbook が変更されていないかの確認に使う）
+	Checksum string `yaml:"-"`
+}
+
+// Step は runbook の1ステップ
+type Step struct {
+	Name      string         `yaml:"name"`
+	Action    string         `yaml:"action"`     // 実行するアクション (e.g., ecs.stop)
+	With      map[string]any `yaml:"with"`       // アクションの引数（${var} を展開する）
+	When      string         `yaml:"when"`       // 実行条件 (e.g., ${env} == dev)
+	OnFailure string         `yaml:"on_failure"` // 失敗時の動作
+}
+
+// Load は runbook ファイルを読み込みます
+func Load(path string) (*Runbook, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("runbook の読み込みに失敗: %w", err)
+	}
+	rb := &Runbook{}
+	if err := yaml.Unmarshal(data, rb); err != nil {
+		return nil, fmt.Errorf("runbook %s の解析に失敗: %w", path, err)
+	}
+	rb.Path = path
+	rb.Checksum = checksum(data)
+	if rb.Name == "" {
+		rb.Name = path
+	}
+	return rb, nil
+}
+
+// Validate は runbook の構造とアクション名を検証します
+func (rb *Runbook) Validate(actions Registry) error {
+	if len(rb.Steps) == 0 {
+		return fmt.Errorf("steps が定義されていません")
+	}
+	if err := validateOnFailure(rb.OnFailure); err != nil {
+		return err
+	}
+	// 後続のステップが on_failure: rollback で失敗した場合に取り消されるステップを判定する
+	lastRollback := -1
+	for i, step := range rb.Steps {
+		if step.onFailure(rb) == OnFailureRollback {
+			lastRollback = i
+		}
+	}
+	var names []string
+	for i, step := range rb.Steps {
+		label := fmt.Sprintf("ステップ %d", i+1)
+		if step.Name != "" {
+			label += " (" + step.Name + ")"
+			if slices.Contains(names, step.Name) {
+				return fmt.Errorf("%s: ステップ名が重複しています", label)
+			}
+			names = append(names, step.Name)
+		}
+		if step.Action == "" {
+			return fmt.Errorf("%s: action を指定してください", label)
+		}
+		if _, ok := actions[step.Action]; !ok {
+			return fmt.Errorf("%s: 未対応のアクションです: '%s' (対応: %v)", label, step.Action, actions.Names())
+		}
+		if err := validateOnFailure(step.OnFailure); err != nil {
+			return fmt.Errorf("%s: %w", label, err)
+		}
+		if i < lastRollback {
+			var missing []string
+			for _, key := range actions[step.Action].UndoRequires {
+				if _, ok := step.With[key]; !ok {
+					missing = append(missing, key)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("%s: rollback で実行前の状態に戻せるよう with に %s を指定してください", label, strings.Join(missing, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// onFailure はステップの失敗時の動作を返す（省略時は runbook の既定値、それもなければ abort）
+func (s Step) onFailure(rb *Runbook) string {
+	if s.OnFailure != "" {
+		return s.OnFailure
+	}
+	if rb.OnFailure != "" {
+		return rb.OnFailure
+	}
+	return OnFailureAbort
+}
+
+// label はステップの表示名を返す
+func (s Step) label(index int) string {
+	if s.Name != "" {
+		return fmt.Sprintf("%d. %s", index+1, s.Name)
+	}
+	return fmt.Sprintf("%d. %s", index+1, s.Action)
+}
+
+func validateOnFailure(value string) error {
+	switch value {
+	case "", OnFailureAbort, OnFailureContinue, OnFailureRollback:
+		return nil
+	}
+	return fmt.Errorf("on_failure には %s, %s, %s のいずれかを指定してください: %s", OnFailureContinue, OnFailureAbort, OnFailureRollback, value)
+}
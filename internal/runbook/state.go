@@ -0,0 +1,113 @@
+package runbook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ステップ・実行全体の状態
+const (
+	StatusPending    = "pending"
+	StatusSucceeded  = "succeeded"
+	StatusFailed     = "failed"
+	StatusSkipped    = "skipped"
+	StatusRolledBack = "rolled_back"
+)
+
+// State は runbook の実行状態（--resume で失敗したステップから再開するために保存する）
+type State struct {
+	Runbook   string            `json:"runbook"`
+	Checksum  string            `json:"checksum"`
+	Vars      map[string]string `json:"vars,omitempty"`
+	Status    string            `json:"status"`
+	Steps     []StepState       `json:"steps"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// StepState はステップの実行状態
+type StepState struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// DefaultStatePath は runbook の状態ファイルのデフォルトパスを返します
+// XDG_STATE_HOME が設定されていればそれを優先します
+func DefaultStatePath(runbookPath string) (string, error) {
+	name := strings.TrimSuffix(filepath.Base(runbookPath), filepath.Ext(runbookPath)) + ".json"
+	if dir := os.Getenv("XDG_STATE_HOME"); dir != "" {
+		return filepath.Join(dir, "awstk", "runbooks", name), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("ホームディレクトリの取得に失敗: %w", err)
+	}
+	return filepath.Join(home, ".local", "state", "awstk", "runbooks", name), nil
+}
+
+// newState は runbook の全ステップを pending とした状態を作成する
+func newState(rb *Runbook, vars map[string]string) *State {
+	path, err := filepath.Abs(rb.Path)
+	if err != nil {
+		path = rb.Path
+	}
+	state := &State{Runbook: path, Checksum: rb.Checksum, Vars: vars, Status: StatusPending}
+	for i, step := range rb.Steps {
+		state.Steps = append(state.Steps, StepState{Name: step.label(i), Action: step.Action, Status: StatusPending})
+	}
+	return state
+}
+
+// LoadState は状態ファイルを読み込みます
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("状態ファイルがありません（再開できる実行がありません）: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("状態ファイルの読み込みに失敗: %w", err)
+	}
+	state := &State{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("状態ファイル %s の解析に失敗: %w", path, err)
+	}
+	return state, nil
+}
+
+// save は状態ファイルを書き込む（path が空の場合は何もしない）
+func (s *State) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	s.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("状態ファイルのディレクトリ作成に失敗: %w", err)
+	}
+	// 書き込み途中で中断しても壊れないよう一時ファイルから置き換える
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("状態ファイルの書き込みに失敗: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("状態ファイルの書き込みに失敗: %w", err)
+	}
+	return nil
+}
+
+// checksum は runbook の内容のSHA-256を返す
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
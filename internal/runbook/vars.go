@@ -0,0 +1,134 @@
+package runbook
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// varPattern は ${name} 形式の変数参照
+var varPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// MergeVars は runbook の vars に --var で指定した値を上書きした変数を返します
+// overrides は key=value 形式
+func MergeVars(defaults map[string]string, overrides []string) (map[string]string, error) {
+	vars := maps.Clone(defaults)
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	for _, o := range overrides {
+		key, value, ok := strings.Cut(o, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("--var は key=value 形式で指定してください: %s", o)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// expandString は文字列中の ${name} を変数の値に置き換える
+func expandString(s string, vars map[string]string) (string, error) {
+	var missing []string
+	result := varPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := varPattern.FindStringSubmatch(ref)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("未定義の変数です: %s", strings.Join(missing, ", "))
+	}
+	return result, nil
+}
+
+// expandValue は with の値に含まれる ${name} を再帰的に置き換える
+// 値全体が1つの変数参照の場合は、YAMLのスカラーとして数値・真偽値に変換する
+func expandValue(v any, vars map[string]string) (any, error) {
+	switch v := v.(type) {
+	case string:
+		expanded, err := expandString(v, vars)
+		if err != nil {
+			return nil, err
+		}
+		if varPattern.FindString(v) == v && v != "" {
+			var scalar any
+			if err := yaml.Unmarshal([]byte(expanded), &scalar); err == nil {
+				switch scalar.(type) {
+				case int, float64, bool:
+					return scalar, nil
+				}
+			}
+		}
+		return expanded, nil
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			expanded, err := expandValue(value, vars)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+			result[key] = expanded
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(v))
+		for i, value := range v {
+			expanded, err := expandValue(value, vars)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = expanded
+		}
+		return result, nil
+	}
+	return v, nil
+}
+
+// expandWith はステップの with を展開する
+func expandWith(with map[string]any, vars map[string]string) (map[string]any, error) {
+	if with == nil {
+		return nil, nil
+	}
+	expanded, err := expandValue(with, vars)
+	if err != nil {
+		return nil, err
+	}
+	return expanded.(map[string]any), nil
+}
+
+// evalWhen はステップの実行条件を評価する
+// "a == b" / "a != b" の比較、またはそれ以外の場合は値が空・false・0・no でなければ真
+func evalWhen(when string, vars map[string]string) (bool, error) {
+	if strings.TrimSpace(when) == "" {
+		return true, nil
+	}
+	expanded, err := expandString(when, vars)
+	if err != nil {
+		return false, err
+	}
+	if left, right, ok := strings.Cut(expanded, "!="); ok {
+		return unquote(left) != unquote(right), nil
+	}
+	if left, right, ok := strings.Cut(expanded, "=="); ok {
+		return unquote(left) == unquote(right), nil
+	}
+	switch strings.ToLower(unquote(expanded)) {
+	case "", "false", "0", "no":
+		return false, nil
+	}
+	return true, nil
+}
+
+// unquote は前後の空白と引用符を取り除く
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package runbook
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandWith(t *testing.T) {
+	vars := map[string]string{"env": "dev", "count": "2", "wait": "true"}
+
+	tests := []struct {
+		name    string
+		with    map[string]any
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name: "文字列中の変数を置き換える",
+			with: map[string]any{"id": "${env}-cluster/api", "tags": []any{"env=${env}"}},
+			want: map[string]any{"id": "dev-cluster/api", "tags": []any{"env=dev"}},
+		},
+		{
+			name: "値全体が変数の場合は数値・真偽値に変換する",
+			with: map[string]any{"min": "${count}", "wait": "${wait}", "id": "${env}"},
+			want: map[string]any{"min": 2, "wait": true, "id": "dev"},
+		},
+		{
+			name:    "未定義の変数",
+			with:    map[string]any{"id": "${stage}-cluster"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expandWith(tt.with, vars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expandWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expandWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalWhen(t *testing.T) {
+	vars := map[string]string{"env": "dev", "enabled": "false"}
+
+	tests := []struct {
+		when    string
+		want    bool
+		wantErr bool
+	}{
+		{when: "", want: true},
+		{when: "${env} == dev", want: true},
+		{when: "${env} == 'prd'", want: false},
+		{when: "${env} != prd", want: true},
+		{when: "${env}", want: true},
+		{when: "${enabled}", want: false},
+		{when: "${missing} == dev", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.when, func(t *testing.T) {
+			got, err := evalWhen(tt.when, vars)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("evalWhen() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("evalWhen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeVars(t *testing.T) {
+	got, err := MergeVars(map[string]string{"env": "dev", "region": "ap-northeast-1"}, []string{"env=stg", "url=https://example.com/?a=b"})
+	if err != nil {
+		t.Fatalf("MergeVars() error = %v", err)
+	}
+	want := map[string]string{"env": "stg", "region": "ap-northeast-1", "url": "https://example.com/?a=b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeVars() = %v, want %v", got, want)
+	}
+
+	if _, err := MergeVars(nil, []string{"env"}); err == nil {
+		t.Error("MergeVars() error = nil, want error")
+	}
+}
@@ -0,0 +1,178 @@
+package apply
+
+import (
+	"awstk/internal/service/canary"
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
+	ecrsvc "awstk/internal/service/ecr"
+	logssvc "awstk/internal/service/logs"
+	route53svc "awstk/internal/service/route53"
+	s3svc "awstk/internal/service/s3"
+	secretsmgrsvc "awstk/internal/service/secretsmanager"
+	ssmsvc "awstk/internal/service/ssm"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// step は同じリソースタイプ・操作のアクションをまとめた実行単位
+type step struct {
+	resourceType string
+	operation    common.Operation
+	actions      []common.Action
+}
+
+// Execute は計画に含まれるアクションを実行します
+// 同じリソースタイプ・操作のアクションはまとめて既存の一括処理に渡し、計画での初出順に実行します
+func Execute(ctx context.Context, clients ClientSet, plan *common.Plan) error {
+	steps := groupSteps(plan.Actions)
+
+	// 実行前にすべてのアクションが実行可能か確認する
+	for _, s := range steps {
+		if err := validateStep(clients, s); err != nil {
+			return err
+		}
+	}
+
+	failed := false
+	results := make([]common.ItemResult, 0, len(plan.Actions))
+	for _, s := range steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		common.Progressf("\n%s %s を%sします (%d件)\n", common.ProcessIcon, s.resourceType, operationLabel(s.operation), len(s.actions))
+		err := executeStep(ctx, clients, s)
+		if err != nil {
+			common.Warnf("%s %s の%sでエラーが発生しました: %v\n", common.ErrorIcon, s.resourceType, operationLabel(s.operation), err)
+			failed = true
+		}
+		results = append(results, stepResults(s, err)...)
+	}
+
+	if failed {
+		return &common.PartialFailureError{Operation: "実行計画のアクション", Results: results}
+	}
+	common.Progressf("\n%s %d件のアクションを実行しました\n", common.SuccessIcon, len(plan.Actions))
+	return nil
+}
+
+// stepResults は実行単位のエラーをアクションごとの結果に展開します
+// 一括処理が PartialFailureError を返した場合は対象ごとの結果を引き継ぎ、それ以外のエラーは全アクションの失敗とみなします
+func stepResults(s *step, err error) []common.ItemResult {
+	var partial *common.PartialFailureError
+	if errors.As(err, &partial) && len(partial.Results) == len(s.actions) {
+		results := make([]common.ItemResult, len(partial.Results))
+		for i, r := range partial.Results {
+			results[i] = common.ItemResult{Item: s.resourceType + " " + s.actions[i].ResourceId, Err: r.Err}
+		}
+		return results
+	}
+
+	results := make([]common.ItemResult, len(s.actions))
+	for i, a := range s.actions {
+		results[i] = common.ItemResult{Item: s.resourceType + " " + a.ResourceId, Err: err}
+	}
+	return results
+}
+
+// groupSteps はアクションをリソースタイプ・操作ごとにまとめます
+func groupSteps(actions []common.Action) []*step {
+	var steps []*step
+	index := map[string]*step{}
+	for _, a := range actions {
+		key := a.ResourceType + "|" + string(a.Operation)
+		s, ok := index[key]
+		if !ok {
+			s = &step{resourceType: a.ResourceType, operation: a.Operation}
+			index[key] = s
+			steps = append(steps, s)
+		}
+		s.actions = append(s.actions, a)
+	}
+	return steps
+}
+
+// validateStep は実行単位が対応済みの操作で、必要なクライアントが揃っているか確認します
+func validateStep(clients ClientSet, s *step) error {
+	var ok bool
+	switch {
+	case s.resourceType == common.ResourceS3Bucket && s.operation == common.OperationDelete:
+		ok = clients.S3Client != nil
+	case s.resourceType == common.ResourceEcrRepository && s.operation == common.OperationDelete:
+		ok = clients.EcrClient != nil
+	case s.resourceType == common.ResourceLogGroup && s.operation == common.OperationDelete:
+		ok = clients.LogsClient != nil
+	case s.resourceType == common.ResourceCfnStack && s.operation == common.OperationDelete:
+		ok = clients.CfnClient != nil
+	case s.resourceType == common.ResourceSecret && s.operation == common.OperationDelete:
+		ok = clients.SecretsClient != nil
+	case s.resourceType == common.ResourceSyntheticCanary && s.operation == common.OperationRun:
+		ok = clients.SyntheticsClient != nil
+	case s.resourceType == common.ResourceHostedZone && s.operation == common.OperationDelete:
+		ok = clients.Route53Client != nil
+	case s.resourceType == common.ResourceSsmParameter && (s.operation == common.OperationPut || s.operation == common.OperationDelete):
+		ok = clients.SsmClient != nil
+	case s.operation == common.OperationStop:
+		if _, err := cfn.StackResourcesFromActions(s.actions); err != nil {
+			return err
+		}
+		ok = clients.Ec2Client != nil && clients.RdsClient != nil && clients.AasClient != nil
+	default:
+		return fmt.Errorf("未対応の操作です: %s %s", s.operation, s.resourceType)
+	}
+	if !ok {
+		return fmt.Errorf("%s の%sに必要なクライアントが指定されていません", s.resourceType, operationLabel(s.operation))
+	}
+	return nil
+}
+
+// executeStep は実行単位を対応するサービスの処理に振り分けます
+func executeStep(ctx context.Context, clients ClientSet, s *step) error {
+	ids := make([]string, len(s.actions))
+	for i, a := range s.actions {
+		ids[i] = a.ResourceId
+	}
+
+	switch {
+	case s.resourceType == common.ResourceS3Bucket:
+		return s3svc.CleanupS3Buckets(ctx, clients.S3Client, ids)
+	case s.resourceType == common.ResourceEcrRepository:
+		return ecrsvc.CleanupEcrRepositories(ctx, clients.EcrClient, ids)
+	case s.resourceType == common.ResourceLogGroup:
+		return logssvc.CleanupLogGroups(ctx, clients.LogsClient, ids)
+	case s.resourceType == common.ResourceCfnStack:
+		return cfn.DeleteStacks(ctx, clients.CfnClient, ids)
+	case s.resourceType == common.ResourceSecret:
+		return secretsmgrsvc.DeleteSecrets(ctx, clients.SecretsClient, ids)
+	case s.resourceType == common.ResourceSyntheticCanary:
+		return canary.RunCanaries(ctx, clients.SyntheticsClient, ids)
+	case s.resourceType == common.ResourceHostedZone:
+		return route53svc.DeleteHostedZones(ctx, clients.Route53Client, ids)
+	case s.resourceType == common.ResourceSsmParameter && s.operation == common.OperationPut:
+		return ssmsvc.PutParameters(ctx, clients.SsmClient, s.actions)
+	case s.resourceType == common.ResourceSsmParameter:
+		return ssmsvc.DeleteParameters(ctx, clients.SsmClient, ids)
+	default:
+		resources, err := cfn.StackResourcesFromActions(s.actions)
+		if err != nil {
+			return err
+		}
+		return cfn.StopResources(ctx, clients.Ec2Client, clients.RdsClient, clients.AasClient, resources)
+	}
+}
+
+// operationLabel は操作種別の表示名を返します
+func operationLabel(op common.Operation) string {
+	switch op {
+	case common.OperationDelete:
+		return "削除"
+	case common.OperationStop:
+		return "停止"
+	case common.OperationRun:
+		return "実行"
+	case common.OperationPut:
+		return "登録"
+	default:
+		return string(op)
+	}
+}
@@ -0,0 +1,160 @@
+package apply
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"awstk/internal/service/common"
+	ssmsvc "awstk/internal/service/ssm"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestExecute(t *testing.T) {
+	s3Fake := fakeaws.NewS3(
+		&fakeaws.Bucket{Name: "dev-assets", Versions: []fakeaws.ObjectVersion{{Key: "a", VersionId: "v1"}}},
+		&fakeaws.Bucket{Name: "prod-assets"},
+	)
+	ecrFake := fakeaws.NewEcr(&fakeaws.Repository{Name: "dev-api", ImageCount: 2})
+	logsFake := fakeaws.NewLogs(fakeaws.LogGroup("/ecs/dev-api", 0, 0))
+
+	plan := common.NewPlan("awstk cleanup all")
+	plan.Add(
+		common.Action{ResourceType: common.ResourceS3Bucket, ResourceId: "dev-assets", Operation: common.OperationDelete},
+		common.Action{ResourceType: common.ResourceEcrRepository, ResourceId: "dev-api", Operation: common.OperationDelete},
+		common.Action{ResourceType: common.ResourceLogGroup, ResourceId: "/ecs/dev-api", Operation: common.OperationDelete},
+	)
+
+	err := Execute(t.Context(), ClientSet{S3Client: s3Fake, EcrClient: ecrFake, LogsClient: logsFake}, plan)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if s3Fake.HasBucket("dev-assets") {
+		t.Error("計画に含まれるバケットが削除されていません")
+	}
+	if !s3Fake.HasBucket("prod-assets") {
+		t.Error("計画に含まれないバケットが削除されました")
+	}
+	if ecrFake.HasRepository("dev-api") {
+		t.Error("計画に含まれるリポジトリが削除されていません")
+	}
+	if logsFake.HasLogGroup("/ecs/dev-api") {
+		t.Error("計画に含まれるロググループが削除されていません")
+	}
+}
+
+func TestExecute_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		action common.Action
+	}{
+		{
+			name:   "未対応の操作",
+			action: common.Action{ResourceType: common.ResourceS3Bucket, ResourceId: "prod-assets", Operation: common.OperationRun},
+		},
+		{
+			name:   "クライアント未指定",
+			action: common.Action{ResourceType: common.ResourceSecret, ResourceId: "my-secret", Operation: common.OperationDelete},
+		},
+		{
+			name:   "ECSサービスIDの形式が不正",
+			action: common.Action{ResourceType: common.ResourceEcsService, ResourceId: "my-service", Operation: common.OperationStop},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s3Fake := fakeaws.NewS3(&fakeaws.Bucket{Name: "dev-assets"})
+
+			// 不正なアクションが含まれる場合は、他のアクションも実行しない
+			plan := common.NewPlan("awstk apply")
+			plan.Add(
+				common.Action{ResourceType: common.ResourceS3Bucket, ResourceId: "dev-assets", Operation: common.OperationDelete},
+				tt.action,
+			)
+
+			if err := Execute(t.Context(), ClientSet{S3Client: s3Fake}, plan); err == nil {
+				t.Fatal("Execute() error = nil, want error")
+			}
+			if !s3Fake.HasBucket("dev-assets") {
+				t.Error("検証エラー時にバケットが削除されました")
+			}
+		})
+	}
+}
+
+func TestExecute_PartialFailure(t *testing.T) {
+	s3Fake := fakeaws.NewS3(&fakeaws.Bucket{Name: "dev-assets"}, &fakeaws.Bucket{Name: "dev-logs"})
+	s3Fake.Fail("DeleteBucket", "dev-logs", errors.New("access denied"))
+	logsFake := fakeaws.NewLogs(fakeaws.LogGroup("/ecs/dev-api", 0, 0))
+
+	plan := common.NewPlan("awstk cleanup all")
+	plan.Add(
+		common.Action{ResourceType: common.ResourceS3Bucket, ResourceId: "dev-assets", Operation: common.OperationDelete},
+		common.Action{ResourceType: common.ResourceS3Bucket, ResourceId: "dev-logs", Operation: common.OperationDelete},
+		common.Action{ResourceType: common.ResourceLogGroup, ResourceId: "/ecs/dev-api", Operation: common.OperationDelete},
+	)
+
+	// 失敗したアクションだけが結果に失敗として残り、他のアクションは実行される
+	err := Execute(t.Context(), ClientSet{S3Client: s3Fake, LogsClient: logsFake}, plan)
+	var partial *common.PartialFailureError
+	if !errors.As(err, &partial) {
+		t.Fatalf("Execute() error = %v, want *PartialFailureError", err)
+	}
+	if len(partial.Results) != len(plan.Actions) {
+		t.Errorf("len(Results) = %d, want %d", len(partial.Results), len(plan.Actions))
+	}
+	if failed := partial.Failed(); len(failed) != 1 || failed[0].Item != common.ResourceS3Bucket+" dev-logs" {
+		t.Errorf("Failed() = %+v, want [%s dev-logs]", failed, common.ResourceS3Bucket)
+	}
+	if got := common.ExitCode(err); got != common.ExitPartialFailure {
+		t.Errorf("ExitCode() = %d, want %d", got, common.ExitPartialFailure)
+	}
+	if logsFake.HasLogGroup("/ecs/dev-api") {
+		t.Error("後続のロググループが削除されていません")
+	}
+}
+
+func TestExecute_SsmParameters(t *testing.T) {
+	dir := t.TempDir()
+	paramsFile := filepath.Join(dir, "params.csv")
+	if err := os.WriteFile(paramsFile, []byte("name,value,type,description\n/db/host,db.local,String,\n/db/password,secret,SecureString,DBパスワード\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ssmFake := fakeaws.NewSsm(map[string]string{"/db/host": "old.local", "/old/key": "v"})
+
+	// 保存した計画ファイルから登録できるよう、値は計画に含めて引き継ぐ
+	actions, err := ssmsvc.PutActions(ssmsvc.PutParamsOptions{FilePath: paramsFile, Prefix: "/app"})
+	if err != nil {
+		t.Fatalf("PutActions() error = %v", err)
+	}
+	plan := common.NewPlan("awstk ssm put-params")
+	plan.Add(actions...)
+	planFile := filepath.Join(dir, "plan.json")
+	if err := plan.Save(planFile); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := common.LoadPlan(planFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded.Add(common.Action{ResourceType: common.ResourceSsmParameter, ResourceId: "/old/key", Operation: common.OperationDelete})
+
+	if err := Execute(t.Context(), ClientSet{SsmClient: ssmFake}, loaded); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	want := map[string]string{"/app/db/host": "db.local", "/app/db/password": "secret", "/db/host": "old.local"}
+	for name, value := range want {
+		p, ok := ssmFake.Parameter(name)
+		if !ok || aws.ToString(p.Value) != value {
+			t.Errorf("パラメータ %s = %q, want %q", name, aws.ToString(p.Value), value)
+		}
+	}
+	if _, ok := ssmFake.Parameter("/old/key"); ok {
+		t.Error("計画に含まれるパラメータが削除されていません")
+	}
+}
@@ -0,0 +1,28 @@
+package apply
+
+import (
+	"awstk/internal/service/canary"
+	"awstk/internal/service/cfn"
+	ecrsvc "awstk/internal/service/ecr"
+	logssvc "awstk/internal/service/logs"
+	route53svc "awstk/internal/service/route53"
+	s3svc "awstk/internal/service/s3"
+	secretsmgrsvc "awstk/internal/service/secretsmanager"
+	ssmsvc "awstk/internal/service/ssm"
+)
+
+// ClientSet は計画の実行に必要なクライアントをまとめた構造体
+// 計画に含まれないリソースタイプのクライアントは nil でもよい
+type ClientSet struct {
+	S3Client         s3svc.API
+	EcrClient        ecrsvc.API
+	LogsClient       logssvc.API
+	CfnClient        cfn.API
+	Ec2Client        cfn.Ec2API
+	RdsClient        cfn.RdsAPI
+	AasClient        cfn.AutoScalingAPI
+	SecretsClient    secretsmgrsvc.API
+	SyntheticsClient canary.API
+	Route53Client    route53svc.API
+	SsmClient        ssmsvc.API
+}
@@ -12,13 +12,13 @@ import (
 )
 
 // GetAuroraCapacityInfo Aurora Serverless v2のAcu情報を取得
-func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, clusterName string) (*CapacityInfo, error) {
+func GetAuroraCapacityInfo(ctx context.Context, rdsClient API, cwClient CloudWatchAPI, clusterName string) (*CapacityInfo, error) {
 	// まずクラスター情報を取得
 	describeInput := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(clusterName),
 	}
 
-	result, err := rdsClient.DescribeDBClusters(context.Background(), describeInput)
+	result, err := rdsClient.DescribeDBClusters(ctx, describeInput)
 	if err != nil {
 		return nil, fmt.Errorf("クラスター情報の取得に失敗: %w", err)
 	}
@@ -45,7 +45,7 @@ func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, c
 	info.MaxAcu = aws.ToFloat64(scaling.MaxCapacity)
 
 	// CloudWatchから現在のAcuを取得
-	currentAcu, err := getCurrentAcuFromCloudWatch(cwClient, clusterName)
+	currentAcu, err := getCurrentAcuFromCloudWatch(ctx, cwClient, clusterName)
 	if err != nil {
 		// エラーがあっても部分的な情報は返す（エラーメッセージは表示しない）
 		// CloudWatchにデータがない場合やアクセス権限がない場合がある
@@ -58,7 +58,7 @@ func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, c
 }
 
 // getCurrentAcuFromCloudWatch CloudWatchから現在のAcu値を取得
-func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string) (float64, error) {
+func getCurrentAcuFromCloudWatch(ctx context.Context, cwClient CloudWatchAPI, clusterName string) (float64, error) {
 	now := time.Now()
 	startTime := now.Add(-5 * time.Minute) // 過去5分間に拡大（データがない可能性を考慮）
 
@@ -77,7 +77,7 @@ func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string
 		Statistics: []types.Statistic{types.StatisticAverage},
 	}
 
-	result, err := cwClient.GetMetricStatistics(context.Background(), input)
+	result, err := cwClient.GetMetricStatistics(ctx, input)
 	if err != nil {
 		return 0, err
 	}
@@ -99,16 +99,16 @@ func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string
 }
 
 // ListAuroraCapacityInfo 複数クラスターのAcu情報を取得
-func ListAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client) ([]CapacityInfo, error) {
+func ListAuroraCapacityInfo(ctx context.Context, rdsClient API, cwClient CloudWatchAPI) ([]CapacityInfo, error) {
 	// 全クラスターを取得
-	clusters, err := getAllAuroraClusters(rdsClient)
+	clusters, err := getAllAuroraClusters(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
 
 	var capacityInfos []CapacityInfo
 	for _, cluster := range clusters {
-		info, err := GetAuroraCapacityInfo(rdsClient, cwClient, cluster.ClusterId)
+		info, err := GetAuroraCapacityInfo(ctx, rdsClient, cwClient, cluster.ClusterId)
 		if err != nil {
 			// エラーがあっても続行（部分的な情報を含む）
 			if info != nil {
@@ -1,53 +1,86 @@
 package aurora
 
 import (
+	"awstk/internal/i18n"
 	"context"
 	"fmt"
+	"slices"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 
 	"awstk/internal/service/cfn"
 	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
 )
 
 // ListAuroraClusters cmdから呼ばれるメイン関数（Get + Display）
-func ListAuroraClusters(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) error {
+func ListAuroraClusters(ctx context.Context, clients ClientSet, opts ListOptions) error {
 	// Get: データ取得
-	clusters, err := getAuroraClusters(rdsClient, cfnClient, stackName)
+	clusters, err := getAuroraClusters(ctx, clients, opts)
 	if err != nil {
-		if stackName != "" {
+		if opts.StackName != "" {
 			return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
 		}
-		return common.FormatListError("Auroraクラスター", err)
+		return common.FormatListError(i18n.T("resource.aurora_cluster"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		clusters,
-		"Auroraクラスター一覧",
+		common.GenerateFilteredTitle(i18n.T("resource.aurora_cluster")),
 		auroraClustersToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "Auroraクラスターが見つかりませんでした",
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.aurora_cluster")),
+		},
+	)
+}
+
+// ListAuroraClustersInRegions 複数リージョンのAuroraクラスターを並列取得し、リージョン列付きで表示
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListAuroraClustersInRegions(ctx context.Context, regions []string, clientsFor func(region string) ClientSet, opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Cluster, error) {
+		return getAuroraClusters(ctx, clientsFor(region), opts)
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.aurora_cluster")),
+		auroraClustersToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.aurora_cluster")),
 		},
 	)
 }
 
 // getAuroraClusters データ取得内部関数
-func getAuroraClusters(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
-	if stackName != "" {
-		return getAuroraClustersByStackName(rdsClient, cfnClient, stackName)
+func getAuroraClusters(ctx context.Context, clients ClientSet, opts ListOptions) ([]Cluster, error) {
+	var clusters []Cluster
+	var err error
+	if opts.StackName != "" {
+		clusters, err = getAuroraClustersByStackName(ctx, clients.RdsClient, clients.CfnClient, opts.StackName)
+	} else {
+		clusters, err = getAllAuroraClusters(ctx, clients.RdsClient)
+	}
+	if err != nil || len(opts.Tags) == 0 {
+		return clusters, err
+	}
+
+	// タグで絞り込む
+	ids, err := opts.Tags.Names(ctx, clients.TagClient, tagging.ResourceRdsCluster)
+	if err != nil {
+		return nil, err
 	}
-	return getAllAuroraClusters(rdsClient)
+	return slices.DeleteFunc(clusters, func(cl Cluster) bool { return !slices.Contains(ids, cl.ClusterId) }), nil
 }
 
 // getAllAuroraClusters 現在のリージョンの全Auroraクラスターを取得
-func getAllAuroraClusters(rdsClient *rds.Client) ([]Cluster, error) {
-	resp, err := rdsClient.DescribeDBClusters(context.Background(), &rds.DescribeDBClustersInput{})
+func getAllAuroraClusters(ctx context.Context, rdsClient API) ([]Cluster, error) {
+	resp, err := rdsClient.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{})
 	if err != nil {
-		return nil, fmt.Errorf(common.ListErrorFormat, common.ErrorIcon, "Auroraクラスター", err)
+		return nil, fmt.Errorf(i18n.T(common.ListErrorFormat), common.ErrorIcon, i18n.T("resource.aurora_cluster"), err)
 	}
 
 	clusters := make([]Cluster, 0, len(resp.DBClusters))
@@ -63,8 +96,8 @@ func getAllAuroraClusters(rdsClient *rds.Client) ([]Cluster, error) {
 }
 
 // getAuroraClustersByStackName 指定されたCloudFormationスタック名でフィルタリングしたAuroraクラスター一覧を取得
-func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
-	ids, err := cfn.GetAllAuroraFromStack(cfnClient, stackName)
+func getAuroraClustersByStackName(ctx context.Context, rdsClient API, cfnClient cfn.API, stackName string) ([]Cluster, error) {
+	ids, err := cfn.GetAllAuroraFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +106,7 @@ func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformati
 		return []Cluster{}, nil
 	}
 
-	all, err := getAllAuroraClusters(rdsClient)
+	all, err := getAllAuroraClusters(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +129,9 @@ func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformati
 // auroraClustersToTableData Auroraクラスター情報をテーブルデータに変換
 func auroraClustersToTableData(clusters []Cluster) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "クラスターID"},
-		{Header: "エンジン"},
-		{Header: "ステータス"},
+		{Header: i18n.T("header.cluster_id")},
+		{Header: i18n.T("header.engine")},
+		{Header: i18n.T("header.status")},
 	}
 
 	data := make([][]string, len(clusters))
@@ -1,6 +1,7 @@
 package aurora
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
@@ -9,14 +10,14 @@ import (
 )
 
 // StartAuroraCluster Auroraクラスターを起動する
-func StartAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func StartAuroraCluster(ctx context.Context, rdsClient API, clusterId string) error {
 	input := &rds.StartDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StartDBCluster(context.Background(), input)
+	_, err := rdsClient.StartDBCluster(ctx, input)
 	if err != nil {
-		return fmt.Errorf(common.StartErrorFormat, common.ErrorIcon, "Auroraクラスター", err)
+		return fmt.Errorf(i18n.T(common.StartErrorFormat), common.ErrorIcon, i18n.T("resource.aurora_cluster"), err)
 	}
 
 	return nil
@@ -8,12 +8,12 @@ import (
 )
 
 // StopAuroraCluster Auroraクラスターを停止する
-func StopAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func StopAuroraCluster(ctx context.Context, rdsClient API, clusterId string) error {
 	input := &rds.StopDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StopDBCluster(context.Background(), input)
+	_, err := rdsClient.StopDBCluster(ctx, input)
 	if err != nil {
 		return fmt.Errorf("❌ Auroraクラスター停止エラー: %w", err)
 	}
@@ -0,0 +1,43 @@
+package aurora
+
+import (
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+)
+
+var _ API = (*fakeaws.Rds)(nil)
+
+func TestStopAuroraCluster(t *testing.T) {
+	tests := []struct {
+		name       string
+		clusterId  string
+		wantErr    bool
+		wantStatus string
+	}{
+		{
+			name:       "利用可能なクラスターを停止",
+			clusterId:  "aurora-1",
+			wantStatus: "stopping",
+		},
+		{
+			name:      "存在しないクラスターはエラー",
+			clusterId: "aurora-missing",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewRds(nil, map[string]string{"aurora-1": "available"})
+
+			err := StopAuroraCluster(t.Context(), fake, tt.clusterId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StopAuroraCluster() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := fake.ClusterStatus(tt.clusterId); got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -1,5 +1,39 @@
 package aurora
 
+import (
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/tagging"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// API はauroraパッケージが利用するRDS APIのインターフェース
+type API interface {
+	DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error)
+	StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error)
+}
+
+// CloudWatchAPI はAcu取得に利用するCloudWatch APIのインターフェース
+type CloudWatchAPI interface {
+	GetMetricStatistics(ctx context.Context, params *cloudwatch.GetMetricStatisticsInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricStatisticsOutput, error)
+}
+
+// ClientSet はAuroraクラスター一覧の取得に必要なクライアントをまとめた構造体
+type ClientSet struct {
+	RdsClient API
+	CfnClient cfn.API
+	TagClient tagging.API
+}
+
+// ListOptions はAuroraクラスター一覧取得のオプション
+type ListOptions struct {
+	StackName string           // CloudFormationスタック名で絞り込む
+	Tags      tagging.Selector // タグで絞り込む
+}
+
 // Cluster AuroraCluster Auroraクラスターの情報を格納する構造体
 type Cluster struct {
 	ClusterId string
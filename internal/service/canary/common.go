@@ -2,19 +2,16 @@ package canary
 
 import (
 	"awstk/internal/service/common"
-	"bufio"
 	"context"
 	"fmt"
-	"os"
-	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
 // getCanariesByFilter フィルタパターンに一致するCanaryを取得
-func getCanariesByFilter(client *synthetics.Client, filter string) ([]Canary, error) {
-	allCanaries, err := getAllCanaries(client)
+func getCanariesByFilter(ctx context.Context, client API, filter string) ([]Canary, error) {
+	allCanaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -29,23 +26,9 @@ func getCanariesByFilter(client *synthetics.Client, filter string) ([]Canary, er
 	return filtered, nil
 }
 
-// confirmAction ユーザーに確認を求める
-func confirmAction(message string) bool {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("%s [y/N]: ", message)
-
-	response, err := reader.ReadString('\n')
-	if err != nil {
-		return false
-	}
-
-	response = strings.TrimSpace(strings.ToLower(response))
-	return response == "y" || response == "yes"
-}
-
 // startCanary Canaryを開始
-func startCanary(client *synthetics.Client, name string) error {
-	_, err := client.StartCanary(context.Background(), &synthetics.StartCanaryInput{
+func startCanary(ctx context.Context, client API, name string) error {
+	_, err := client.StartCanary(ctx, &synthetics.StartCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
@@ -55,8 +38,8 @@ func startCanary(client *synthetics.Client, name string) error {
 }
 
 // stopCanary Canaryを停止
-func stopCanary(client *synthetics.Client, name string) error {
-	_, err := client.StopCanary(context.Background(), &synthetics.StopCanaryInput{
+func stopCanary(ctx context.Context, client API, name string) error {
+	_, err := client.StopCanary(ctx, &synthetics.StopCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
@@ -1,15 +1,16 @@
 package canary
 
 import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
 // DisableCanary 指定したCanaryを無効化
-func DisableCanary(client *synthetics.Client, name string) error {
+func DisableCanary(ctx context.Context, client API, name string) error {
 	// 現在の状態を確認
-	canaries, err := getAllCanaries(client)
+	canaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -28,7 +29,7 @@ func DisableCanary(client *synthetics.Client, name string) error {
 
 	// 既に停止中の場合
 	if targetCanary.State == CanaryStateStopped {
-		fmt.Printf("ℹ️  %s は既に停止しています\n", name)
+		common.Progressf("ℹ️  %s は既に停止しています\n", name)
 		return nil
 	}
 
@@ -38,18 +39,18 @@ func DisableCanary(client *synthetics.Client, name string) error {
 	}
 
 	// 無効化実行
-	if err := stopCanary(client, name); err != nil {
+	if err := stopCanary(ctx, client, name); err != nil {
 		return err
 	}
 
-	fmt.Printf("✅ %s を無効化しました\n", name)
+	common.Progressf("✅ %s を無効化しました\n", name)
 	return nil
 }
 
 // DisableCanariesByFilter フィルタに一致するCanaryを無効化
-func DisableCanariesByFilter(client *synthetics.Client, filter string, skipConfirm bool) error {
+func DisableCanariesByFilter(ctx context.Context, client API, filter string, skipConfirm bool) error {
 	// フィルタに一致するCanaryを取得
-	canaries, err := getCanariesByFilter(client, filter)
+	canaries, err := getCanariesByFilter(ctx, client, filter)
 	if err != nil {
 		return err
 	}
@@ -76,12 +77,12 @@ func DisableCanariesByFilter(client *synthetics.Client, filter string, skipConfi
 	// 無効化対象がない場合
 	if len(toDisable) == 0 {
 		if len(alreadyStopped) > 0 {
-			fmt.Printf("ℹ️  全てのCanaryが既に停止しています\n")
+			common.Progressf("ℹ️  全てのCanaryが既に停止しています\n")
 		}
 		if len(cannotDisable) > 0 {
-			fmt.Printf("⚠️  以下のCanaryは現在の状態では無効化できません:\n")
+			common.Warnf("⚠️  以下のCanaryは現在の状態では無効化できません:\n")
 			for _, name := range cannotDisable {
-				fmt.Printf("  - %s\n", name)
+				common.Warnf("  - %s\n", name)
 			}
 		}
 		return nil
@@ -93,8 +94,8 @@ func DisableCanariesByFilter(client *synthetics.Client, filter string, skipConfi
 		for _, c := range toDisable {
 			fmt.Printf("  - %s (現在: %s)\n", c.Name, formatState(c.State))
 		}
-		if !confirmAction("続行しますか？") {
-			return fmt.Errorf("キャンセルされました")
+		if !picker.Confirm("続行しますか？") {
+			return &common.UserAbortedError{}
 		}
 	}
 
@@ -102,25 +103,25 @@ func DisableCanariesByFilter(client *synthetics.Client, filter string, skipConfi
 	var errors []error
 	successCount := 0
 	for _, canary := range toDisable {
-		if err := stopCanary(client, canary.Name); err != nil {
+		if err := stopCanary(ctx, client, canary.Name); err != nil {
 			errors = append(errors, fmt.Errorf("%s: %w", canary.Name, err))
 		} else {
-			fmt.Printf("✅ %s を無効化しました\n", canary.Name)
+			common.Progressf("✅ %s を無効化しました\n", canary.Name)
 			successCount++
 		}
 	}
 
 	// 結果サマリー
 	if len(alreadyStopped) > 0 {
-		fmt.Printf("\nℹ️  既に停止中: %d個\n", len(alreadyStopped))
+		common.Progressf("\nℹ️  既に停止中: %d個\n", len(alreadyStopped))
 	}
 	if successCount > 0 {
-		fmt.Printf("✅ 無効化成功: %d個\n", successCount)
+		common.Progressf("✅ 無効化成功: %d個\n", successCount)
 	}
 	if len(errors) > 0 {
-		fmt.Printf("❌ 無効化失敗: %d個\n", len(errors))
+		common.Warnf("❌ 無効化失敗: %d個\n", len(errors))
 		for _, err := range errors {
-			fmt.Printf("  - %v\n", err)
+			common.Warnf("  - %v\n", err)
 		}
 		return fmt.Errorf("一部のCanaryの無効化に失敗しました")
 	}
@@ -129,8 +130,8 @@ func DisableCanariesByFilter(client *synthetics.Client, filter string, skipConfi
 }
 
 // DisableAllCanaries 全てのCanaryを無効化
-func DisableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
-	canaries, err := getAllCanaries(client)
+func DisableAllCanaries(ctx context.Context, client API, skipConfirm bool) error {
+	canaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -157,12 +158,12 @@ func DisableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 	// 無効化対象がない場合
 	if len(toDisable) == 0 {
 		if len(alreadyStopped) > 0 {
-			fmt.Printf("ℹ️  全てのCanaryが既に停止しています (%d個)\n", len(alreadyStopped))
+			common.Progressf("ℹ️  全てのCanaryが既に停止しています (%d個)\n", len(alreadyStopped))
 		}
 		if len(cannotDisable) > 0 {
-			fmt.Printf("⚠️  以下のCanaryは現在の状態では無効化できません:\n")
+			common.Warnf("⚠️  以下のCanaryは現在の状態では無効化できません:\n")
 			for _, name := range cannotDisable {
-				fmt.Printf("  - %s\n", name)
+				common.Warnf("  - %s\n", name)
 			}
 		}
 		return nil
@@ -175,8 +176,8 @@ func DisableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 			fmt.Printf("  - %s (現在: %s)\n", c.Name, formatState(c.State))
 		}
 		fmt.Printf("\n🔴 警告: 全てのCanaryが停止すると、監視が行われなくなります。\n")
-		if !confirmAction("本当に続行しますか？") {
-			return fmt.Errorf("キャンセルされました")
+		if !picker.Confirm("本当に続行しますか？") {
+			return &common.UserAbortedError{}
 		}
 	}
 
@@ -184,29 +185,29 @@ func DisableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 	var errors []error
 	successCount := 0
 	for _, canary := range toDisable {
-		if err := stopCanary(client, canary.Name); err != nil {
+		if err := stopCanary(ctx, client, canary.Name); err != nil {
 			errors = append(errors, fmt.Errorf("%s: %w", canary.Name, err))
 		} else {
-			fmt.Printf("✅ %s を無効化しました\n", canary.Name)
+			common.Progressf("✅ %s を無効化しました\n", canary.Name)
 			successCount++
 		}
 	}
 
 	// 結果サマリー
-	fmt.Printf("\n--- 実行結果 ---\n")
+	common.Progressf("\n--- 実行結果 ---\n")
 	if len(alreadyStopped) > 0 {
-		fmt.Printf("ℹ️  既に停止中: %d個\n", len(alreadyStopped))
+		common.Progressf("ℹ️  既に停止中: %d個\n", len(alreadyStopped))
 	}
 	if successCount > 0 {
-		fmt.Printf("✅ 無効化成功: %d個\n", successCount)
+		common.Progressf("✅ 無効化成功: %d個\n", successCount)
 	}
 	if len(cannotDisable) > 0 {
-		fmt.Printf("⚠️  状態により対象外: %d個\n", len(cannotDisable))
+		common.Warnf("⚠️  状態により対象外: %d個\n", len(cannotDisable))
 	}
 	if len(errors) > 0 {
-		fmt.Printf("❌ 無効化失敗: %d個\n", len(errors))
+		common.Warnf("❌ 無効化失敗: %d個\n", len(errors))
 		for _, err := range errors {
-			fmt.Printf("  - %v\n", err)
+			common.Warnf("  - %v\n", err)
 		}
 		return fmt.Errorf("一部のCanaryの無効化に失敗しました")
 	}
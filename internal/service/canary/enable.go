@@ -1,15 +1,16 @@
 package canary
 
 import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
 // EnableCanary 指定したCanaryを有効化
-func EnableCanary(client *synthetics.Client, name string) error {
+func EnableCanary(ctx context.Context, client API, name string) error {
 	// 現在の状態を確認
-	canaries, err := getAllCanaries(client)
+	canaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -28,7 +29,7 @@ func EnableCanary(client *synthetics.Client, name string) error {
 
 	// 既に実行中の場合
 	if targetCanary.State == CanaryStateRunning {
-		fmt.Printf("ℹ️  %s は既に実行中です\n", name)
+		common.Progressf("ℹ️  %s は既に実行中です\n", name)
 		return nil
 	}
 
@@ -38,18 +39,18 @@ func EnableCanary(client *synthetics.Client, name string) error {
 	}
 
 	// 有効化実行
-	if err := startCanary(client, name); err != nil {
+	if err := startCanary(ctx, client, name); err != nil {
 		return err
 	}
 
-	fmt.Printf("✅ %s を有効化しました\n", name)
+	common.Progressf("✅ %s を有効化しました\n", name)
 	return nil
 }
 
 // EnableCanariesByFilter フィルタに一致するCanaryを有効化
-func EnableCanariesByFilter(client *synthetics.Client, filter string, skipConfirm bool) error {
+func EnableCanariesByFilter(ctx context.Context, client API, filter string, skipConfirm bool) error {
 	// フィルタに一致するCanaryを取得
-	canaries, err := getCanariesByFilter(client, filter)
+	canaries, err := getCanariesByFilter(ctx, client, filter)
 	if err != nil {
 		return err
 	}
@@ -76,12 +77,12 @@ func EnableCanariesByFilter(client *synthetics.Client, filter string, skipConfir
 	// 有効化対象がない場合
 	if len(toEnable) == 0 {
 		if len(alreadyRunning) > 0 {
-			fmt.Printf("ℹ️  全てのCanaryが既に実行中です\n")
+			common.Progressf("ℹ️  全てのCanaryが既に実行中です\n")
 		}
 		if len(cannotEnable) > 0 {
-			fmt.Printf("⚠️  以下のCanaryは現在の状態では有効化できません:\n")
+			common.Warnf("⚠️  以下のCanaryは現在の状態では有効化できません:\n")
 			for _, name := range cannotEnable {
-				fmt.Printf("  - %s\n", name)
+				common.Warnf("  - %s\n", name)
 			}
 		}
 		return nil
@@ -93,8 +94,8 @@ func EnableCanariesByFilter(client *synthetics.Client, filter string, skipConfir
 		for _, c := range toEnable {
 			fmt.Printf("  - %s (現在: %s)\n", c.Name, formatState(c.State))
 		}
-		if !confirmAction("続行しますか？") {
-			return fmt.Errorf("キャンセルされました")
+		if !picker.Confirm("続行しますか？") {
+			return &common.UserAbortedError{}
 		}
 	}
 
@@ -102,25 +103,25 @@ func EnableCanariesByFilter(client *synthetics.Client, filter string, skipConfir
 	var errors []error
 	successCount := 0
 	for _, canary := range toEnable {
-		if err := startCanary(client, canary.Name); err != nil {
+		if err := startCanary(ctx, client, canary.Name); err != nil {
 			errors = append(errors, fmt.Errorf("%s: %w", canary.Name, err))
 		} else {
-			fmt.Printf("✅ %s を有効化しました\n", canary.Name)
+			common.Progressf("✅ %s を有効化しました\n", canary.Name)
 			successCount++
 		}
 	}
 
 	// 結果サマリー
 	if len(alreadyRunning) > 0 {
-		fmt.Printf("\nℹ️  既に実行中: %d個\n", len(alreadyRunning))
+		common.Progressf("\nℹ️  既に実行中: %d個\n", len(alreadyRunning))
 	}
 	if successCount > 0 {
-		fmt.Printf("✅ 有効化成功: %d個\n", successCount)
+		common.Progressf("✅ 有効化成功: %d個\n", successCount)
 	}
 	if len(errors) > 0 {
-		fmt.Printf("❌ 有効化失敗: %d個\n", len(errors))
+		common.Warnf("❌ 有効化失敗: %d個\n", len(errors))
 		for _, err := range errors {
-			fmt.Printf("  - %v\n", err)
+			common.Warnf("  - %v\n", err)
 		}
 		return fmt.Errorf("一部のCanaryの有効化に失敗しました")
 	}
@@ -129,8 +130,8 @@ func EnableCanariesByFilter(client *synthetics.Client, filter string, skipConfir
 }
 
 // EnableAllCanaries 全てのCanaryを有効化
-func EnableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
-	canaries, err := getAllCanaries(client)
+func EnableAllCanaries(ctx context.Context, client API, skipConfirm bool) error {
+	canaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return err
 	}
@@ -157,12 +158,12 @@ func EnableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 	// 有効化対象がない場合
 	if len(toEnable) == 0 {
 		if len(alreadyRunning) > 0 {
-			fmt.Printf("ℹ️  全てのCanaryが既に実行中です (%d個)\n", len(alreadyRunning))
+			common.Progressf("ℹ️  全てのCanaryが既に実行中です (%d個)\n", len(alreadyRunning))
 		}
 		if len(cannotEnable) > 0 {
-			fmt.Printf("⚠️  以下のCanaryは現在の状態では有効化できません:\n")
+			common.Warnf("⚠️  以下のCanaryは現在の状態では有効化できません:\n")
 			for _, name := range cannotEnable {
-				fmt.Printf("  - %s\n", name)
+				common.Warnf("  - %s\n", name)
 			}
 		}
 		return nil
@@ -174,8 +175,8 @@ func EnableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 		for _, c := range toEnable {
 			fmt.Printf("  - %s (現在: %s)\n", c.Name, formatState(c.State))
 		}
-		if !confirmAction("続行しますか？") {
-			return fmt.Errorf("キャンセルされました")
+		if !picker.Confirm("続行しますか？") {
+			return &common.UserAbortedError{}
 		}
 	}
 
@@ -183,29 +184,29 @@ func EnableAllCanaries(client *synthetics.Client, skipConfirm bool) error {
 	var errors []error
 	successCount := 0
 	for _, canary := range toEnable {
-		if err := startCanary(client, canary.Name); err != nil {
+		if err := startCanary(ctx, client, canary.Name); err != nil {
 			errors = append(errors, fmt.Errorf("%s: %w", canary.Name, err))
 		} else {
-			fmt.Printf("✅ %s を有効化しました\n", canary.Name)
+			common.Progressf("✅ %s を有効化しました\n", canary.Name)
 			successCount++
 		}
 	}
 
 	// 結果サマリー
-	fmt.Printf("\n--- 実行結果 ---\n")
+	common.Progressf("\n--- 実行結果 ---\n")
 	if len(alreadyRunning) > 0 {
-		fmt.Printf("ℹ️  既に実行中: %d個\n", len(alreadyRunning))
+		common.Progressf("ℹ️  既に実行中: %d個\n", len(alreadyRunning))
 	}
 	if successCount > 0 {
-		fmt.Printf("✅ 有効化成功: %d個\n", successCount)
+		common.Progressf("✅ 有効化成功: %d個\n", successCount)
 	}
 	if len(cannotEnable) > 0 {
-		fmt.Printf("⚠️  状態により対象外: %d個\n", len(cannotEnable))
+		common.Warnf("⚠️  状態により対象外: %d個\n", len(cannotEnable))
 	}
 	if len(errors) > 0 {
-		fmt.Printf("❌ 有効化失敗: %d個\n", len(errors))
+		common.Warnf("❌ 有効化失敗: %d個\n", len(errors))
 		for _, err := range errors {
-			fmt.Printf("  - %v\n", err)
+			common.Warnf("  - %v\n", err)
 		}
 		return fmt.Errorf("一部のCanaryの有効化に失敗しました")
 	}
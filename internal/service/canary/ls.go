@@ -1,6 +1,7 @@
 package canary
 
 import (
+	"awstk/internal/i18n"
 	"context"
 	"fmt"
 	"time"
@@ -12,28 +13,46 @@ import (
 )
 
 // ListCanaries cmdから呼ばれるメイン関数（Get + Display）
-func ListCanaries(client *synthetics.Client) error {
+func ListCanaries(ctx context.Context, client API) error {
 	// Get: データ取得
-	canaries, err := getAllCanaries(client)
+	canaries, err := getAllCanaries(ctx, client)
 	if err != nil {
-		return common.FormatListError("Canary", err)
+		return common.FormatListError(i18n.T("resource.canary"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		canaries,
-		"Canary一覧",
+		common.GenerateFilteredTitle(i18n.T("resource.canary")),
 		canariesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "Canaryが見つかりませんでした",
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.canary")),
+		},
+	)
+}
+
+// ListCanariesInRegions 複数リージョンのCanaryを並列取得し、リージョン列付きで表示
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListCanariesInRegions(ctx context.Context, regions []string, clientFor func(region string) API) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Canary, error) {
+		return getAllCanaries(ctx, clientFor(region))
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.canary")),
+		canariesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.canary")),
 		},
 	)
 }
 
 // getAllCanaries 全てのCanaryを取得
-func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
-	resp, err := client.DescribeCanaries(context.Background(), &synthetics.DescribeCanariesInput{})
+func getAllCanaries(ctx context.Context, client API) ([]Canary, error) {
+	resp, err := client.DescribeCanaries(ctx, &synthetics.DescribeCanariesInput{})
 	if err != nil {
 		return nil, fmt.Errorf("canary一覧の取得に失敗: %w", err)
 	}
@@ -58,7 +77,7 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 		}
 
 		// 最新の実行結果を取得
-		runs, err := client.DescribeCanariesLastRun(context.Background(), &synthetics.DescribeCanariesLastRunInput{})
+		runs, err := client.DescribeCanariesLastRun(ctx, &synthetics.DescribeCanariesLastRunInput{})
 		if err == nil && len(runs.CanariesLastRun) > 0 {
 			for _, lastRun := range runs.CanariesLastRun {
 				if awssdk.ToString(lastRun.CanaryName) == canary.Name {
@@ -71,7 +90,7 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 		}
 
 		// 成功率の計算（直近の実行結果から）
-		canary.SuccessRate = calculateSuccessRate(client, canary.Name)
+		canary.SuccessRate = calculateSuccessRate(ctx, client, canary.Name)
 
 		canaries = append(canaries, canary)
 	}
@@ -80,9 +99,9 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 }
 
 // calculateSuccessRate 直近の実行結果から成功率を計算
-func calculateSuccessRate(client *synthetics.Client, canaryName string) float64 {
+func calculateSuccessRate(ctx context.Context, client API, canaryName string) float64 {
 	// 直近100件の実行結果を取得
-	runs, err := client.GetCanaryRuns(context.Background(), &synthetics.GetCanaryRunsInput{
+	runs, err := client.GetCanaryRuns(ctx, &synthetics.GetCanaryRunsInput{
 		Name:       awssdk.String(canaryName),
 		MaxResults: awssdk.Int32(100),
 	})
@@ -105,12 +124,12 @@ func calculateSuccessRate(client *synthetics.Client, canaryName string) float64
 // canariesToTableData Canary情報をテーブルデータに変換
 func canariesToTableData(canaries []Canary) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "名前"},
-		{Header: "状態"},
-		{Header: "スケジュール"},
-		{Header: "成功率"},
-		{Header: "最終実行"},
-		{Header: "最終結果"},
+		{Header: i18n.T("header.name")},
+		{Header: i18n.T("header.state")},
+		{Header: i18n.T("header.schedule")},
+		{Header: i18n.T("header.success_rate")},
+		{Header: i18n.T("header.last_run")},
+		{Header: i18n.T("header.last_result")},
 	}
 
 	data := make([][]string, len(canaries))
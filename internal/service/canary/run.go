@@ -1,6 +1,7 @@
 package canary
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
@@ -10,117 +11,99 @@ import (
 )
 
 // RunCanary 特定のCanaryを手動実行
-func RunCanary(client *synthetics.Client, name string) error {
-	fmt.Printf("Canary '%s' を実行中...\n", name)
+func RunCanary(ctx context.Context, client API, name string) error {
+	common.Progressf("Canary '%s' を実行中...\n", name)
 
-	_, err := client.StartCanary(context.Background(), &synthetics.StartCanaryInput{
+	_, err := client.StartCanary(ctx, &synthetics.StartCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
 		return fmt.Errorf("canary '%s' の実行に失敗: %w", name, err)
 	}
 
-	fmt.Printf("✓ Canary '%s' を開始しました\n", name)
+	common.Progressf("✓ Canary '%s' を開始しました\n", name)
 	return nil
 }
 
-// RunCanaryDryRun Canaryのドライラン実行（将来の拡張用）
-// 注意: 現在はドライラン機能を使わず、通常の実行と同じ動作をします
-func RunCanaryDryRun(client *synthetics.Client, name string) error {
-	fmt.Printf("Canary '%s' を実行中（ドライランモード）...\n", name)
-
-	// 現在はドライラン専用APIを使わず、通常の実行を行う
-	// 将来的にドライラン機能が必要になったら、この部分を拡張する
-	return RunCanary(client, name)
-}
-
-// RunCanariesByFilter フィルターに一致するCanaryを一括実行
-func RunCanariesByFilter(client *synthetics.Client, filters []string, dryRun bool, skipConfirm bool) error {
+// RunActions はCanary手動実行の実行計画アクションを生成します
+// name が指定された場合はそのCanaryのみ、それ以外はフィルターに一致するCanaryを対象にします
+func RunActions(ctx context.Context, client API, name string, filters []string) ([]common.Action, error) {
+	if name != "" {
+		return []common.Action{runAction(name, "")}, nil
+	}
 	if len(filters) == 0 {
-		return fmt.Errorf("フィルターが指定されていません")
+		return nil, fmt.Errorf("フィルターが指定されていません")
 	}
 
 	// フィルターに一致するCanaryを取得
 	var matchedCanaries []Canary
 	for _, filter := range filters {
-		canaries, err := getCanariesByFilter(client, filter)
+		canaries, err := getCanariesByFilter(ctx, client, filter)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		matchedCanaries = append(matchedCanaries, canaries...)
 	}
 
 	// 重複排除
 	uniqueCanaries := removeDuplicateCanaries(matchedCanaries)
-
 	if len(uniqueCanaries) == 0 {
-		fmt.Printf("フィルター '%s' に一致するCanaryが見つかりませんでした\n", strings.Join(filters, ", "))
-		return nil
-	}
-
-	// 実行対象を表示
-	actionType := "実行"
-	if dryRun {
-		actionType = "ドライラン実行"
+		common.Progressf("フィルター '%s' に一致するCanaryが見つかりませんでした\n", strings.Join(filters, ", "))
 	}
 
-	fmt.Printf("\n以下のCanaryを%sします:\n", actionType)
+	actions := make([]common.Action, 0, len(uniqueCanaries))
 	for _, canary := range uniqueCanaries {
-		fmt.Printf("  - %s (%s)\n", canary.Name, canary.State)
+		actions = append(actions, runAction(canary.Name, "State: "+canary.State))
 	}
-	fmt.Printf("\n合計: %d個のCanary\n", len(uniqueCanaries))
+	return actions, nil
+}
 
-	// 確認
-	if !skipConfirm {
-		if !confirmAction(fmt.Sprintf("%d個のCanaryを%sしますか？", len(uniqueCanaries), actionType)) {
-			fmt.Println("キャンセルしました")
-			return nil
-		}
+// runAction はCanary手動実行のアクションを生成します
+func runAction(name, detail string) common.Action {
+	return common.Action{
+		ResourceType: common.ResourceSyntheticCanary,
+		ResourceId:   name,
+		Operation:    common.OperationRun,
+		Risk:         common.RiskLow,
+		Detail:       detail,
 	}
-
-	// 実行
-	return executeCanaries(client, uniqueCanaries, dryRun)
 }
 
-// executeCanaries Canary群を実行
-func executeCanaries(client *synthetics.Client, canaries []Canary, dryRun bool) error {
+// RunCanaries Canary群を順に実行
+func RunCanaries(ctx context.Context, client API, names []string) error {
 	successCount := 0
 	errorCount := 0
 	var errors []string
+	results := make([]common.ItemResult, 0, len(names))
 
-	fmt.Printf("\n実行中...\n")
-
-	for _, canary := range canaries {
-		var err error
-		if dryRun {
-			err = RunCanaryDryRun(client, canary.Name)
-		} else {
-			err = RunCanary(client, canary.Name)
-		}
+	common.Progressf("\n実行中...\n")
 
+	for _, name := range names {
+		err := RunCanary(ctx, client, name)
+		results = append(results, common.ItemResult{Item: name, Err: err})
 		if err != nil {
 			errorCount++
-			errMsg := fmt.Sprintf("- %s: %v", canary.Name, err)
+			errMsg := fmt.Sprintf("- %s: %v", name, err)
 			errors = append(errors, errMsg)
-			fmt.Printf("✗ %s\n", errMsg)
+			common.Warnf("✗ %s\n", errMsg)
 		} else {
 			successCount++
 		}
 	}
 
 	// 結果サマリー
-	fmt.Printf("\n=== 実行結果 ===\n")
-	fmt.Printf("成功: %d個\n", successCount)
+	common.Progressf("\n=== 実行結果 ===\n")
+	common.Progressf("成功: %d個\n", successCount)
 	if errorCount > 0 {
-		fmt.Printf("失敗: %d個\n", errorCount)
-		fmt.Printf("\n失敗詳細:\n")
+		common.Warnf("失敗: %d個\n", errorCount)
+		common.Warnf("\n失敗詳細:\n")
 		for _, errMsg := range errors {
-			fmt.Printf("  %s\n", errMsg)
+			common.Warnf("  %s\n", errMsg)
 		}
-		return fmt.Errorf("%d個のCanaryの実行に失敗しました", errorCount)
+		return &common.PartialFailureError{Operation: "Canaryの実行", Results: results}
 	}
 
-	fmt.Printf("全てのCanaryの実行に成功しました！\n")
+	common.Progressf("全てのCanaryの実行に成功しました！\n")
 	return nil
 }
 
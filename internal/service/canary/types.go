@@ -1,9 +1,21 @@
 package canary
 
 import (
+	"context"
 	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
+// API はcanaryパッケージが利用するSynthetics APIのインターフェース
+type API interface {
+	DescribeCanaries(ctx context.Context, params *synthetics.DescribeCanariesInput, optFns ...func(*synthetics.Options)) (*synthetics.DescribeCanariesOutput, error)
+	DescribeCanariesLastRun(ctx context.Context, params *synthetics.DescribeCanariesLastRunInput, optFns ...func(*synthetics.Options)) (*synthetics.DescribeCanariesLastRunOutput, error)
+	GetCanaryRuns(ctx context.Context, params *synthetics.GetCanaryRunsInput, optFns ...func(*synthetics.Options)) (*synthetics.GetCanaryRunsOutput, error)
+	StartCanary(ctx context.Context, params *synthetics.StartCanaryInput, optFns ...func(*synthetics.Options)) (*synthetics.StartCanaryOutput, error)
+	StopCanary(ctx context.Context, params *synthetics.StopCanaryInput, optFns ...func(*synthetics.Options)) (*synthetics.StopCanaryOutput, error)
+}
+
 // Canary はAWS Synthetics Canaryの情報を表す構造体
 type Canary struct {
 	Name           string
@@ -0,0 +1,321 @@
+package cfn
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// statefulResourceTypes は置き換えられるとデータが失われるリソースタイプ
+var statefulResourceTypes = []string{
+	common.ResourceRdsInstance,
+	common.ResourceAuroraCluster,
+	"AWS::DynamoDB::Table",
+	"AWS::DynamoDB::GlobalTable",
+	common.ResourceS3Bucket,
+	"AWS::EFS::FileSystem",
+}
+
+// ChangeSetSummary はスタックのチェンジセットの概要
+type ChangeSetSummary struct {
+	Name            string
+	Status          string
+	ExecutionStatus string
+	CreationTime    *time.Time
+	Description     string
+	StatusReason    string
+}
+
+// ChangeSet はチェンジセットと、ネストしたチェンジセットを含むリソースの変更内容
+type ChangeSet struct {
+	Name            string
+	StackName       string
+	Status          string
+	StatusReason    string
+	ExecutionStatus string
+	Changes         []ResourceChange
+}
+
+// ResourceChange はチェンジセットに含まれるリソースの変更
+type ResourceChange struct {
+	Stack               string // 変更対象のリソースが属するスタック名
+	Action              string // Add / Modify / Remove / Import / Dynamic
+	LogicalId           string
+	PhysicalId          string
+	ResourceType        string
+	Replacement         string   // True / False / Conditional（Modify の場合のみ）
+	Scope               []string // 変更されるリソースの属性（Properties の場合は変更されるプロパティ名を含む）
+	CausingEntities     []string // 変更の原因となったパラメータ・リソースなど
+	StatefulReplacement bool     // ステートフルなリソースが置き換えられる（可能性がある）かどうか
+
+	depth int // ルートのチェンジセットからのネストの深さ
+}
+
+// ListChangeSets はスタックのチェンジセットの一覧を取得します
+func ListChangeSets(ctx context.Context, cfnClient API, stackName string) ([]ChangeSetSummary, error) {
+	var summaries []ChangeSetSummary
+	var nextToken *string
+	for {
+		output, err := cfnClient.ListChangeSets(ctx, &cloudformation.ListChangeSetsInput{
+			StackName: aws.String(stackName),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("スタック %s のチェンジセット一覧の取得に失敗しました: %w", stackName, err)
+		}
+
+		for _, s := range output.Summaries {
+			// ネストしたスタックのチェンジセットはルートのチェンジセットに含めて表示する
+			if aws.ToString(s.ParentChangeSetId) != "" {
+				continue
+			}
+			summaries = append(summaries, ChangeSetSummary{
+				Name:            aws.ToString(s.ChangeSetName),
+				Status:          string(s.Status),
+				ExecutionStatus: string(s.ExecutionStatus),
+				CreationTime:    s.CreationTime,
+				Description:     aws.ToString(s.Description),
+				StatusReason:    aws.ToString(s.StatusReason),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return summaries, nil
+}
+
+// DescribeChangeSet はチェンジセットのリソースの変更内容を取得します
+// ネストしたスタックのチェンジセットも再帰的にたどり、そのスタックを表す変更の直後に並べます
+func DescribeChangeSet(ctx context.Context, cfnClient API, stackName, changeSetName string) (*ChangeSet, error) {
+	changeSet := &ChangeSet{}
+	if err := describeChangeSet(ctx, cfnClient, stackName, changeSetName, 0, changeSet); err != nil {
+		return nil, err
+	}
+	return changeSet, nil
+}
+
+// describeChangeSet は changeSetName の変更内容を changeSet に追加する
+// ネストしたチェンジセットはチェンジセットIDで参照するため stackName は空でもよい
+func describeChangeSet(ctx context.Context, cfnClient API, stackName, changeSetName string, depth int, changeSet *ChangeSet) error {
+	var nextToken *string
+	for {
+		input := &cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(changeSetName),
+			NextToken:     nextToken,
+		}
+		if stackName != "" {
+			input.StackName = aws.String(stackName)
+		}
+		output, err := cfnClient.DescribeChangeSet(ctx, input)
+		if err != nil {
+			return fmt.Errorf("チェンジセット %s の取得に失敗しました: %w", changeSetName, err)
+		}
+
+		if depth == 0 && nextToken == nil {
+			changeSet.Name = aws.ToString(output.ChangeSetName)
+			changeSet.StackName = aws.ToString(output.StackName)
+			changeSet.Status = string(output.Status)
+			changeSet.StatusReason = aws.ToString(output.StatusReason)
+			changeSet.ExecutionStatus = string(output.ExecutionStatus)
+		}
+
+		for _, c := range output.Changes {
+			if c.ResourceChange == nil {
+				continue
+			}
+			change := newResourceChange(aws.ToString(output.StackName), c.ResourceChange, depth)
+			changeSet.Changes = append(changeSet.Changes, change)
+
+			nestedId := aws.ToString(c.ResourceChange.ChangeSetId)
+			if change.ResourceType == nestedStackType && nestedId != "" {
+				if err := describeChangeSet(ctx, cfnClient, "", nestedId, depth+1, changeSet); err != nil {
+					return err
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return nil
+}
+
+// newResourceChange はAPIのリソースの変更を表示用に変換する
+func newResourceChange(stackName string, rc *types.ResourceChange, depth int) ResourceChange {
+	change := ResourceChange{
+		Stack:        stackName,
+		Action:       string(rc.Action),
+		LogicalId:    aws.ToString(rc.LogicalResourceId),
+		PhysicalId:   aws.ToString(rc.PhysicalResourceId),
+		ResourceType: aws.ToString(rc.ResourceType),
+		Replacement:  string(rc.Replacement),
+		depth:        depth,
+	}
+
+	var properties []string
+	for _, d := range rc.Details {
+		if d.Target != nil && d.Target.Attribute == types.ResourceAttributeProperties {
+			if name := aws.ToString(d.Target.Name); name != "" && !slices.Contains(properties, name) {
+				properties = append(properties, name)
+			}
+		}
+		entity := aws.ToString(d.CausingEntity)
+		if entity == "" {
+			entity = string(d.ChangeSource)
+		}
+		if entity != "" && !slices.Contains(change.CausingEntities, entity) {
+			change.CausingEntities = append(change.CausingEntities, entity)
+		}
+	}
+	for _, s := range rc.Scope {
+		scope := string(s)
+		if s == types.ResourceAttributeProperties && len(properties) > 0 {
+			scope += " (" + strings.Join(properties, ", ") + ")"
+		}
+		change.Scope = append(change.Scope, scope)
+	}
+
+	change.StatefulReplacement = (rc.Replacement == types.ReplacementTrue || rc.Replacement == types.ReplacementConditional) &&
+		slices.Contains(statefulResourceTypes, change.ResourceType)
+	return change
+}
+
+// StatefulReplacements はステートフルなリソースが置き換えられる変更を返します
+func (c *ChangeSet) StatefulReplacements() []ResourceChange {
+	var replacements []ResourceChange
+	for _, change := range c.Changes {
+		if change.StatefulReplacement {
+			replacements = append(replacements, change)
+		}
+	}
+	return replacements
+}
+
+// CheckExecutable はチェンジセットが実行可能な状態かを確認します
+func (c *ChangeSet) CheckExecutable() error {
+	if c.ExecutionStatus != string(types.ExecutionStatusAvailable) {
+		reason := c.StatusReason
+		if reason == "" {
+			reason = c.Status + " / " + c.ExecutionStatus
+		}
+		return fmt.Errorf("❌ チェンジセット %s は実行できません: %s", c.Name, reason)
+	}
+	return nil
+}
+
+// ExecuteChangeSet はチェンジセットを実行します
+func ExecuteChangeSet(ctx context.Context, cfnClient API, stackName, changeSetName string) error {
+	_, err := cfnClient.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		StackName:     aws.String(stackName),
+		ChangeSetName: aws.String(changeSetName),
+	})
+	if err != nil {
+		return fmt.Errorf("チェンジセット %s の実行に失敗しました: %w", changeSetName, err)
+	}
+	return nil
+}
+
+// DeleteChangeSet はチェンジセットを削除します
+func DeleteChangeSet(ctx context.Context, cfnClient API, stackName, changeSetName string) error {
+	_, err := cfnClient.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+		StackName:     aws.String(stackName),
+		ChangeSetName: aws.String(changeSetName),
+	})
+	if err != nil {
+		return fmt.Errorf("チェンジセット %s の削除に失敗しました: %w", changeSetName, err)
+	}
+	return nil
+}
+
+// PrintChangeSets はチェンジセットの一覧を表形式で表示します
+func PrintChangeSets(stackName string, summaries []ChangeSetSummary) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.name")},
+		{Header: i18n.T("header.status")},
+		{Header: i18n.T("header.execution_status")},
+		{Header: i18n.T("header.created")},
+		{Header: i18n.T("header.description")},
+	}
+	data := make([][]string, len(summaries))
+	for i, s := range summaries {
+		created := ""
+		if s.CreationTime != nil {
+			created = s.CreationTime.Local().Format("2006-01-02 15:04:05")
+		}
+		data[i] = []string{s.Name, s.Status, s.ExecutionStatus, created, s.Description}
+	}
+	common.PrintTable(fmt.Sprintf("スタック %s のチェンジセット一覧", stackName), columns, data)
+	fmt.Printf("\n合計: %d チェンジセット\n", len(summaries))
+}
+
+// PrintChangeSet はチェンジセットの変更内容を表形式で表示します
+// ステートフルなリソースの置き換えは赤色で表示し、表の後に警告としてまとめて表示します
+func PrintChangeSet(changeSet *ChangeSet) {
+	fmt.Printf("チェンジセット: %s (%s / %s)\n", changeSet.Name, changeSet.Status, changeSet.ExecutionStatus)
+	if changeSet.StatusReason != "" {
+		fmt.Printf("理由: %s\n", changeSet.StatusReason)
+	}
+	if len(changeSet.Changes) == 0 {
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.resource_change")))
+		return
+	}
+
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.stack")},
+		{Header: i18n.T("header.action")},
+		{Header: i18n.T("header.resource")},
+		{Header: i18n.T("header.resource_type")},
+		{Header: i18n.T("header.replacement")},
+		{Header: i18n.T("header.scope")},
+		{Header: i18n.T("header.causing_entity")},
+	}
+	data := make([][]string, len(changeSet.Changes))
+	for i, c := range changeSet.Changes {
+		row := []string{
+			strings.Repeat("  ", c.depth) + c.Stack,
+			c.Action,
+			c.LogicalId,
+			c.ResourceType,
+			c.Replacement,
+			strings.Join(c.Scope, ", "),
+			strings.Join(c.CausingEntities, ", "),
+		}
+		if c.StatefulReplacement {
+			for j := range row {
+				row[j] = common.Red(row[j])
+			}
+		}
+		data[i] = row
+	}
+	common.PrintTable(fmt.Sprintf("スタック %s の変更内容", changeSet.StackName), columns, data)
+	fmt.Printf("\n合計: %d 件の変更\n", len(changeSet.Changes))
+
+	replacements := changeSet.StatefulReplacements()
+	if len(replacements) == 0 {
+		return
+	}
+	fmt.Println(common.Red(fmt.Sprintf("\n%s  ステートフルなリソースが置き換えられます。既存のデータが失われる可能性があります:", common.WarningIcon)))
+	for _, c := range replacements {
+		line := fmt.Sprintf("  - %s/%s (%s)", c.Stack, c.LogicalId, c.ResourceType)
+		if c.PhysicalId != "" {
+			line += " " + c.PhysicalId
+		}
+		if c.Replacement == string(types.ReplacementConditional) {
+			line += " （変更内容によっては置き換え）"
+		}
+		fmt.Println(common.Red(line))
+	}
+}
@@ -0,0 +1,145 @@
+package cfn
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// newChangeSetFake は app スタックのチェンジセット deploy-1 と、そのネストしたスタックのチェンジセットを持つフェイクを返す
+func newChangeSetFake() *fakeaws.CloudFormation {
+	bucket := fakeaws.ResourceChange(types.ChangeActionModify, "Bucket", "AWS::S3::Bucket", types.ReplacementTrue)
+	bucket.ResourceChange.Scope = []types.ResourceAttribute{types.ResourceAttributeProperties}
+	bucket.ResourceChange.Details = []types.ResourceChangeDetail{
+		{
+			Target:        &types.ResourceTargetDefinition{Attribute: types.ResourceAttributeProperties, Name: aws.String("BucketName")},
+			ChangeSource:  types.ChangeSourceParameterReference,
+			CausingEntity: aws.String("BucketName"),
+		},
+		{
+			Target:       &types.ResourceTargetDefinition{Attribute: types.ResourceAttributeProperties, Name: aws.String("BucketName")},
+			ChangeSource: types.ChangeSourceDirectModification,
+		},
+	}
+	nested := fakeaws.ResourceChange(types.ChangeActionModify, "Database", "AWS::CloudFormation::Stack", types.ReplacementFalse)
+	nested.ResourceChange.ChangeSetId = aws.String(fakeaws.ChangeSetId("app-Database", "deploy-1-nested"))
+
+	return fakeaws.NewCloudFormation(
+		&fakeaws.Stack{
+			Name:   "app",
+			Status: types.StackStatusUpdateComplete,
+			ChangeSets: []*fakeaws.ChangeSet{{
+				Name: "deploy-1",
+				Changes: []types.Change{
+					fakeaws.ResourceChange(types.ChangeActionAdd, "Queue", "AWS::SQS::Queue", ""),
+					nested,
+					bucket,
+					fakeaws.ResourceChange(types.ChangeActionModify, "Function", "AWS::Lambda::Function", types.ReplacementFalse),
+				},
+			}},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Database",
+			Status: types.StackStatusUpdateComplete,
+			ChangeSets: []*fakeaws.ChangeSet{{
+				Name:   "deploy-1-nested",
+				Parent: fakeaws.ChangeSetId("app", "deploy-1"),
+				Changes: []types.Change{
+					fakeaws.ResourceChange(types.ChangeActionModify, "Table", "AWS::DynamoDB::Table", types.ReplacementConditional),
+					fakeaws.ResourceChange(types.ChangeActionRemove, "Alarm", "AWS::CloudWatch::Alarm", ""),
+				},
+			}},
+		},
+	)
+}
+
+func TestDescribeChangeSet(t *testing.T) {
+	fake := newChangeSetFake()
+	fake.PageSize = 2
+
+	changeSet, err := DescribeChangeSet(t.Context(), fake, "app", "deploy-1")
+	if err != nil {
+		t.Fatalf("DescribeChangeSet() error = %v", err)
+	}
+	if changeSet.StackName != "app" || changeSet.Status != string(types.ChangeSetStatusCreateComplete) {
+		t.Errorf("StackName, Status = %s, %s, want app, CREATE_COMPLETE", changeSet.StackName, changeSet.Status)
+	}
+
+	// ネストしたスタックの変更は、そのスタックを表す変更の直後に並ぶ
+	var got []string
+	for _, c := range changeSet.Changes {
+		got = append(got, strings.Repeat("  ", c.depth)+c.Stack+"/"+c.LogicalId+":"+c.Action)
+	}
+	want := []string{
+		"app/Queue:Add",
+		"app/Database:Modify",
+		"  app-Database/Table:Modify",
+		"  app-Database/Alarm:Remove",
+		"app/Bucket:Modify",
+		"app/Function:Modify",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("DescribeChangeSet() = %q, want %q", got, want)
+	}
+
+	bucket := changeSet.Changes[4]
+	if !slices.Equal(bucket.Scope, []string{"Properties (BucketName)"}) {
+		t.Errorf("Scope = %q, want [Properties (BucketName)]", bucket.Scope)
+	}
+	if !slices.Equal(bucket.CausingEntities, []string{"BucketName", "DirectModification"}) {
+		t.Errorf("CausingEntities = %q, want [BucketName DirectModification]", bucket.CausingEntities)
+	}
+
+	// ステートフルなリソースの置き換え（Conditional を含む）のみを警告する
+	var replaced []string
+	for _, c := range changeSet.StatefulReplacements() {
+		replaced = append(replaced, c.LogicalId)
+	}
+	if !slices.Equal(replaced, []string{"Table", "Bucket"}) {
+		t.Errorf("StatefulReplacements() = %v, want [Table Bucket]", replaced)
+	}
+}
+
+func TestListChangeSets(t *testing.T) {
+	fake := newChangeSetFake()
+	// ネストしたスタックのチェンジセットは一覧に含めない
+	fake.Stack("app").ChangeSets = append(fake.Stack("app").ChangeSets, &fakeaws.ChangeSet{
+		Name:   "nested-of-other",
+		Parent: fakeaws.ChangeSetId("root", "deploy-2"),
+	})
+
+	summaries, err := ListChangeSets(t.Context(), fake, "app")
+	if err != nil {
+		t.Fatalf("ListChangeSets() error = %v", err)
+	}
+	if len(summaries) != 1 || summaries[0].Name != "deploy-1" {
+		t.Errorf("ListChangeSets() = %+v, want deploy-1 のみ", summaries)
+	}
+}
+
+func TestCheckExecutable(t *testing.T) {
+	fake := newChangeSetFake()
+	changeSet, err := DescribeChangeSet(t.Context(), fake, "app", "deploy-1")
+	if err != nil {
+		t.Fatalf("DescribeChangeSet() error = %v", err)
+	}
+	if err := changeSet.CheckExecutable(); err != nil {
+		t.Errorf("CheckExecutable() error = %v", err)
+	}
+
+	if err := ExecuteChangeSet(t.Context(), fake, "app", "deploy-1"); err != nil {
+		t.Fatalf("ExecuteChangeSet() error = %v", err)
+	}
+	changeSet, err = DescribeChangeSet(t.Context(), fake, "app", "deploy-1")
+	if err != nil {
+		t.Fatalf("DescribeChangeSet() error = %v", err)
+	}
+	if err := changeSet.CheckExecutable(); err == nil {
+		t.Error("実行済みのチェンジセットで CheckExecutable() error = nil, want error")
+	}
+}
@@ -1,10 +1,10 @@
 package cfn
 
 import (
-	"bufio"
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,71 +13,95 @@ import (
 )
 
 // CleanupStacks は指定した条件に一致するスタックを削除します
-func CleanupStacks(cfnClient *cloudformation.Client, opts CleanupOptions) error {
+func CleanupStacks(ctx context.Context, cfnClient API, opts CleanupOptions) error {
 	// 削除対象のスタックを検索
-	stacks, err := findStacksForCleanup(cfnClient, opts)
+	actions, err := CleanupActions(ctx, cfnClient, opts)
 	if err != nil {
 		return err
 	}
 
-	if len(stacks) == 0 {
-		fmt.Println("削除対象のスタックが見つかりませんでした")
-		return nil
+	if len(actions) == 0 {
+		return common.NotFoundf("削除対象のスタックが見つかりませんでした")
 	}
 
 	// 削除対象のスタック一覧を表示
-	fmt.Println("🔍 削除対象のスタック:")
-	for _, stack := range stacks {
-		fmt.Printf("  - %s (Status: %s)\n", aws.ToString(stack.StackName), stack.StackStatus)
+	common.Progressln("🔍 削除対象のスタック:")
+	for _, action := range actions {
+		common.Progressf("  - %s (%s)\n", action.ResourceId, action.Detail)
 	}
-	fmt.Printf("\n合計 %d 個のスタックが削除されます\n", len(stacks))
+	common.Progressf("\n合計 %d 個のスタックが削除されます\n", len(actions))
 
 	// 確認プロンプト
 	if !opts.Force {
-		fmt.Print("\n本当に削除しますか？ [y/N]: ")
-		reader := bufio.NewReader(os.Stdin)
-		response, _ := reader.ReadString('\n')
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "y" && response != "yes" {
-			fmt.Println("削除をキャンセルしました")
-			return nil
+		if !picker.Confirm("\n本当に削除しますか？") {
+			return &common.UserAbortedError{}
 		}
 	}
 
-	// スタックを削除
-	fmt.Println("\n削除を開始します...")
-	deleteCount := 0
+	stackNames := make([]string, len(actions))
+	for i, action := range actions {
+		stackNames[i] = action.ResourceId
+	}
+	return DeleteStacks(ctx, cfnClient, stackNames)
+}
+
+// CleanupActions は指定した条件に一致するスタック削除の実行計画アクションを生成します
+// 削除保護が有効なスタックは計画に含めません
+func CleanupActions(ctx context.Context, cfnClient API, opts CleanupOptions) ([]common.Action, error) {
+	stacks, err := findStacksForCleanup(ctx, cfnClient, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []common.Action
 	for _, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
-		fmt.Printf("スタック %s を削除中...", stackName)
-
-		// 削除保護の確認
 		if aws.ToBool(stack.EnableTerminationProtection) {
-			fmt.Printf("\n⚠️  スタック %s は削除保護が有効です。スキップします\n", stackName)
+			common.Warnf("⚠️  スタック %s は削除保護が有効なため対象外です\n", stackName)
 			continue
 		}
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceCfnStack,
+			ResourceId:   stackName,
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+			Detail:       "Status: " + string(stack.StackStatus),
+		})
+	}
+	return actions, nil
+}
+
+// DeleteStacks は指定したスタックの削除リクエストを送信します
+func DeleteStacks(ctx context.Context, cfnClient API, stackNames []string) error {
+	common.Progressln("\n削除を開始します...")
+	deleteCount := 0
+	results := make([]common.ItemResult, 0, len(stackNames))
+	for _, stackName := range stackNames {
+		common.Progressf("スタック %s を削除中...", stackName)
 
-		_, err := cfnClient.DeleteStack(context.Background(), &cloudformation.DeleteStackInput{
+		_, err := cfnClient.DeleteStack(ctx, &cloudformation.DeleteStackInput{
 			StackName: aws.String(stackName),
 		})
+		results = append(results, common.ItemResult{Item: stackName, Err: err})
 		if err != nil {
-			fmt.Printf("\n❌ スタック %s の削除に失敗しました: %v\n", stackName, err)
+			common.Warnf("\n❌ スタック %s の削除に失敗しました: %v\n", stackName, err)
 			continue
 		}
-		fmt.Printf(" ✅\n")
+		common.Progressf(" ✅\n")
 		deleteCount++
 	}
 
-	fmt.Printf("\n✅ %d 個のスタックの削除リクエストを送信しました\n", deleteCount)
-	if deleteCount < len(stacks) {
-		fmt.Printf("⚠️  %d 個のスタックはスキップされました\n", len(stacks)-deleteCount)
+	common.Progressf("\n✅ %d 個のスタックの削除リクエストを送信しました\n", deleteCount)
+	if deleteCount < len(stackNames) {
+		common.Warnf("⚠️  %d 個のスタックはスキップされました\n", len(stackNames)-deleteCount)
+		return &common.PartialFailureError{Operation: "スタックの削除", Results: results}
 	}
 
 	return nil
 }
 
 // findStacksForCleanup は指定した条件に一致するスタックを検索します
-func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions) ([]types.Stack, error) {
+func findStacksForCleanup(ctx context.Context, cfnClient API, opts CleanupOptions) ([]types.Stack, error) {
 	// ステータスフィルターの解析
 	var targetStatuses []types.StackStatus
 	if opts.Status != "" {
@@ -121,7 +145,7 @@ func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions)
 			}
 		}
 
-		output, err := cfnClient.ListStacks(context.Background(), input)
+		output, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("スタック一覧の取得に失敗しました: %w", err)
 		}
@@ -130,7 +154,7 @@ func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions)
 		for _, summary := range output.StackSummaries {
 			if opts.Filter == "" || strings.Contains(aws.ToString(summary.StackName), opts.Filter) {
 				// スタックの詳細情報を取得（削除保護の確認のため）
-				describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+				describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 					StackName: summary.StackName,
 				})
 				if err != nil {
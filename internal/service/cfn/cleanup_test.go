@@ -0,0 +1,107 @@
+package cfn
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+var _ API = (*fakeaws.CloudFormation)(nil)
+
+func TestCleanupStacks(t *testing.T) {
+	newStacks := func() []*fakeaws.Stack {
+		return []*fakeaws.Stack{
+			{Name: "dev-app", Status: types.StackStatusCreateComplete},
+			{Name: "dev-db", Status: types.StackStatusUpdateRollbackComplete, TerminationProtection: true},
+			{Name: "dev-broken", Status: types.StackStatusRollbackComplete},
+			{Name: "dev-updating", Status: types.StackStatusUpdateInProgress},
+			{Name: "prod-app", Status: types.StackStatusCreateComplete},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		opts        CleanupOptions
+		pageSize    int
+		failDelete  string
+		wantExit    int // common.ExitCode(err) の期待値
+		wantDeleted []string
+	}{
+		{
+			name:        "フィルターに一致する削除可能なスタックのみ削除",
+			opts:        CleanupOptions{Filter: "dev-", Force: true},
+			wantDeleted: []string{"dev-app", "dev-broken"},
+		},
+		{
+			name:        "ステータス指定",
+			opts:        CleanupOptions{Status: "ROLLBACK_COMPLETE", Force: true},
+			wantDeleted: []string{"dev-broken"},
+		},
+		{
+			name:        "カンマ区切りの複数ステータス",
+			opts:        CleanupOptions{Filter: "app", Status: "CREATE_COMPLETE, ROLLBACK_COMPLETE", Force: true},
+			wantDeleted: []string{"dev-app", "prod-app"},
+		},
+		{
+			name:        "ページネーションをまたいで削除",
+			opts:        CleanupOptions{Force: true},
+			pageSize:    1,
+			wantDeleted: []string{"dev-app", "dev-broken", "prod-app"},
+		},
+		{
+			name:        "削除に失敗したスタックは残る",
+			opts:        CleanupOptions{Filter: "dev-", Force: true},
+			failDelete:  "dev-app",
+			wantExit:    common.ExitPartialFailure,
+			wantDeleted: []string{"dev-broken"},
+		},
+		{
+			name:        "一致なし",
+			opts:        CleanupOptions{Filter: "staging", Force: true},
+			wantExit:    common.ExitNotFound,
+			wantDeleted: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewCloudFormation(newStacks()...)
+			fake.PageSize = tt.pageSize
+			if tt.failDelete != "" {
+				fake.Fail("DeleteStack", tt.failDelete, errors.New("throttled"))
+			}
+
+			err := CleanupStacks(t.Context(), fake, tt.opts)
+			if got := common.ExitCode(err); got != tt.wantExit {
+				t.Fatalf("ExitCode(CleanupStacks()) = %d, want %d (error = %v)", got, tt.wantExit, err)
+			}
+
+			var deleted []string
+			for _, s := range fake.Stacks {
+				if s.Status == types.StackStatusDeleteComplete {
+					deleted = append(deleted, s.Name)
+				}
+			}
+			if !slices.Equal(deleted, tt.wantDeleted) {
+				t.Errorf("deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if fake.CallCount("DeleteStack:dev-db") != 0 {
+				t.Errorf("削除保護が有効なスタックに DeleteStack が呼ばれました")
+			}
+		})
+	}
+}
+
+func TestCleanupStacks_ListError(t *testing.T) {
+	fake := fakeaws.NewCloudFormation()
+	fake.Fail("ListStacks", "", errors.New("access denied"))
+
+	if err := CleanupStacks(t.Context(), fake, CleanupOptions{Force: true}); err == nil {
+		t.Fatal("CleanupStacks() error = nil, want error")
+	}
+}
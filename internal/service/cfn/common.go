@@ -1,13 +1,9 @@
 package cfn
 
 import (
+	"awstk/internal/service/common"
 	"context"
-	"fmt"
 	"strings"
-
-	awssdk "github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 )
 
 // EcsServiceInfo はECSサービスの情報を格納する構造体（ローカル定義）
@@ -17,30 +13,27 @@ type EcsServiceInfo struct {
 }
 
 // GetStackResources はスタックからリソース一覧を取得する関数
-func GetStackResources(cfnClient *cloudformation.Client, stackName string) ([]types.StackResource, error) {
-	ctx := context.Background()
-
+// ネストしたスタックのリソースも含めてすべて取得します
+func GetStackResources(ctx context.Context, cfnClient API, stackName string) ([]StackResource, error) {
 	// スタックからリソースを取得
-	fmt.Printf("🔍 スタック '%s' からリソースを検索中...\n", stackName)
-	resp, err := cfnClient.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
-		StackName: awssdk.String(stackName),
-	})
+	common.Progressf("🔍 スタック '%s' からリソースを検索中...\n", stackName)
+	resources, err := WalkStackResources(ctx, cfnClient, stackName)
 	if err != nil {
-		return nil, fmt.Errorf("cloudFormationスタックのリソース取得に失敗: %w", err)
+		return nil, err
 	}
 
 	// スタック存在確認
-	if len(resp.StackResources) == 0 {
-		return nil, fmt.Errorf("スタック '%s' にリソースが見つかりませんでした", stackName)
+	if len(resources) == 0 {
+		return nil, common.NotFoundf("スタック '%s' にリソースが見つかりませんでした", stackName)
 	}
 
-	return resp.StackResources, nil
+	return resources, nil
 }
 
 // GetCleanupResourcesFromStack はCloudFormationスタックからS3バケットとECRリポジトリのリソース一覧を取得します
-func GetCleanupResourcesFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, []string, error) {
+func GetCleanupResourcesFromStack(ctx context.Context, cfnClient API, stackName string) ([]string, []string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -51,18 +44,18 @@ func GetCleanupResourcesFromStack(cfnClient *cloudformation.Client, stackName st
 
 	for _, resource := range stackResources {
 		// リソースタイプに基づいて振り分け
-		resourceType := *resource.ResourceType
+		resourceType := resource.ResourceType
 
 		// S3バケット
-		if resourceType == "AWS::S3::Bucket" && resource.PhysicalResourceId != nil {
-			s3Resources = append(s3Resources, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたS3バケット: %s\n", *resource.PhysicalResourceId)
+		if resourceType == "AWS::S3::Bucket" && resource.PhysicalId != "" {
+			s3Resources = append(s3Resources, resource.PhysicalId)
+			common.Progressf("🔍 検出されたS3バケット: %s\n", resource.PhysicalId)
 		}
 
 		// ECRリポジトリ
-		if resourceType == "AWS::ECR::Repository" && resource.PhysicalResourceId != nil {
-			ecrResources = append(ecrResources, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたECRリポジトリ: %s\n", *resource.PhysicalResourceId)
+		if resourceType == "AWS::ECR::Repository" && resource.PhysicalId != "" {
+			ecrResources = append(ecrResources, resource.PhysicalId)
+			common.Progressf("🔍 検出されたECRリポジトリ: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -70,39 +63,42 @@ func GetCleanupResourcesFromStack(cfnClient *cloudformation.Client, stackName st
 }
 
 // getStartStopResourcesFromStack はCloudFormationスタックから起動・停止可能なリソースの識別子を取得します
-func getStartStopResourcesFromStack(cfnClient *cloudformation.Client, stackName string) (StackResources, error) {
+func getStartStopResourcesFromStack(ctx context.Context, cfnClient API, stackName string) (StackResources, error) {
 	var result StackResources
 
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return result, err
 	}
 
-	// Auroraクラスターの存在フラグ
-	hasAuroraCluster := false
+	// Auroraクラスターを持つスタック（ネストしたスタックごとに判定する）
+	auroraStacks := make(map[string]bool)
+	for _, resource := range stackResources {
+		if resource.ResourceType == "AWS::RDS::DBCluster" && resource.PhysicalId != "" {
+			auroraStacks[resource.Stack] = true
+		}
+	}
 
 	// 各リソースタイプをフィルタリング
 	for _, resource := range stackResources {
-		if resource.PhysicalResourceId == nil || *resource.PhysicalResourceId == "" {
+		if resource.PhysicalId == "" {
 			continue
 		}
 
-		switch *resource.ResourceType {
+		switch resource.ResourceType {
 		case "AWS::RDS::DBCluster":
-			// Aurora DBクラスターを検出した場合、フラグを立てる
-			hasAuroraCluster = true
-			result.AuroraClusterIds = append(result.AuroraClusterIds, *resource.PhysicalResourceId)
+			result.AuroraClusterIds = append(result.AuroraClusterIds, resource.PhysicalId)
 		case "AWS::RDS::DBInstance":
-			// Aurora DBクラスターが存在しない場合のみ、純粋なRDSインスタンスとして扱う
-			if !hasAuroraCluster {
-				result.RdsInstanceIds = append(result.RdsInstanceIds, *resource.PhysicalResourceId)
+			// 同じスタックにAurora DBクラスターが存在しない場合のみ、純粋なRDSインスタンスとして扱う
+			if !auroraStacks[resource.Stack] {
+				result.RdsInstanceIds = append(result.RdsInstanceIds, resource.PhysicalId)
 			}
 		case "AWS::EC2::Instance":
-			result.Ec2InstanceIds = append(result.Ec2InstanceIds, *resource.PhysicalResourceId)
+			result.Ec2InstanceIds = append(result.Ec2InstanceIds, resource.PhysicalId)
 		case "AWS::ECS::Service":
 			// ECSサービスARNからクラスター名とサービス名を抽出
-			serviceArn := *resource.PhysicalResourceId
+			serviceArn := resource.PhysicalId
 			parts := strings.Split(serviceArn, "/")
 			if len(parts) >= 2 {
 				clusterName := parts[len(parts)-2]
@@ -127,33 +123,33 @@ func getStartStopResourcesFromStack(cfnClient *cloudformation.Client, stackName
 
 // printResourcesSummary はスタック内の検出されたリソースサマリーを表示します
 func printResourcesSummary(resources StackResources) {
-	fmt.Println("📋 検出されたリソース:")
+	common.Progressln("📋 検出されたリソース:")
 
 	if len(resources.Ec2InstanceIds) > 0 {
-		fmt.Println("  EC2インスタンス:")
+		common.Progressln("  EC2インスタンス:")
 		for _, id := range resources.Ec2InstanceIds {
-			fmt.Println("   - " + id)
+			common.Progressln("   - " + id)
 		}
 	}
 
 	if len(resources.RdsInstanceIds) > 0 {
-		fmt.Println("  RDSインスタンス:")
+		common.Progressln("  RDSインスタンス:")
 		for _, id := range resources.RdsInstanceIds {
-			fmt.Println("   - " + id)
+			common.Progressln("   - " + id)
 		}
 	}
 
 	if len(resources.AuroraClusterIds) > 0 {
-		fmt.Println("  Aurora DBクラスター:")
+		common.Progressln("  Aurora DBクラスター:")
 		for _, id := range resources.AuroraClusterIds {
-			fmt.Println("   - " + id)
+			common.Progressln("   - " + id)
 		}
 	}
 
 	if len(resources.EcsServiceInfo) > 0 {
-		fmt.Println("  ECSサービス:")
+		common.Progressln("  ECSサービス:")
 		for _, info := range resources.EcsServiceInfo {
-			fmt.Printf("   - %s/%s\n", info.ClusterName, info.ServiceName)
+			common.Progressf("   - %s/%s\n", info.ClusterName, info.ServiceName)
 		}
 	}
 
@@ -161,6 +157,6 @@ func printResourcesSummary(resources StackResources) {
 		len(resources.RdsInstanceIds) == 0 &&
 		len(resources.AuroraClusterIds) == 0 &&
 		len(resources.EcsServiceInfo) == 0 {
-		fmt.Println("  操作可能なリソースは見つかりませんでした")
+		common.Progressln("  操作可能なリソースは見つかりませんでした")
 	}
 }
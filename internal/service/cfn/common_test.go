@@ -0,0 +1,115 @@
+package cfn
+
+import (
+	"reflect"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestGetStartStopResourcesFromStack(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []types.StackResource
+		want      StackResources
+		wantErr   bool
+	}{
+		{
+			name: "各リソースタイプを振り分け",
+			resources: []types.StackResource{
+				fakeaws.StackResource("AWS::EC2::Instance", "Web", "i-0123"),
+				fakeaws.StackResource("AWS::RDS::DBInstance", "Db", "db-1"),
+				fakeaws.StackResource("AWS::ECS::Service", "Svc", "arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/my-service"),
+				fakeaws.StackResource("AWS::S3::Bucket", "Bucket", "my-bucket"),
+			},
+			want: StackResources{
+				Ec2InstanceIds: []string{"i-0123"},
+				RdsInstanceIds: []string{"db-1"},
+				EcsServiceInfo: []EcsServiceInfo{{ClusterName: "my-cluster", ServiceName: "my-service"}},
+			},
+		},
+		{
+			name: "Auroraクラスターと同じスタックのDBインスタンスはクラスターのメンバーとして扱う",
+			resources: []types.StackResource{
+				fakeaws.StackResource("AWS::RDS::DBInstance", "Writer", "aurora-1-writer"),
+				fakeaws.StackResource("AWS::RDS::DBCluster", "Cluster", "aurora-1"),
+			},
+			want: StackResources{
+				AuroraClusterIds: []string{"aurora-1"},
+			},
+		},
+		{
+			name: "物理IDが空のリソースは無視",
+			resources: []types.StackResource{
+				fakeaws.StackResource("AWS::EC2::Instance", "Pending", ""),
+			},
+			want: StackResources{},
+		},
+		{
+			name:      "リソースがないスタックはエラー",
+			resources: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+				Name:      "my-stack",
+				Status:    types.StackStatusCreateComplete,
+				Resources: tt.resources,
+			})
+
+			got, err := getStartStopResourcesFromStack(t.Context(), fake, "my-stack")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getStartStopResourcesFromStack() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getStartStopResourcesFromStack() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStartStopResourcesFromNestedStacks(t *testing.T) {
+	// Auroraクラスターを持つネストしたスタックと、単体のRDSインスタンスを持つネストしたスタック
+	fake := fakeaws.NewCloudFormation(
+		&fakeaws.Stack{
+			Name:   "app",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::CloudFormation::Stack", "Aurora", "app-Aurora"),
+				fakeaws.StackResource("AWS::CloudFormation::Stack", "Legacy", "app-Legacy"),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Aurora",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::RDS::DBCluster", "Cluster", "aurora-1"),
+				fakeaws.StackResource("AWS::RDS::DBInstance", "Writer", "aurora-1-writer"),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Legacy",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::RDS::DBInstance", "Db", "legacy-db"),
+			},
+		},
+	)
+
+	got, err := getStartStopResourcesFromStack(t.Context(), fake, "app")
+	if err != nil {
+		t.Fatalf("getStartStopResourcesFromStack() error = %v", err)
+	}
+	want := StackResources{
+		RdsInstanceIds:   []string{"legacy-db"},
+		AuroraClusterIds: []string{"aurora-1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getStartStopResourcesFromStack() = %+v, want %+v", got, want)
+	}
+}
@@ -1,9 +1,13 @@
 package cfn
 
 import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -41,84 +45,214 @@ func getDriftDetectableStatuses() []types.StackStatus {
 }
 
 // DetectDrift は指定した条件に一致するスタックのドリフト検出を実行します
-func DetectDrift(cfnClient *cloudformation.Client, opts DriftOptions) error {
+// opts.Wait が true の場合は、開始したすべての検出の完了を並列で待機して結果を返します
+func DetectDrift(ctx context.Context, cfnClient API, opts DriftOptions) ([]DriftDetectionResult, error) {
 	// 対象のスタックを検索
-	stacks, err := findStacksForDrift(cfnClient, opts)
+	stacks, err := findStacksForDrift(ctx, cfnClient, opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("対象のスタックが見つかりませんでした")
-		return nil
+		return nil, common.NotFoundf("対象のスタックが見つかりませんでした")
 	}
 
 	// 検出対象のスタック一覧を表示
-	fmt.Printf("🔍 ドリフト検出を実行するスタック:\n")
+	common.Progressf("🔍 ドリフト検出を実行するスタック:\n")
 	for _, stack := range stacks {
-		fmt.Printf("  - %s\n", aws.ToString(stack.StackName))
+		common.Progressf("  - %s\n", aws.ToString(stack.StackName))
 	}
-	fmt.Printf("\n合計 %d 個のスタックでドリフト検出を実行します\n", len(stacks))
+	common.Progressf("\n合計 %d 個のスタックでドリフト検出を実行します\n", len(stacks))
 
 	// ドリフト検出を実行
-	fmt.Println("\nドリフト検出を開始します...")
+	common.Progressln("\nドリフト検出を開始します...")
+	var started []string
 	detectionIds := make(map[string]string) // stackName -> detectionId
+	// スタックごとの結果（開始に失敗したスタックと、待機に失敗したスタックを記録する）
+	itemResults := make([]common.ItemResult, len(stacks))
+	var startErrs []error
 
-	for _, stack := range stacks {
+	for i, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
-		fmt.Printf("スタック %s のドリフト検出を開始中...", stackName)
+		itemResults[i].Item = stackName
+		common.Progressf("スタック %s のドリフト検出を開始中...", stackName)
 
-		output, err := cfnClient.DetectStackDrift(context.Background(), &cloudformation.DetectStackDriftInput{
-			StackName: aws.String(stackName),
-		})
+		detectionId, err := startDriftDetection(ctx, cfnClient, stackName)
 		if err != nil {
-			fmt.Printf("\n❌ スタック %s のドリフト検出開始に失敗しました: %v\n", stackName, err)
+			common.Warnf("\n❌ スタック %s のドリフト検出開始に失敗しました: %v\n", stackName, err)
+			itemResults[i].Err = fmt.Errorf("ドリフト検出の開始に失敗: %w", err)
+			startErrs = append(startErrs, err)
 			continue
 		}
 
-		detectionIds[stackName] = aws.ToString(output.StackDriftDetectionId)
-		fmt.Printf(" ✅ (検出ID: %s)\n", aws.ToString(output.StackDriftDetectionId))
+		started = append(started, stackName)
+		detectionIds[stackName] = detectionId
+		common.Progressf(" ✅ (検出ID: %s)\n", detectionId)
 	}
 
-	if len(detectionIds) > 0 {
-		fmt.Printf("\n✅ %d 個のスタックでドリフト検出を開始しました\n", len(detectionIds))
-		fmt.Println("ℹ️  検出結果は 'awstk cfn drift-status' コマンドで確認できます")
+	if len(detectionIds) == 0 {
+		return nil, fmt.Errorf("すべてのスタックでドリフト検出の開始に失敗しました: %w", errors.Join(startErrs...))
+	}
+	common.Progressf("\n✅ %d 個のスタックでドリフト検出を開始しました\n", len(detectionIds))
+	if !opts.Wait {
+		common.Progressln("ℹ️  検出結果は 'awstk cfn drift-status' コマンドで確認できます")
+		return nil, driftFailures(itemResults)
 	}
 
+	common.Progressln("⏳ ドリフト検出の完了を待機しています...")
+	results := common.Run(ctx, started, func(ctx context.Context, stackName string) (DriftDetectionResult, error) {
+		return waitDriftDetection(ctx, cfnClient, stackName, detectionIds[stackName], opts.TimeoutSeconds)
+	}, &common.RunOptions{Progress: "ドリフト検出の完了を待機中"})
+
+	waitErrs := make(map[string]error, len(results))
+	detected := make([]DriftDetectionResult, 0, len(results))
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ %s: %v\n", started[i], r.Err)
+			waitErrs[started[i]] = r.Err
+			continue
+		}
+		detected = append(detected, r.Value)
+	}
+	for i := range itemResults {
+		if err, ok := waitErrs[itemResults[i].Item]; ok {
+			itemResults[i].Err = err
+		}
+	}
+	return detected, driftFailures(itemResults)
+}
+
+// driftFailures は開始または待機に失敗したスタックがあれば PartialFailureError を返す
+func driftFailures(results []common.ItemResult) error {
+	for _, r := range results {
+		if r.Err != nil {
+			return &common.PartialFailureError{Operation: "ドリフト検出", Results: results}
+		}
+	}
 	return nil
 }
 
-// ShowDriftStatus は指定した条件に一致するスタックのドリフト状態を表示します
-func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions) error {
+// DefaultDriftTimeoutSeconds はドリフト検出の完了を待機する既定のタイムアウト秒数
+const DefaultDriftTimeoutSeconds = 600
+
+// driftPollInterval はドリフト検出の状態を確認する間隔
+var driftPollInterval = 5 * time.Second
+
+// DriftDetectionResult はドリフト検出の結果
+type DriftDetectionResult struct {
+	StackName            string
+	DetectionStatus      string // DETECTION_COMPLETE / DETECTION_FAILED
+	DriftStatus          string
+	DriftedResourceCount int
+	Reason               string // 検出に失敗したリソースがある場合の理由
+}
+
+// startDriftDetection はスタックのドリフト検出を開始し、検出IDを返す
+func startDriftDetection(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	output, err := cfnClient.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(output.StackDriftDetectionId), nil
+}
+
+// waitDriftDetection はドリフト検出が完了するまで待機して結果を返す
+// 一部のリソースで検出に失敗した場合（DETECTION_FAILED）も、検出できたリソースの結果が得られるため結果として返す
+func waitDriftDetection(ctx context.Context, cfnClient API, stackName, detectionId string, timeoutSeconds int) (DriftDetectionResult, error) {
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = DefaultDriftTimeoutSeconds
+	}
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	for {
+		output, err := cfnClient.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionId),
+		})
+		if err != nil {
+			return DriftDetectionResult{}, fmt.Errorf("ドリフト検出の状態の取得に失敗しました: %w", err)
+		}
+
+		if output.DetectionStatus != types.StackDriftDetectionStatusDetectionInProgress {
+			return DriftDetectionResult{
+				StackName:            stackName,
+				DetectionStatus:      string(output.DetectionStatus),
+				DriftStatus:          string(output.StackDriftStatus),
+				DriftedResourceCount: int(aws.ToInt32(output.DriftedStackResourceCount)),
+				Reason:               aws.ToString(output.DetectionStatusReason),
+			}, nil
+		}
+
+		if time.Now().After(deadline) {
+			return DriftDetectionResult{}, common.Timeoutf("タイムアウト: %d秒経過しましたがドリフト検出が完了していません", timeoutSeconds)
+		}
+		if err := common.Sleep(ctx, driftPollInterval); err != nil {
+			return DriftDetectionResult{}, err
+		}
+	}
+}
+
+// PrintDriftDetectionResults はドリフト検出の結果を表形式で表示します
+func PrintDriftDetectionResults(results []DriftDetectionResult) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.stack_name")},
+		{Header: i18n.T("header.result")},
+		{Header: i18n.T("header.drifted_count")},
+		{Header: i18n.T("header.note")},
+	}
+	data := make([][]string, len(results))
+	drifted := 0
+	for i, r := range results {
+		note := ""
+		if r.DetectionStatus == string(types.StackDriftDetectionStatusDetectionFailed) {
+			note = "一部のリソースで検出に失敗: " + r.Reason
+		}
+		if r.DriftStatus == string(types.StackDriftStatusDrifted) {
+			drifted++
+		}
+		data[i] = []string{r.StackName, driftStatusString(types.StackDriftStatus(r.DriftStatus)), fmt.Sprint(r.DriftedResourceCount), note}
+	}
+	common.PrintTable("ドリフト検出の結果", columns, data)
+	fmt.Printf("\n📊 合計: %d スタック (ドリフトあり: %d スタック)\n", len(results), drifted)
+	if drifted > 0 {
+		fmt.Println("ℹ️  リソースごとの差分は 'awstk cfn drift-show -S <スタック名>' コマンドで確認できます")
+	}
+}
+
+// DriftStatus はスタックのドリフト状態
+type DriftStatus struct {
+	StackName          string
+	DriftStatus        types.StackDriftStatus
+	LastCheckTimestamp *time.Time
+}
+
+// GetDriftStatuses は指定した条件に一致するスタックのドリフト状態を取得します
+// opts.DriftedOnly が true の場合はドリフトしているスタックのみを返します
+func GetDriftStatuses(ctx context.Context, cfnClient API, opts DriftStatusOptions) ([]DriftStatus, error) {
 	// 対象のスタックを検索
-	stacks, err := findStacksForDrift(cfnClient, DriftOptions{
+	stacks, err := findStacksForDrift(ctx, cfnClient, DriftOptions{
 		Stacks: opts.Stacks,
 		Filter: opts.Filter,
 		All:    opts.All,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("対象のスタックが見つかりませんでした")
-		return nil
+		return nil, common.NotFoundf("対象のスタックが見つかりませんでした")
 	}
 
-	// ドリフト状態を確認
-	fmt.Println("🔍 スタックのドリフト状態を確認中...")
-	driftedCount := 0
-	notCheckedCount := 0
-
+	statuses := make([]DriftStatus, 0, len(stacks))
 	for _, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
 
 		// スタックの詳細情報を取得（ドリフト情報を含む）
-		describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+		describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 			StackName: aws.String(stackName),
 		})
 		if err != nil {
-			fmt.Printf("❌ スタック %s の情報取得に失敗しました: %v\n", stackName, err)
+			common.Warnf("❌ スタック %s の情報取得に失敗しました: %v\n", stackName, err)
 			continue
 		}
 
@@ -126,15 +260,44 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 			continue
 		}
 
-		stackInfo := describeOutput.Stacks[0]
-		driftInfo := stackInfo.DriftInformation
+		driftInfo := describeOutput.Stacks[0].DriftInformation
 		if driftInfo == nil {
 			continue
 		}
 
+		// --drifted-onlyが指定されている場合、ドリフトしていないスタックはスキップ
+		if opts.DriftedOnly && driftInfo.StackDriftStatus != types.StackDriftStatusDrifted {
+			continue
+		}
+
+		statuses = append(statuses, DriftStatus{
+			StackName:          stackName,
+			DriftStatus:        driftInfo.StackDriftStatus,
+			LastCheckTimestamp: driftInfo.LastCheckTimestamp,
+		})
+	}
+
+	return statuses, nil
+}
+
+// ShowDriftStatus は指定した条件に一致するスタックのドリフト状態を表示します
+func ShowDriftStatus(ctx context.Context, cfnClient API, opts DriftStatusOptions) error {
+	common.Progressln("🔍 スタックのドリフト状態を確認中...")
+
+	// サマリーの件数を数えるため、ドリフトしていないスタックも含めて取得する
+	driftedOnly := opts.DriftedOnly
+	opts.DriftedOnly = false
+	statuses, err := GetDriftStatuses(ctx, cfnClient, opts)
+	if err != nil {
+		return err
+	}
+
+	driftedCount := 0
+	notCheckedCount := 0
+
+	for _, status := range statuses {
 		// ドリフト状態を確認
-		driftStatus := driftInfo.StackDriftStatus
-		switch driftStatus {
+		switch status.DriftStatus {
 		case types.StackDriftStatusNotChecked:
 			notCheckedCount++
 		case types.StackDriftStatusDrifted:
@@ -142,25 +305,25 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 		}
 
 		// --drifted-onlyが指定されている場合、ドリフトしていないスタックはスキップ
-		if opts.DriftedOnly && driftStatus != types.StackDriftStatusDrifted {
+		if driftedOnly && status.DriftStatus != types.StackDriftStatusDrifted {
 			continue
 		}
 
 		// ドリフト状態を表示
-		statusStr := driftStatusString(driftStatus)
+		statusStr := driftStatusString(status.DriftStatus)
 		statusIcon := "✅"
-		switch driftStatus {
+		switch status.DriftStatus {
 		case types.StackDriftStatusDrifted:
 			statusIcon = "⚠️ "
 		case types.StackDriftStatusNotChecked:
 			statusIcon = "❓"
 		}
 
-		fmt.Printf("%s %s: %s", statusIcon, stackName, statusStr)
+		fmt.Printf("%s %s: %s", statusIcon, status.StackName, statusStr)
 
 		// 最終チェック時刻を表示
-		if driftInfo.LastCheckTimestamp != nil {
-			checkTime := aws.ToTime(driftInfo.LastCheckTimestamp)
+		if status.LastCheckTimestamp != nil {
+			checkTime := aws.ToTime(status.LastCheckTimestamp)
 			fmt.Printf(" (最終チェック: %s)", checkTime.Format("2006-01-02 15:04:05"))
 		}
 		fmt.Println()
@@ -168,7 +331,7 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 
 	// サマリーを表示
 	fmt.Printf("\n📊 サマリー:\n")
-	fmt.Printf("  - 合計: %d スタック\n", len(stacks))
+	fmt.Printf("  - 合計: %d スタック\n", len(statuses))
 	fmt.Printf("  - ドリフトあり: %d スタック\n", driftedCount)
 	fmt.Printf("  - 未確認: %d スタック\n", notCheckedCount)
 
@@ -180,7 +343,7 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 }
 
 // findStacksForDrift はドリフト検出対象のスタックを検索します
-func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]types.Stack, error) {
+func findStacksForDrift(ctx context.Context, cfnClient API, opts DriftOptions) ([]types.Stack, error) {
 	var allStacks []types.Stack
 
 	// スタック名が指定されている場合
@@ -191,11 +354,11 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 			}
 
 			// スタックの詳細情報を取得
-			describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+			describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 				StackName: aws.String(stackName),
 			})
 			if err != nil {
-				fmt.Printf("⚠️  スタック %s が見つかりません: %v\n", stackName, err)
+				common.Warnf("⚠️  スタック %s が見つかりません: %v\n", stackName, err)
 				continue
 			}
 			if len(describeOutput.Stacks) > 0 {
@@ -204,7 +367,7 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 				if isDriftDetectable(stack.StackStatus) {
 					allStacks = append(allStacks, stack)
 				} else {
-					fmt.Printf("⚠️  スタック %s はドリフト検出できない状態です (Status: %s)\n", stackName, stack.StackStatus)
+					common.Warnf("⚠️  スタック %s はドリフト検出できない状態です (Status: %s)\n", stackName, stack.StackStatus)
 				}
 			}
 		}
@@ -220,7 +383,7 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 			StackStatusFilter: getDriftDetectableStatuses(),
 		}
 
-		output, err := cfnClient.ListStacks(context.Background(), input)
+		output, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("スタック一覧の取得に失敗しました: %w", err)
 		}
@@ -229,7 +392,7 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 		for _, summary := range output.StackSummaries {
 			if opts.All || (opts.Filter != "" && strings.Contains(aws.ToString(summary.StackName), opts.Filter)) {
 				// スタックの詳細情報を取得
-				describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+				describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 					StackName: summary.StackName,
 				})
 				if err != nil {
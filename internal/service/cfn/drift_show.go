@@ -0,0 +1,145 @@
+package cfn
+
+import (
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// DriftReport はスタックのリソースごとのドリフト情報
+type DriftReport struct {
+	StackName          string
+	DriftStatus        string
+	LastCheckTimestamp *time.Time
+	DriftedCount       int
+	Resources          []ResourceDrift // 変更・削除されたリソース
+}
+
+// ResourceDrift はドリフトしたリソースと、テンプレートで期待される値との差分
+type ResourceDrift struct {
+	LogicalId    string
+	PhysicalId   string
+	ResourceType string
+	DriftStatus  string // MODIFIED / DELETED
+	Timestamp    *time.Time
+	Differences  []PropertyDiff
+}
+
+// PropertyDiff はプロパティ単位の差分
+type PropertyDiff struct {
+	Path     string // プロパティのJSONパス（例: /Tags/0/Value）
+	Type     string // ADD / REMOVE / NOT_EQUAL
+	Expected string
+	Actual   string
+}
+
+// GetResourceDrifts はスタックの変更・削除されたリソースと、そのプロパティの差分を取得します
+// opts.Detect が true の場合は、先にドリフト検出を実行して完了を待機します
+func GetResourceDrifts(ctx context.Context, cfnClient API, opts DriftShowOptions) (*DriftReport, error) {
+	if opts.Detect {
+		common.Progressf("🔍 スタック %s のドリフト検出を実行しています...\n", opts.StackName)
+		detectionId, err := startDriftDetection(ctx, cfnClient, opts.StackName)
+		if err != nil {
+			return nil, fmt.Errorf("❌ スタック %s のドリフト検出開始に失敗しました: %w", opts.StackName, err)
+		}
+		result, err := waitDriftDetection(ctx, cfnClient, opts.StackName, detectionId, opts.TimeoutSeconds)
+		if err != nil {
+			return nil, err
+		}
+		if result.DetectionStatus == string(types.StackDriftDetectionStatusDetectionFailed) {
+			common.Warnf("⚠️  一部のリソースでドリフト検出に失敗しました: %s\n", result.Reason)
+		}
+	}
+
+	stack, err := describeStack(ctx, cfnClient, opts.StackName)
+	if err != nil {
+		return nil, err
+	}
+	report := &DriftReport{StackName: opts.StackName}
+	if stack.DriftInformation != nil {
+		report.DriftStatus = string(stack.DriftInformation.StackDriftStatus)
+		report.LastCheckTimestamp = stack.DriftInformation.LastCheckTimestamp
+	}
+	if report.DriftStatus == "" || report.DriftStatus == string(types.StackDriftStatusNotChecked) {
+		common.Warnf("⚠️  スタック %s はドリフト検出が実行されていません。--detect を指定するか 'awstk cfn drift-detect' を実行してください\n", opts.StackName)
+	}
+
+	var nextToken *string
+	for {
+		output, err := cfnClient.DescribeStackResourceDrifts(ctx, &cloudformation.DescribeStackResourceDriftsInput{
+			StackName: aws.String(opts.StackName),
+			StackResourceDriftStatusFilters: []types.StackResourceDriftStatus{
+				types.StackResourceDriftStatusModified,
+				types.StackResourceDriftStatusDeleted,
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("❌ スタック %s のリソースのドリフト情報の取得に失敗しました: %w", opts.StackName, err)
+		}
+
+		for _, d := range output.StackResourceDrifts {
+			resource := ResourceDrift{
+				LogicalId:    aws.ToString(d.LogicalResourceId),
+				PhysicalId:   aws.ToString(d.PhysicalResourceId),
+				ResourceType: aws.ToString(d.ResourceType),
+				DriftStatus:  string(d.StackResourceDriftStatus),
+				Timestamp:    d.Timestamp,
+			}
+			for _, p := range d.PropertyDifferences {
+				resource.Differences = append(resource.Differences, PropertyDiff{
+					Path:     aws.ToString(p.PropertyPath),
+					Type:     string(p.DifferenceType),
+					Expected: aws.ToString(p.ExpectedValue),
+					Actual:   aws.ToString(p.ActualValue),
+				})
+			}
+			report.Resources = append(report.Resources, resource)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	report.DriftedCount = len(report.Resources)
+	return report, nil
+}
+
+// PrintDriftReport はドリフトしたリソースごとに、期待される値と実際の値の差分を表示します
+func PrintDriftReport(report *DriftReport) {
+	fmt.Printf("スタック: %s (%s)", report.StackName, driftStatusString(types.StackDriftStatus(report.DriftStatus)))
+	if report.LastCheckTimestamp != nil {
+		fmt.Printf(" (最終チェック: %s)", report.LastCheckTimestamp.Local().Format("2006-01-02 15:04:05"))
+	}
+	fmt.Println()
+
+	if len(report.Resources) == 0 {
+		fmt.Println(common.SuccessIcon + " ドリフトしたリソースはありません")
+		return
+	}
+
+	for _, r := range report.Resources {
+		fmt.Printf("\n⚠️  %s (%s) %s [%s]\n", r.LogicalId, r.ResourceType, r.PhysicalId, r.DriftStatus)
+		if r.DriftStatus == string(types.StackResourceDriftStatusDeleted) {
+			fmt.Println("    - リソースが削除されています")
+			continue
+		}
+		for _, d := range r.Differences {
+			switch types.DifferenceType(d.Type) {
+			case types.DifferenceTypeAdd:
+				fmt.Printf("    + %s: %s\n", d.Path, d.Actual)
+			case types.DifferenceTypeRemove:
+				fmt.Printf("    - %s: %s\n", d.Path, d.Expected)
+			default:
+				fmt.Printf("    ~ %s: %s → %s\n", d.Path, d.Expected, d.Actual)
+			}
+		}
+	}
+	fmt.Printf("\n📊 合計: %d リソースがドリフトしています（+ 追加 / - 削除 / ~ 変更: 期待値 → 実際の値）\n", report.DriftedCount)
+}
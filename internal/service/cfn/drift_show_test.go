@@ -0,0 +1,74 @@
+package cfn
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestGetResourceDrifts(t *testing.T) {
+	driftPollInterval = 0
+	t.Cleanup(func() { driftPollInterval = 5 * time.Second })
+	fake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+		Name:   "app",
+		Status: types.StackStatusUpdateComplete,
+		ResourceDrifts: []types.StackResourceDrift{
+			fakeaws.ResourceDrift("Bucket", "AWS::S3::Bucket", types.StackResourceDriftStatusModified,
+				types.PropertyDifference{
+					PropertyPath:   aws.String("/VersioningConfiguration/Status"),
+					DifferenceType: types.DifferenceTypeNotEqual,
+					ExpectedValue:  aws.String("Enabled"),
+					ActualValue:    aws.String("Suspended"),
+				},
+				types.PropertyDifference{
+					PropertyPath:   aws.String("/Tags/1"),
+					DifferenceType: types.DifferenceTypeAdd,
+					ActualValue:    aws.String(`{"Key":"owner","Value":"me"}`),
+				},
+			),
+			fakeaws.ResourceDrift("Queue", "AWS::SQS::Queue", types.StackResourceDriftStatusInSync),
+			fakeaws.ResourceDrift("Topic", "AWS::SNS::Topic", types.StackResourceDriftStatusDeleted),
+			fakeaws.ResourceDrift("Role", "AWS::IAM::Role", types.StackResourceDriftStatusNotChecked),
+		},
+	})
+	fake.PageSize = 1
+	fake.DriftDetectionPolls = 1
+
+	report, err := GetResourceDrifts(t.Context(), fake, DriftShowOptions{StackName: "app", Detect: true})
+	if err != nil {
+		t.Fatalf("GetResourceDrifts() error = %v", err)
+	}
+	if report.DriftStatus != string(types.StackDriftStatusDrifted) || report.DriftedCount != 2 {
+		t.Errorf("DriftStatus, DriftedCount = %s, %d, want DRIFTED, 2", report.DriftStatus, report.DriftedCount)
+	}
+
+	// 変更・削除されたリソースのみを、プロパティの差分とともに返す
+	var got []string
+	for _, r := range report.Resources {
+		got = append(got, r.LogicalId+":"+r.DriftStatus)
+		for _, d := range r.Differences {
+			got = append(got, fmt.Sprintf("  %s %s %s→%s", d.Type, d.Path, d.Expected, d.Actual))
+		}
+	}
+	want := []string{
+		"Bucket:MODIFIED",
+		"  NOT_EQUAL /VersioningConfiguration/Status Enabled→Suspended",
+		`  ADD /Tags/1 →{"Key":"owner","Value":"me"}`,
+		"Topic:DELETED",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("GetResourceDrifts() = %q, want %q", got, want)
+	}
+	if n := fake.CallCount("DetectStackDrift"); n != 1 {
+		t.Errorf("DetectStackDrift の呼び出し回数 = %d, want 1", n)
+	}
+	if n := fake.CallCount("DescribeStackResourceDrifts"); n != 2 {
+		t.Errorf("DescribeStackResourceDrifts の呼び出し回数 = %d, want 2", n)
+	}
+}
@@ -0,0 +1,144 @@
+package cfn
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestGetDriftStatuses(t *testing.T) {
+	newFake := func() *fakeaws.CloudFormation {
+		return fakeaws.NewCloudFormation(
+			&fakeaws.Stack{Name: "dev-api", Status: types.StackStatusCreateComplete, DriftStatus: types.StackDriftStatusDrifted},
+			&fakeaws.Stack{Name: "dev-web", Status: types.StackStatusUpdateComplete, DriftStatus: types.StackDriftStatusInSync},
+			&fakeaws.Stack{Name: "prod-api", Status: types.StackStatusCreateComplete},
+			&fakeaws.Stack{Name: "dev-failed", Status: types.StackStatusRollbackComplete},
+		)
+	}
+
+	tests := []struct {
+		name    string
+		opts    DriftStatusOptions
+		want    []string
+		wantErr bool
+	}{
+		{name: "フィルターに一致するスタック", opts: DriftStatusOptions{Filter: "dev-"}, want: []string{"dev-api", "dev-web"}},
+		{name: "ドリフトしているスタックのみ", opts: DriftStatusOptions{All: true, DriftedOnly: true}, want: []string{"dev-api"}},
+		{name: "スタック名を指定", opts: DriftStatusOptions{Stacks: []string{"prod-api", "missing"}}, want: []string{"prod-api"}},
+		{name: "対象がなければNotFound", opts: DriftStatusOptions{Filter: "stg-"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses, err := GetDriftStatuses(t.Context(), newFake(), tt.opts)
+			if tt.wantErr {
+				var notFound *common.NotFoundError
+				if !errors.As(err, &notFound) {
+					t.Fatalf("GetDriftStatuses() error = %v, want NotFoundError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetDriftStatuses() error = %v", err)
+			}
+			var got []string
+			for _, s := range statuses {
+				got = append(got, s.StackName)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetDriftStatuses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDriftWait(t *testing.T) {
+	driftPollInterval = 0
+	t.Cleanup(func() { driftPollInterval = 5 * time.Second })
+	newFake := func() *fakeaws.CloudFormation {
+		fake := fakeaws.NewCloudFormation(
+			&fakeaws.Stack{
+				Name:   "dev-api",
+				Status: types.StackStatusCreateComplete,
+				ResourceDrifts: []types.StackResourceDrift{
+					fakeaws.ResourceDrift("Bucket", "AWS::S3::Bucket", types.StackResourceDriftStatusModified),
+					fakeaws.ResourceDrift("Queue", "AWS::SQS::Queue", types.StackResourceDriftStatusInSync),
+				},
+			},
+			&fakeaws.Stack{Name: "dev-web", Status: types.StackStatusUpdateComplete},
+		)
+		// 2回目までは検出中を返す
+		fake.DriftDetectionPolls = 2
+		return fake
+	}
+
+	fake := newFake()
+	results, err := DetectDrift(t.Context(), fake, DriftOptions{Filter: "dev-", Wait: true})
+	if err != nil {
+		t.Fatalf("DetectDrift() error = %v", err)
+	}
+	var got []string
+	for _, r := range results {
+		got = append(got, fmt.Sprintf("%s:%s:%d", r.StackName, r.DriftStatus, r.DriftedResourceCount))
+	}
+	want := []string{"dev-api:DRIFTED:1", "dev-web:IN_SYNC:0"}
+	if !slices.Equal(got, want) {
+		t.Errorf("DetectDrift() = %v, want %v", got, want)
+	}
+	if n := fake.CallCount("DescribeStackDriftDetectionStatus"); n != 6 {
+		t.Errorf("DescribeStackDriftDetectionStatus の呼び出し回数 = %d, want 6", n)
+	}
+
+	// 待機に失敗したスタックがあっても、完了したスタックの結果は返す
+	fake = newFake()
+	fake.Fail("DescribeStackDriftDetectionStatus", "drift-dev-web", errors.New("throttled"))
+	results, err = DetectDrift(t.Context(), fake, DriftOptions{Filter: "dev-", Wait: true})
+	var partial *common.PartialFailureError
+	if !errors.As(err, &partial) {
+		t.Fatalf("DetectDrift() error = %v, want PartialFailureError", err)
+	}
+	if len(results) != 1 || results[0].StackName != "dev-api" {
+		t.Errorf("DetectDrift() = %+v, want dev-api のみ", results)
+	}
+
+	// --wait を指定しない場合は検出を開始するだけ
+	fake = newFake()
+	results, err = DetectDrift(t.Context(), fake, DriftOptions{Filter: "dev-"})
+	if err != nil || results != nil {
+		t.Errorf("DetectDrift(Wait: false) = %v, %v, want nil, nil", results, err)
+	}
+	if n := fake.CallCount("DescribeStackDriftDetectionStatus"); n != 0 {
+		t.Errorf("DescribeStackDriftDetectionStatus の呼び出し回数 = %d, want 0", n)
+	}
+
+	// 検出の開始に失敗したスタックは失敗として返す
+	for _, wait := range []bool{false, true} {
+		fake = newFake()
+		fake.Fail("DetectStackDrift", "dev-web", errors.New("throttled"))
+		results, err = DetectDrift(t.Context(), fake, DriftOptions{Filter: "dev-", Wait: wait})
+		if !errors.As(err, &partial) {
+			t.Fatalf("DetectDrift(Wait: %v) error = %v, want PartialFailureError", wait, err)
+		}
+		if failed := partial.Failed(); len(failed) != 1 || failed[0].Item != "dev-web" {
+			t.Errorf("DetectDrift(Wait: %v) の失敗 = %+v, want dev-web のみ", wait, failed)
+		}
+		if wait && (len(results) != 1 || results[0].StackName != "dev-api") {
+			t.Errorf("DetectDrift(Wait: true) = %+v, want dev-api のみ", results)
+		}
+	}
+
+	// すべてのスタックで開始に失敗した場合はエラーを返す
+	fake = newFake()
+	fake.Fail("DetectStackDrift", "dev-api", errors.New("throttled"))
+	fake.Fail("DetectStackDrift", "dev-web", errors.New("throttled"))
+	if _, err := DetectDrift(t.Context(), fake, DriftOptions{Filter: "dev-", Wait: true}); err == nil || errors.As(err, &partial) {
+		t.Errorf("DetectDrift() error = %v, want 開始失敗のエラー", err)
+	}
+}
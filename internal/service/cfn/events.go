@@ -0,0 +1,309 @@
+package cfn
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// nestedStackType はネストしたスタックのリソースタイプ
+const nestedStackType = "AWS::CloudFormation::Stack"
+
+// eventPollInterval は --follow で新しいイベントを取得する間隔
+var eventPollInterval = 5 * time.Second
+
+// StackEvent はスタックイベント（ネストしたスタックのイベントを含む）
+type StackEvent struct {
+	Timestamp    time.Time
+	Stack        string
+	LogicalId    string
+	PhysicalId   string
+	ResourceType string
+	Status       string
+	Reason       string
+
+	eventId    string
+	stackLevel bool // スタック自身のイベントかどうか
+}
+
+// isFailed はリソースの操作に失敗したイベントかを判定する
+func (e StackEvent) isFailed() bool {
+	return strings.HasSuffix(e.Status, "_FAILED")
+}
+
+// startsOperation はスタックの作成・更新・削除の開始を表すイベントかを判定する
+// ロールバックやクリーンアップの開始は操作の一部として扱う
+func (e StackEvent) startsOperation() bool {
+	if !e.stackLevel {
+		return false
+	}
+	switch types.ResourceStatus(e.Status) {
+	case types.ResourceStatusCreateInProgress, types.ResourceStatusUpdateInProgress,
+		types.ResourceStatusDeleteInProgress, types.ResourceStatusImportInProgress:
+		return true
+	}
+	return false
+}
+
+// GetStackEvents はスタックとネストしたスタックのイベントを古い順に取得します
+// since がゼロ値でない場合は、その時刻以降のイベントのみを返します
+func GetStackEvents(ctx context.Context, cfnClient API, stackName string, since time.Time) ([]StackEvent, error) {
+	stacks := []string{stackName}
+	return collectStackEvents(ctx, cfnClient, &stacks, since)
+}
+
+// collectStackEvents は stacks の各スタックのイベントをページングして取得し、古い順に並べて返す
+// 見つかったネストしたスタックは stacks に追加してたどる（--follow では次回以降の取得でも引き続き対象にする）
+// ネストしたスタックはスタックIDで参照するため、削除済みのスタックのイベントも取得できる
+func collectStackEvents(ctx context.Context, cfnClient API, stacks *[]string, since time.Time) ([]StackEvent, error) {
+	var events []StackEvent
+	for i := 0; i < len(*stacks); i++ {
+		stackName := (*stacks)[i]
+
+		var nextToken *string
+		for {
+			output, err := cfnClient.DescribeStackEvents(ctx, &cloudformation.DescribeStackEventsInput{
+				StackName: aws.String(stackName),
+				NextToken: nextToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("スタック %s のイベント取得に失敗しました: %w", stackName, err)
+			}
+
+			// イベントは新しい順に返されるため、since より古いイベントが出たら以降のページは取得しない
+			reachedSince := false
+			for _, e := range output.StackEvents {
+				timestamp := aws.ToTime(e.Timestamp)
+				if !since.IsZero() && timestamp.Before(since) {
+					reachedSince = true
+					break
+				}
+				event := StackEvent{
+					Timestamp:    timestamp,
+					Stack:        aws.ToString(e.StackName),
+					LogicalId:    aws.ToString(e.LogicalResourceId),
+					PhysicalId:   aws.ToString(e.PhysicalResourceId),
+					ResourceType: aws.ToString(e.ResourceType),
+					Status:       string(e.ResourceStatus),
+					Reason:       aws.ToString(e.ResourceStatusReason),
+					eventId:      aws.ToString(e.EventId),
+					stackLevel:   aws.ToString(e.PhysicalResourceId) == aws.ToString(e.StackId),
+				}
+				events = append(events, event)
+
+				if event.ResourceType == nestedStackType && !event.stackLevel && event.PhysicalId != "" && !slices.Contains(*stacks, event.PhysicalId) {
+					*stacks = append(*stacks, event.PhysicalId)
+				}
+			}
+
+			if reachedSince || output.NextToken == nil {
+				break
+			}
+			nextToken = output.NextToken
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b StackEvent) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return events, nil
+}
+
+// RootCauses はスタックごとに、最後の操作で最初に失敗したリソースのイベントを返します
+// 後続の失敗（キャンセルやロールバック）は最初の失敗の結果であることが多いため、最初の1件のみを原因とみなします
+func RootCauses(events []StackEvent) []StackEvent {
+	// events は古い順に並んでいる前提
+	operationStart := map[string]int{}
+	for i, e := range events {
+		if e.startsOperation() {
+			operationStart[e.Stack] = i
+		}
+	}
+
+	var causes []StackEvent
+	found := map[string]bool{}
+	for i, e := range events {
+		if found[e.Stack] || i < operationStart[e.Stack] || !e.isFailed() || e.stackLevel {
+			continue
+		}
+		found[e.Stack] = true
+		causes = append(causes, e)
+	}
+	return causes
+}
+
+// FollowStackEvents はスタックが処理中（*_IN_PROGRESS）の間、新しいイベントを取得して emit に渡します
+// since がゼロ値の場合は、最後の操作（作成・更新・削除）の開始以降のイベントから表示します
+// 処理が終わるとスタックの最終ステータスを返します
+func FollowStackEvents(ctx context.Context, cfnClient API, stackName string, since time.Time, emit func(StackEvent)) (types.StackStatus, error) {
+	// 削除後もイベントとステータスを取得できるよう、スタックIDで参照する
+	stack, err := describeStack(ctx, cfnClient, stackName)
+	if err != nil {
+		return "", err
+	}
+	stackId := aws.ToString(stack.StackId)
+
+	if since.IsZero() {
+		since, err = lastOperationStart(ctx, cfnClient, stackId)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	stacks := []string{stackId}
+	seen := map[string]bool{}
+	for {
+		// ステータスを先に取得し、処理が終わっていれば最後にもう一度イベントを取得してから終了する
+		stack, err = describeStack(ctx, cfnClient, stackId)
+		if err != nil {
+			return "", err
+		}
+
+		events, err := collectStackEvents(ctx, cfnClient, &stacks, since)
+		if err != nil {
+			return "", err
+		}
+		for _, e := range events {
+			if seen[e.eventId] {
+				continue
+			}
+			seen[e.eventId] = true
+			emit(e)
+			// 同じ時刻のイベントを取りこぼさないよう、最新のイベントの時刻から取得し直す
+			since = e.Timestamp
+		}
+
+		if !strings.HasSuffix(string(stack.StackStatus), "_IN_PROGRESS") || stack.StackStatus == types.StackStatusReviewInProgress {
+			return stack.StackStatus, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(eventPollInterval):
+		}
+	}
+}
+
+// lastOperationStart はスタックの最後の操作が開始された時刻を返す（見つからない場合はゼロ値）
+func lastOperationStart(ctx context.Context, cfnClient API, stackId string) (time.Time, error) {
+	var nextToken *string
+	for {
+		output, err := cfnClient.DescribeStackEvents(ctx, &cloudformation.DescribeStackEventsInput{
+			StackName: aws.String(stackId),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return time.Time{}, fmt.Errorf("スタック %s のイベント取得に失敗しました: %w", stackId, err)
+		}
+		for _, e := range output.StackEvents {
+			event := StackEvent{
+				Status:     string(e.ResourceStatus),
+				stackLevel: aws.ToString(e.PhysicalResourceId) == aws.ToString(e.StackId),
+			}
+			if event.startsOperation() {
+				return aws.ToTime(e.Timestamp), nil
+			}
+		}
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return time.Time{}, nil
+}
+
+// describeStack はスタックの詳細を取得する
+func describeStack(ctx context.Context, cfnClient API, stackName string) (types.Stack, error) {
+	output, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return types.Stack{}, fmt.Errorf("スタック %s の情報取得に失敗しました: %w", stackName, err)
+	}
+	if len(output.Stacks) == 0 {
+		return types.Stack{}, common.NotFoundf("スタック '%s' が見つかりませんでした", stackName)
+	}
+	return output.Stacks[0], nil
+}
+
+// CheckStackResult はスタックの最終ステータスが失敗・ロールバックの場合にエラーを返します
+func CheckStackResult(stackName string, status types.StackStatus) error {
+	if strings.HasSuffix(string(status), "_FAILED") || strings.Contains(string(status), "ROLLBACK") {
+		return fmt.Errorf("❌ スタック %s は %s で終了しました", stackName, status)
+	}
+	return nil
+}
+
+// EventPrinter はスタックイベントを1行ずつ表示します
+// 操作ごとに最初に失敗したリソースを原因として強調表示します
+type EventPrinter struct {
+	failed map[string]bool // 現在の操作で失敗を表示済みのスタック
+}
+
+// Print はイベントを1行で表示します
+func (p *EventPrinter) Print(e StackEvent) {
+	if p.failed == nil {
+		p.failed = map[string]bool{}
+	}
+	if e.startsOperation() {
+		p.failed[e.Stack] = false
+	}
+
+	icon := "  "
+	switch {
+	case e.isFailed():
+		icon = common.ErrorIcon
+	case strings.HasSuffix(e.Status, "_COMPLETE") && !strings.Contains(e.Status, "ROLLBACK"):
+		icon = common.SuccessIcon
+	case strings.Contains(e.Status, "ROLLBACK"):
+		icon = "↩️ "
+	}
+
+	line := fmt.Sprintf("%s %s %s  %s  %s  %s",
+		e.Timestamp.Local().Format("2006-01-02 15:04:05"), icon, e.Stack, e.LogicalId, e.ResourceType, e.Status)
+	if e.Reason != "" {
+		line += "  " + e.Reason
+	}
+	if e.isFailed() && !e.stackLevel && !p.failed[e.Stack] {
+		p.failed[e.Stack] = true
+		line += "  👈 原因の可能性があります"
+	}
+	fmt.Println(line)
+}
+
+// PrintRootCauses はスタックごとの失敗の原因となったリソースを表形式で表示します
+func PrintRootCauses(causes []StackEvent) {
+	if len(causes) == 0 {
+		fmt.Println(common.SuccessIcon + " 失敗したリソースは見つかりませんでした")
+		return
+	}
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.stack")},
+		{Header: i18n.T("header.time")},
+		{Header: i18n.T("header.resource")},
+		{Header: i18n.T("header.resource_type")},
+		{Header: i18n.T("header.status")},
+		{Header: i18n.T("header.reason")},
+	}
+	data := make([][]string, len(causes))
+	for i, e := range causes {
+		data[i] = []string{
+			e.Stack,
+			e.Timestamp.Local().Format("2006-01-02 15:04:05"),
+			e.LogicalId,
+			e.ResourceType,
+			e.Status,
+			e.Reason,
+		}
+	}
+	common.PrintTable("🔥 失敗の原因となったリソース", columns, data)
+}
@@ -0,0 +1,143 @@
+package cfn
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// newEventsFake は app スタックの作成がネストしたスタック内の Queue で失敗したイベントを持つフェイクを返す
+func newEventsFake(base time.Time) *fakeaws.CloudFormation {
+	at := func(sec int) time.Time { return base.Add(time.Duration(sec) * time.Second) }
+	failed := fakeaws.StackEvent("app-Nested", "Queue", "", "AWS::SQS::Queue", types.ResourceStatusCreateFailed, at(4))
+	failed.ResourceStatusReason = aws.String("Invalid queue name")
+
+	return fakeaws.NewCloudFormation(
+		&fakeaws.Stack{
+			Name:   "app",
+			Status: types.StackStatusRollbackComplete,
+			Events: []types.StackEvent{
+				// 前回の操作の失敗は原因に含めない
+				fakeaws.StackEvent("app", "Old", "", "AWS::S3::Bucket", types.ResourceStatusCreateFailed, at(-100)),
+				fakeaws.StackEvent("app", "app", "app", "AWS::CloudFormation::Stack", types.ResourceStatusCreateInProgress, at(0)),
+				fakeaws.StackEvent("app", "Bucket", "", "AWS::S3::Bucket", types.ResourceStatusCreateInProgress, at(1)),
+				fakeaws.StackEvent("app", "Nested", "app-Nested", "AWS::CloudFormation::Stack", types.ResourceStatusCreateInProgress, at(2)),
+				fakeaws.StackEvent("app", "Nested", "app-Nested", "AWS::CloudFormation::Stack", types.ResourceStatusCreateFailed, at(6)),
+				fakeaws.StackEvent("app", "Bucket", "", "AWS::S3::Bucket", types.ResourceStatusCreateFailed, at(7)),
+				fakeaws.StackEvent("app", "app", "app", "AWS::CloudFormation::Stack", types.ResourceStatusRollbackComplete, at(9)),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Nested",
+			Status: types.StackStatusDeleteComplete,
+			Events: []types.StackEvent{
+				fakeaws.StackEvent("app-Nested", "app-Nested", "app-Nested", "AWS::CloudFormation::Stack", types.ResourceStatusCreateInProgress, at(3)),
+				failed,
+				fakeaws.StackEvent("app-Nested", "app-Nested", "app-Nested", "AWS::CloudFormation::Stack", types.ResourceStatusCreateFailed, at(5)),
+			},
+		},
+	)
+}
+
+func TestGetStackEvents(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	fake := newEventsFake(base)
+	fake.PageSize = 2
+
+	events, err := GetStackEvents(t.Context(), fake, "app", time.Time{})
+	if err != nil {
+		t.Fatalf("GetStackEvents() error = %v", err)
+	}
+	if len(events) != 10 {
+		t.Fatalf("len(events) = %d, want 10", len(events))
+	}
+	if !slices.IsSortedFunc(events, func(a, b StackEvent) int { return a.Timestamp.Compare(b.Timestamp) }) {
+		t.Error("イベントが古い順に並んでいない")
+	}
+
+	// since より前のイベントは含まず、以降のページも取得しない
+	fake = newEventsFake(base)
+	fake.PageSize = 2
+	events, err = GetStackEvents(t.Context(), fake, "app", base.Add(5*time.Second))
+	if err != nil {
+		t.Fatalf("GetStackEvents(since) error = %v", err)
+	}
+	var got []string
+	for _, e := range events {
+		got = append(got, e.Stack+"/"+e.LogicalId+":"+e.Status)
+	}
+	want := []string{"app-Nested/app-Nested:CREATE_FAILED", "app/Nested:CREATE_FAILED", "app/Bucket:CREATE_FAILED", "app/app:ROLLBACK_COMPLETE"}
+	if !slices.Equal(got, want) {
+		t.Errorf("GetStackEvents(since) = %v, want %v", got, want)
+	}
+	// app は2ページ目で since に達し、ネストしたスタックは1ページのみ取得する
+	if n := fake.CallCount("DescribeStackEvents"); n != 3 {
+		t.Errorf("DescribeStackEvents の呼び出し回数 = %d, want 3", n)
+	}
+}
+
+func TestRootCauses(t *testing.T) {
+	events, err := GetStackEvents(t.Context(), newEventsFake(time.Now()), "app", time.Time{})
+	if err != nil {
+		t.Fatalf("GetStackEvents() error = %v", err)
+	}
+
+	var got []string
+	for _, e := range RootCauses(events) {
+		got = append(got, e.Stack+"/"+e.LogicalId+":"+e.Reason)
+	}
+	want := []string{"app-Nested/Queue:Invalid queue name", "app/Nested:"}
+	if !slices.Equal(got, want) {
+		t.Errorf("RootCauses() = %v, want %v", got, want)
+	}
+}
+
+func TestFollowStackEvents(t *testing.T) {
+	eventPollInterval = 0
+	t.Cleanup(func() { eventPollInterval = 5 * time.Second })
+	base := time.Now()
+	fake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+		Name:   "app",
+		Status: types.StackStatusUpdateInProgress,
+		Events: []types.StackEvent{
+			fakeaws.StackEvent("app", "app", "app", "AWS::CloudFormation::Stack", types.ResourceStatusCreateComplete, base.Add(-time.Hour)),
+			fakeaws.StackEvent("app", "app", "app", "AWS::CloudFormation::Stack", types.ResourceStatusUpdateInProgress, base),
+		},
+	})
+
+	var got []string
+	status, err := FollowStackEvents(t.Context(), fake, "app", time.Time{}, func(e StackEvent) {
+		got = append(got, e.LogicalId+":"+e.Status)
+		// 最初のイベントを表示したあとに更新が失敗してロールバックされる
+		if len(got) == 1 {
+			stack := fake.Stack("app")
+			stack.Status = types.StackStatusUpdateRollbackComplete
+			stack.Events = append(stack.Events,
+				fakeaws.StackEvent("app", "Service", "", "AWS::ECS::Service", types.ResourceStatusUpdateFailed, base.Add(time.Second)),
+				fakeaws.StackEvent("app", "app", "app", "AWS::CloudFormation::Stack", types.ResourceStatusUpdateRollbackComplete, base.Add(2*time.Second)),
+			)
+		}
+	})
+	if err != nil {
+		t.Fatalf("FollowStackEvents() error = %v", err)
+	}
+	if status != types.StackStatusUpdateRollbackComplete {
+		t.Errorf("status = %s, want %s", status, types.StackStatusUpdateRollbackComplete)
+	}
+	// 最後の操作より前のイベントは表示せず、同じイベントを重複して表示しない
+	want := []string{"app:UPDATE_IN_PROGRESS", "Service:UPDATE_FAILED", "app:UPDATE_ROLLBACK_COMPLETE"}
+	if !slices.Equal(got, want) {
+		t.Errorf("表示されたイベント = %v, want %v", got, want)
+	}
+	if err := CheckStackResult("app", status); err == nil {
+		t.Error("CheckStackResult() error = nil, want error")
+	}
+	if err := CheckStackResult("app", types.StackStatusUpdateComplete); err != nil {
+		t.Errorf("CheckStackResult(UPDATE_COMPLETE) error = %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package cfn
 
 import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
@@ -12,7 +14,7 @@ import (
 // ListCfnStacks はCloudFormationスタック一覧を返す
 // showAll が true の場合は全てのステータスのスタックを取得する
 // showAll が false の場合はアクティブなスタックのみを取得する
-func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, error) {
+func ListCfnStacks(ctx context.Context, cfnClient API, showAll bool) ([]Stack, error) {
 	activeStatuses := []types.StackStatus{
 		types.StackStatusCreateComplete,
 		types.StackStatusUpdateComplete,
@@ -30,7 +32,7 @@ func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, err
 			input.StackStatusFilter = activeStatuses
 		}
 
-		resp, err := cfnClient.ListStacks(context.Background(), input)
+		resp, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("スタック一覧取得エラー: %w", err)
 		}
@@ -50,3 +52,35 @@ func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, err
 
 	return stacks, nil
 }
+
+// ListCfnStacksInRegions は複数リージョンのCloudFormationスタックを並列取得し、リージョン列付きで表示する
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListCfnStacksInRegions(ctx context.Context, regions []string, clientFor func(region string) API, showAll bool) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Stack, error) {
+		return ListCfnStacks(ctx, clientFor(region), showAll)
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.cfn_stack")),
+		stacksToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.cfn_stack")),
+		},
+	)
+}
+
+// stacksToTableData はスタック情報をテーブルデータに変換する
+func stacksToTableData(stacks []Stack) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.stack_name")},
+		{Header: i18n.T("header.status")},
+	}
+
+	data := make([][]string, len(stacks))
+	for i, stk := range stacks {
+		data[i] = []string{stk.Name, stk.Status}
+	}
+	return columns, data
+}
@@ -0,0 +1,178 @@
+package cfn
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/smithy-go"
+)
+
+// StackOutput はスタックの出力値
+type StackOutput struct {
+	Key         string
+	Value       string
+	Description string
+	ExportName  string
+}
+
+// StackExport はエクスポートされた値と、それをインポートしているスタック
+type StackExport struct {
+	Name            string
+	Value           string
+	ExportingStack  string
+	ImportingStacks []string
+}
+
+// GetStackOutputs はスタックの出力値を取得します
+func GetStackOutputs(ctx context.Context, cfnClient API, stackName string) ([]StackOutput, error) {
+	stack, err := describeStack(ctx, cfnClient, stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]StackOutput, len(stack.Outputs))
+	for i, o := range stack.Outputs {
+		outputs[i] = StackOutput{
+			Key:         aws.ToString(o.OutputKey),
+			Value:       aws.ToString(o.OutputValue),
+			Description: aws.ToString(o.Description),
+			ExportName:  aws.ToString(o.ExportName),
+		}
+	}
+	return outputs, nil
+}
+
+// ListExports はエクスポートされた値の一覧と、それぞれをインポートしているスタックを取得します
+// filter を指定した場合はエクスポート名に部分一致するものに絞り込みます
+func ListExports(ctx context.Context, cfnClient API, filter string) ([]StackExport, error) {
+	var exports []StackExport
+	var nextToken *string
+	for {
+		output, err := cfnClient.ListExports(ctx, &cloudformation.ListExportsInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("エクスポート一覧の取得に失敗しました: %w", err)
+		}
+
+		for _, e := range output.Exports {
+			name := aws.ToString(e.Name)
+			if filter != "" && !strings.Contains(name, filter) {
+				continue
+			}
+			exports = append(exports, StackExport{
+				Name:           name,
+				Value:          aws.ToString(e.Value),
+				ExportingStack: stackNameFromId(aws.ToString(e.ExportingStackId)),
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	// インポートしているスタックはエクスポートごとに取得する必要があるため並列で取得する
+	names := make([]string, len(exports))
+	for i, e := range exports {
+		names[i] = e.Name
+	}
+	results := common.Run(ctx, names, func(ctx context.Context, name string) ([]string, error) {
+		return listImports(ctx, cfnClient, name)
+	}, &common.RunOptions{Progress: "インポートしているスタックを取得中"})
+	if err := common.CollectFailures("インポートしているスタックの取得", names, results); err != nil {
+		return nil, err
+	}
+	for i, r := range results {
+		exports[i].ImportingStacks = r.Value
+	}
+	return exports, nil
+}
+
+// listImports はエクスポートをインポートしているスタック名の一覧を取得する
+func listImports(ctx context.Context, cfnClient API, exportName string) ([]string, error) {
+	var stacks []string
+	var nextToken *string
+	for {
+		output, err := cfnClient.ListImports(ctx, &cloudformation.ListImportsInput{
+			ExportName: aws.String(exportName),
+			NextToken:  nextToken,
+		})
+		if err != nil {
+			// どのスタックからもインポートされていない場合、APIはエラーを返す
+			var apiErr smithy.APIError
+			if errors.As(err, &apiErr) && strings.Contains(apiErr.ErrorMessage(), "is not imported by any stack") {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("エクスポート %s のインポート元の取得に失敗しました: %w", exportName, err)
+		}
+		stacks = append(stacks, output.Imports...)
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return stacks, nil
+}
+
+// stackNameFromId はスタックID（ARN）からスタック名を取り出す
+// ARNでない場合はそのまま返す
+func stackNameFromId(stackId string) string {
+	parsed, err := arn.Parse(stackId)
+	if err != nil {
+		return stackId
+	}
+	// リソース部分は stack/<スタック名>/<UUID> の形式
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) < 2 {
+		return stackId
+	}
+	return parts[1]
+}
+
+// PrintStackOutputs はスタックの出力値を表形式で表示します
+func PrintStackOutputs(stackName string, outputs []StackOutput) {
+	if len(outputs) == 0 {
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.stack_output")))
+		return
+	}
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.key")},
+		{Header: i18n.T("header.value")},
+		{Header: i18n.T("header.export_name")},
+		{Header: i18n.T("header.description")},
+	}
+	data := make([][]string, len(outputs))
+	for i, o := range outputs {
+		data[i] = []string{o.Key, o.Value, o.ExportName, o.Description}
+	}
+	common.PrintTable(fmt.Sprintf("スタック %s の出力値", stackName), columns, data)
+}
+
+// PrintStackExports はエクスポートされた値を表形式で表示します
+func PrintStackExports(exports []StackExport) {
+	if len(exports) == 0 {
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.export")))
+		return
+	}
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.export_name")},
+		{Header: i18n.T("header.value")},
+		{Header: i18n.T("header.stack")},
+		{Header: i18n.T("header.imported_by")},
+	}
+	data := make([][]string, len(exports))
+	for i, e := range exports {
+		data[i] = []string{e.Name, e.Value, e.ExportingStack, strings.Join(e.ImportingStacks, ", ")}
+	}
+	common.PrintTable(common.GenerateFilteredTitle(i18n.T("resource.export")), columns, data)
+}
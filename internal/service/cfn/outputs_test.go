@@ -0,0 +1,90 @@
+package cfn
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func newExportsFake() *fakeaws.CloudFormation {
+	return fakeaws.NewCloudFormation(
+		&fakeaws.Stack{
+			Name:   "network",
+			Status: types.StackStatusCreateComplete,
+			Outputs: []types.Output{
+				fakeaws.Output("VpcId", "vpc-123", "prod-VpcId"),
+				fakeaws.Output("SubnetIds", "subnet-1,subnet-2", "prod-SubnetIds"),
+				fakeaws.Output("Note", "not exported", ""),
+			},
+		},
+		&fakeaws.Stack{Name: "app", Status: types.StackStatusUpdateComplete, Imports: []string{"prod-VpcId"}},
+		&fakeaws.Stack{Name: "batch", Status: types.StackStatusCreateComplete, Imports: []string{"prod-VpcId"}},
+	)
+}
+
+func TestGetStackOutputs(t *testing.T) {
+	outputs, err := GetStackOutputs(t.Context(), newExportsFake(), "network")
+	if err != nil {
+		t.Fatalf("GetStackOutputs() error = %v", err)
+	}
+	if len(outputs) != 3 {
+		t.Fatalf("len(outputs) = %d, want 3", len(outputs))
+	}
+	if got := outputs[0]; got.Key != "VpcId" || got.Value != "vpc-123" || got.ExportName != "prod-VpcId" {
+		t.Errorf("outputs[0] = %+v", got)
+	}
+
+	if _, err := GetStackOutputs(t.Context(), newExportsFake(), "missing"); err == nil {
+		t.Error("GetStackOutputs(missing) error = nil, want error")
+	}
+}
+
+func TestListExports(t *testing.T) {
+	fake := newExportsFake()
+	fake.PageSize = 1
+
+	exports, err := ListExports(t.Context(), fake, "")
+	if err != nil {
+		t.Fatalf("ListExports() error = %v", err)
+	}
+	if len(exports) != 2 {
+		t.Fatalf("len(exports) = %d, want 2", len(exports))
+	}
+	// インポートしているスタックがない場合のエラーは空の一覧として扱う
+	if got := exports[0]; got.Name != "prod-VpcId" || got.ExportingStack != "network" || !slices.Equal(got.ImportingStacks, []string{"app", "batch"}) {
+		t.Errorf("exports[0] = %+v", got)
+	}
+	if got := exports[1]; got.Name != "prod-SubnetIds" || len(got.ImportingStacks) != 0 {
+		t.Errorf("exports[1] = %+v", got)
+	}
+
+	exports, err = ListExports(t.Context(), newExportsFake(), "Subnet")
+	if err != nil {
+		t.Fatalf("ListExports(filter) error = %v", err)
+	}
+	if len(exports) != 1 || exports[0].Name != "prod-SubnetIds" {
+		t.Errorf("ListExports(filter) = %+v", exports)
+	}
+
+	fake = newExportsFake()
+	fake.Fail("ListImports", "prod-VpcId", errors.New("throttled"))
+	if _, err := ListExports(t.Context(), fake, ""); err == nil {
+		t.Error("ListExports() error = nil, want error")
+	}
+}
+
+func TestStackNameFromId(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:cloudformation:ap-northeast-1:123456789012:stack/network/0a1b2c3d-0000-1111-2222-333344445555": "network",
+		"network": "network",
+	}
+	for id, want := range tests {
+		if got := stackNameFromId(id); got != want {
+			t.Errorf("stackNameFromId(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
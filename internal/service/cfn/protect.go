@@ -1,8 +1,8 @@
 package cfn
 
 import (
+	"awstk/internal/service/common"
 	"context"
-	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -26,30 +26,29 @@ func protectionAction(enable bool) string {
 }
 
 // UpdateProtection は指定した条件に一致するスタックの削除保護を更新します
-func UpdateProtection(cfnClient *cloudformation.Client, opts ProtectOptions) error {
+func UpdateProtection(ctx context.Context, cfnClient API, opts ProtectOptions) error {
 	// 対象のスタックを検索
-	stacks, err := findStacksForProtect(cfnClient, opts)
+	stacks, err := findStacksForProtect(ctx, cfnClient, opts)
 	if err != nil {
 		return err
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("対象のスタックが見つかりませんでした")
-		return nil
+		return common.NotFoundf("対象のスタックが見つかりませんでした")
 	}
 
 	// 変更対象のスタック一覧を表示
 	action := protectionAction(opts.Enable)
 
-	fmt.Printf("🔍 削除保護を%sするスタック:\n", action)
+	common.Progressf("🔍 削除保護を%sするスタック:\n", action)
 	for _, stack := range stacks {
 		currentStatus := protectionStatus(aws.ToBool(stack.EnableTerminationProtection))
-		fmt.Printf("  - %s (現在の削除保護: %s)\n", aws.ToString(stack.StackName), currentStatus)
+		common.Progressf("  - %s (現在の削除保護: %s)\n", aws.ToString(stack.StackName), currentStatus)
 	}
-	fmt.Printf("\n合計 %d 個のスタックの削除保護を%sします\n", len(stacks), action)
+	common.Progressf("\n合計 %d 個のスタックの削除保護を%sします\n", len(stacks), action)
 
 	// 削除保護を更新
-	fmt.Printf("\n削除保護の%sを開始します...\n", action)
+	common.Progressf("\n削除保護の%sを開始します...\n", action)
 	updateCount := 0
 	skipCount := 0
 
@@ -59,37 +58,37 @@ func UpdateProtection(cfnClient *cloudformation.Client, opts ProtectOptions) err
 
 		// 既に希望の状態になっている場合はスキップ
 		if currentProtection == opts.Enable {
-			fmt.Printf("⏭️  スタック %s は既に削除保護が%s状態です。スキップします\n",
+			common.Progressf("⏭️  スタック %s は既に削除保護が%s状態です。スキップします\n",
 				stackName,
 				protectionStatus(opts.Enable))
 			skipCount++
 			continue
 		}
 
-		fmt.Printf("スタック %s の削除保護を%s中...", stackName, action)
+		common.Progressf("スタック %s の削除保護を%s中...", stackName, action)
 
-		_, err := cfnClient.UpdateTerminationProtection(context.Background(), &cloudformation.UpdateTerminationProtectionInput{
+		_, err := cfnClient.UpdateTerminationProtection(ctx, &cloudformation.UpdateTerminationProtectionInput{
 			StackName:                   aws.String(stackName),
 			EnableTerminationProtection: aws.Bool(opts.Enable),
 		})
 		if err != nil {
-			fmt.Printf("\n❌ スタック %s の削除保護更新に失敗しました: %v\n", stackName, err)
+			common.Warnf("\n❌ スタック %s の削除保護更新に失敗しました: %v\n", stackName, err)
 			continue
 		}
-		fmt.Printf(" ✅\n")
+		common.Progressf(" ✅\n")
 		updateCount++
 	}
 
-	fmt.Printf("\n✅ %d 個のスタックの削除保護を%sしました\n", updateCount, action)
+	common.Progressf("\n✅ %d 個のスタックの削除保護を%sしました\n", updateCount, action)
 	if skipCount > 0 {
-		fmt.Printf("ℹ️  %d 個のスタックは既に希望の状態のためスキップされました\n", skipCount)
+		common.Progressf("ℹ️  %d 個のスタックは既に希望の状態のためスキップされました\n", skipCount)
 	}
 
 	return nil
 }
 
 // findStacksForProtect は削除保護変更対象のスタックを検索します
-func findStacksForProtect(cfnClient *cloudformation.Client, opts ProtectOptions) ([]types.Stack, error) {
+func findStacksForProtect(ctx context.Context, cfnClient API, opts ProtectOptions) ([]types.Stack, error) {
 	var allStacks []types.Stack
 
 	// スタック名が指定されている場合
@@ -100,11 +99,11 @@ func findStacksForProtect(cfnClient *cloudformation.Client, opts ProtectOptions)
 			}
 
 			// スタックの詳細情報を取得
-			describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+			describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 				StackName: aws.String(stackName),
 			})
 			if err != nil {
-				fmt.Printf("⚠️  スタック %s が見つかりません: %v\n", stackName, err)
+				common.Warnf("⚠️  スタック %s が見つかりません: %v\n", stackName, err)
 				continue
 			}
 			if len(describeOutput.Stacks) > 0 {
@@ -115,7 +114,7 @@ func findStacksForProtect(cfnClient *cloudformation.Client, opts ProtectOptions)
 	}
 
 	// --filterまたは--statusの場合はfindStacksForCleanupのロジックを使用
-	return findStacksForCleanup(cfnClient, CleanupOptions{
+	return findStacksForCleanup(ctx, cfnClient, CleanupOptions{
 		Filter: opts.Filter,
 		Status: opts.Status,
 	})
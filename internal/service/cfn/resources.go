@@ -1,16 +1,16 @@
 package cfn
 
 import (
+	"awstk/internal/service/common"
+	"context"
 	"errors"
 	"fmt"
 	"strings"
-
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 )
 
 // GetEc2FromStack はCloudFormationスタックからEC2インスタンスIDを取得します
-func GetEc2FromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allInstances, err := GetAllEc2FromStack(cfnClient, stackName)
+func GetEc2FromStack(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	allInstances, err := GetAllEc2FromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
@@ -24,18 +24,18 @@ func GetEc2FromStack(cfnClient *cloudformation.Client, stackName string) (string
 }
 
 // GetAllEc2FromStack はCloudFormationスタックからすべてのEC2インスタンス識別子を取得します
-func GetAllEc2FromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllEc2FromStack(ctx context.Context, cfnClient API, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
 
 	var instanceIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::EC2::Instance" && resource.PhysicalResourceId != nil {
-			instanceIds = append(instanceIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたEC2インスタンス: %s\n", *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::EC2::Instance" && resource.PhysicalId != "" {
+			instanceIds = append(instanceIds, resource.PhysicalId)
+			common.Progressf("🔍 検出されたEC2インスタンス: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -43,8 +43,8 @@ func GetAllEc2FromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 }
 
 // GetRdsFromStack はCloudFormationスタックからRDSインスタンス識別子を取得します
-func GetRdsFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allInstances, err := GetAllRdsFromStack(cfnClient, stackName)
+func GetRdsFromStack(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	allInstances, err := GetAllRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
@@ -58,18 +58,18 @@ func GetRdsFromStack(cfnClient *cloudformation.Client, stackName string) (string
 }
 
 // GetAllRdsFromStack はCloudFormationスタックからすべてのRDSインスタンス識別子を取得します
-func GetAllRdsFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllRdsFromStack(ctx context.Context, cfnClient API, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
 
 	var instanceIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::RDS::DBInstance" && resource.PhysicalResourceId != nil {
-			instanceIds = append(instanceIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたRDSインスタンス: %s\n", *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::RDS::DBInstance" && resource.PhysicalId != "" {
+			instanceIds = append(instanceIds, resource.PhysicalId)
+			common.Progressf("🔍 検出されたRDSインスタンス: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -77,8 +77,8 @@ func GetAllRdsFromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 }
 
 // GetAuroraFromStack はCloudFormationスタックからAuroraクラスター識別子を取得します
-func GetAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allClusters, err := GetAllAuroraFromStack(cfnClient, stackName)
+func GetAuroraFromStack(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	allClusters, err := GetAllAuroraFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
@@ -92,18 +92,18 @@ func GetAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (str
 }
 
 // GetAllAuroraFromStack はCloudFormationスタックからすべてのAuroraクラスター識別子を取得します
-func GetAllAuroraFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllAuroraFromStack(ctx context.Context, cfnClient API, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
 
 	var clusterIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::RDS::DBCluster" && resource.PhysicalResourceId != nil {
-			clusterIds = append(clusterIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたAuroraクラスター: %s\n", *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::RDS::DBCluster" && resource.PhysicalId != "" {
+			clusterIds = append(clusterIds, resource.PhysicalId)
+			common.Progressf("🔍 検出されたAuroraクラスター: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -111,8 +111,8 @@ func GetAllAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (
 }
 
 // GetEcsFromStack はCloudFormationスタックからECSサービス情報を取得します
-func GetEcsFromStack(cfnClient *cloudformation.Client, stackName string) (EcsServiceInfo, error) {
-	allServices, err := GetAllEcsFromStack(cfnClient, stackName)
+func GetEcsFromStack(ctx context.Context, cfnClient API, stackName string) (EcsServiceInfo, error) {
+	allServices, err := GetAllEcsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return EcsServiceInfo{}, err
 	}
@@ -126,9 +126,9 @@ func GetEcsFromStack(cfnClient *cloudformation.Client, stackName string) (EcsSer
 }
 
 // GetAllEcsFromStack はCloudFormationスタックからすべてのECSサービス識別子を取得します
-func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]EcsServiceInfo, error) {
+func GetAllEcsFromStack(ctx context.Context, cfnClient API, stackName string) ([]EcsServiceInfo, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -138,9 +138,9 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 	// クラスターリソースをフィルタリング
 	var clusterPhysicalIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::ECS::Cluster" {
-			clusterPhysicalIds = append(clusterPhysicalIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたECSクラスター: %s\n", *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::ECS::Cluster" {
+			clusterPhysicalIds = append(clusterPhysicalIds, resource.PhysicalId)
+			common.Progressf("🔍 検出されたECSクラスター: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -149,11 +149,11 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 	}
 
 	// サービスリソースをフィルタリング
-	fmt.Println("🔍 スタック '" + stackName + "' からECSサービスを検索中...")
+	common.Progressln("🔍 スタック '" + stackName + "' からECSサービスを検索中...")
 	var servicePhysicalIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::ECS::Service" {
-			servicePhysicalIds = append(servicePhysicalIds, *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::ECS::Service" {
+			servicePhysicalIds = append(servicePhysicalIds, resource.PhysicalId)
 		}
 	}
 
@@ -195,9 +195,9 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 				ClusterName: displayClusterName,
 				ServiceName: serviceName,
 			})
-			fmt.Printf("🔍 検出されたECSサービス: %s/%s\n", displayClusterName, serviceName)
+			common.Progressf("🔍 検出されたECSサービス: %s/%s\n", displayClusterName, serviceName)
 		} else {
-			fmt.Printf("⚠️ 警告: サービス %s のクラスター %s がスタック内で見つかりませんでした\n", serviceName, clusterNameFromArn)
+			common.Warnf("⚠️ 警告: サービス %s のクラスター %s がスタック内で見つかりませんでした\n", serviceName, clusterNameFromArn)
 		}
 	}
 
@@ -209,8 +209,8 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 }
 
 // GetCloudFrontFromStack はCloudFormationスタックからCloudFrontディストリビューション識別子を取得します
-func GetCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allDistributions, err := GetAllCloudFrontFromStack(cfnClient, stackName)
+func GetCloudFrontFromStack(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	allDistributions, err := GetAllCloudFrontFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
@@ -224,18 +224,18 @@ func GetCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string)
 }
 
 // GetAllCloudFrontFromStack はCloudFormationスタックからすべてのCloudFrontディストリビューション識別子を取得します
-func GetAllCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllCloudFrontFromStack(ctx context.Context, cfnClient API, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
 
 	var distributionIds []string
 	for _, resource := range stackResources {
-		if *resource.ResourceType == "AWS::CloudFront::Distribution" && resource.PhysicalResourceId != nil {
-			distributionIds = append(distributionIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたCloudFrontディストリビューション: %s\n", *resource.PhysicalResourceId)
+		if resource.ResourceType == "AWS::CloudFront::Distribution" && resource.PhysicalId != "" {
+			distributionIds = append(distributionIds, resource.PhysicalId)
+			common.Progressf("🔍 検出されたCloudFrontディストリビューション: %s\n", resource.PhysicalId)
 		}
 	}
 
@@ -0,0 +1,57 @@
+package cfn
+
+import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
+)
+
+// SelectStack はアクティブなCloudFormationスタックの一覧からスタックを選択させます
+func SelectStack(ctx context.Context, cfnClient API) (string, error) {
+	stacks, err := ListCfnStacks(ctx, cfnClient, false)
+	if err != nil {
+		return "", err
+	}
+	if len(stacks) == 0 {
+		return "", common.NotFoundf("❌ CloudFormationスタックが見つかりません")
+	}
+
+	items := make([]picker.Item, len(stacks))
+	for i, stk := range stacks {
+		items[i] = picker.Item{Label: stk.Name, Detail: stk.Status}
+	}
+	stackName, err := picker.Select(items, &picker.Options{
+		Prompt: "CloudFormationスタックを選択してください",
+		Hint:   "-S (--stack)",
+	})
+	if err != nil {
+		return "", err
+	}
+	common.Progressf("✅ 選択されたスタック: %s\n", stackName)
+	return stackName, nil
+}
+
+// SelectChangeSet はスタックのチェンジセットの一覧からチェンジセットを選択させます
+func SelectChangeSet(ctx context.Context, cfnClient API, stackName string) (string, error) {
+	summaries, err := ListChangeSets(ctx, cfnClient, stackName)
+	if err != nil {
+		return "", err
+	}
+	if len(summaries) == 0 {
+		return "", common.NotFoundf("❌ スタック %s にチェンジセットが見つかりません", stackName)
+	}
+
+	items := make([]picker.Item, len(summaries))
+	for i, s := range summaries {
+		items[i] = picker.Item{Label: s.Name, Detail: s.Status + " / " + s.ExecutionStatus}
+	}
+	name, err := picker.Select(items, &picker.Options{
+		Prompt: "チェンジセットを選択してください",
+		Hint:   "チェンジセット名",
+	})
+	if err != nil {
+		return "", err
+	}
+	common.Progressf("✅ 選択されたチェンジセット: %s\n", name)
+	return name, nil
+}
@@ -1,20 +1,20 @@
 package cfn
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StartAllStackResources はスタック内のすべてのリソースを起動します
-func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Client, rdsClient *rds.Client, aasClient *applicationautoscaling.Client, stackName string) error {
+func StartAllStackResources(ctx context.Context, cfnClient API, ec2Client Ec2API, rdsClient RdsAPI, aasClient AutoScalingAPI, stackName string) error {
 	// スタックからリソースを取得
-	resources, err := getStartStopResourcesFromStack(cfnClient, stackName)
+	resources, err := getStartStopResourcesFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return err
 	}
@@ -27,12 +27,12 @@ func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Cli
 	// EC2インスタンスを起動
 	if len(resources.Ec2InstanceIds) > 0 {
 		for _, instanceId := range resources.Ec2InstanceIds {
-			fmt.Printf("🚀 EC2インスタンス (%s) を起動します...\n", instanceId)
-			if err := startEc2Instance(ec2Client, instanceId); err != nil {
-				fmt.Printf("❌ EC2インスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
+			common.Progressf("🚀 EC2インスタンス (%s) を起動します...\n", instanceId)
+			if err := startEc2Instance(ctx, ec2Client, instanceId); err != nil {
+				common.Warnf("❌ EC2インスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
 				errorsOccurred = true
 			} else {
-				fmt.Printf("✅ EC2インスタンス (%s) の起動を開始しました\n", instanceId)
+				common.Progressf("✅ EC2インスタンス (%s) の起動を開始しました\n", instanceId)
 			}
 		}
 	}
@@ -41,23 +41,23 @@ func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Cli
 	if len(resources.RdsInstanceIds) > 0 || len(resources.AuroraClusterIds) > 0 {
 		// RDSインスタンスを起動
 		for _, instanceId := range resources.RdsInstanceIds {
-			fmt.Printf("🚀 RDSインスタンス (%s) を起動します...\n", instanceId)
-			if err := startRdsInstance(rdsClient, instanceId); err != nil {
-				fmt.Printf("❌ RDSインスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
+			common.Progressf("🚀 RDSインスタンス (%s) を起動します...\n", instanceId)
+			if err := startRdsInstance(ctx, rdsClient, instanceId); err != nil {
+				common.Warnf("❌ RDSインスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
 				errorsOccurred = true
 			} else {
-				fmt.Printf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceId)
+				common.Progressf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceId)
 			}
 		}
 
 		// Auroraクラスターを起動
 		for _, clusterId := range resources.AuroraClusterIds {
-			fmt.Printf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterId)
-			if err := startAuroraCluster(rdsClient, clusterId); err != nil {
-				fmt.Printf("❌ Aurora DBクラスター (%s) の起動中にエラーが発生しました: %v\n", clusterId, err)
+			common.Progressf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterId)
+			if err := startAuroraCluster(ctx, rdsClient, clusterId); err != nil {
+				common.Warnf("❌ Aurora DBクラスター (%s) の起動中にエラーが発生しました: %v\n", clusterId, err)
 				errorsOccurred = true
 			} else {
-				fmt.Printf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterId)
+				common.Progressf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterId)
 			}
 		}
 	}
@@ -65,7 +65,7 @@ func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Cli
 	// ECSサービスを起動
 	if len(resources.EcsServiceInfo) > 0 {
 		for _, ecsInfo := range resources.EcsServiceInfo {
-			fmt.Printf("🚀 ECSサービス (%s/%s) を起動します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
+			common.Progressf("🚀 ECSサービス (%s/%s) を起動します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
 			capacityOpts := ServiceCapacityOptions{
 				ClusterName: ecsInfo.ClusterName,
 				ServiceName: ecsInfo.ServiceName,
@@ -73,12 +73,12 @@ func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Cli
 				MaxCapacity: 2, // デフォルト値として2を使用
 			}
 
-			if err := setEcsServiceCapacity(aasClient, capacityOpts); err != nil {
-				fmt.Printf("❌ ECSサービス (%s/%s) の起動中にエラーが発生しました: %v\n",
+			if err := setEcsServiceCapacity(ctx, aasClient, capacityOpts); err != nil {
+				common.Warnf("❌ ECSサービス (%s/%s) の起動中にエラーが発生しました: %v\n",
 					ecsInfo.ClusterName, ecsInfo.ServiceName, err)
 				errorsOccurred = true
 			} else {
-				fmt.Printf("✅ ECSサービス (%s/%s) の起動を開始しました\n",
+				common.Progressf("✅ ECSサービス (%s/%s) の起動を開始しました\n",
 					ecsInfo.ClusterName, ecsInfo.ServiceName)
 			}
 		}
@@ -99,12 +99,12 @@ type ServiceCapacityOptions struct {
 }
 
 // startEc2Instance はEC2インスタンスを起動します
-func startEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func startEc2Instance(ctx context.Context, ec2Client Ec2API, instanceId string) error {
 	input := &ec2.StartInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StartInstances(context.Background(), input)
+	_, err := ec2Client.StartInstances(ctx, input)
 	if err != nil {
 		return fmt.Errorf("EC2インスタンス起動エラー: %w", err)
 	}
@@ -113,12 +113,12 @@ func startEc2Instance(ec2Client *ec2.Client, instanceId string) error {
 }
 
 // startRdsInstance はRDSインスタンスを起動します
-func startRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func startRdsInstance(ctx context.Context, rdsClient RdsAPI, instanceId string) error {
 	input := &rds.StartDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StartDBInstance(context.Background(), input)
+	_, err := rdsClient.StartDBInstance(ctx, input)
 	if err != nil {
 		return fmt.Errorf("RDSインスタンス起動エラー: %w", err)
 	}
@@ -127,12 +127,12 @@ func startRdsInstance(rdsClient *rds.Client, instanceId string) error {
 }
 
 // startAuroraCluster はAuroraクラスターを起動します
-func startAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func startAuroraCluster(ctx context.Context, rdsClient RdsAPI, clusterId string) error {
 	input := &rds.StartDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StartDBCluster(context.Background(), input)
+	_, err := rdsClient.StartDBCluster(ctx, input)
 	if err != nil {
 		return fmt.Errorf("auroraクラスター起動エラー: %w", err)
 	}
@@ -141,12 +141,12 @@ func startAuroraCluster(rdsClient *rds.Client, clusterId string) error {
 }
 
 // setEcsServiceCapacity はECSサービスのキャパシティを設定します
-func setEcsServiceCapacity(autoScalingClient *applicationautoscaling.Client, opts ServiceCapacityOptions) error {
+func setEcsServiceCapacity(ctx context.Context, autoScalingClient AutoScalingAPI, opts ServiceCapacityOptions) error {
 	// リソースIDを構築
 	resourceId := fmt.Sprintf("service/%s/%s", opts.ClusterName, opts.ServiceName)
 
 	// スケーラブルターゲットを登録
-	_, err := autoScalingClient.RegisterScalableTarget(context.Background(), &applicationautoscaling.RegisterScalableTargetInput{
+	_, err := autoScalingClient.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
 		ServiceNamespace:  "ecs",
 		ScalableDimension: "ecs:service:DesiredCount",
 		ResourceId:        &resourceId,
@@ -1,19 +1,19 @@
 package cfn
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StopAllStackResources はスタック内のすべてのリソースを停止します
-func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Client, rdsClient *rds.Client, aasClient *applicationautoscaling.Client, stackName string) error {
+func StopAllStackResources(ctx context.Context, cfnClient API, ec2Client Ec2API, rdsClient RdsAPI, aasClient AutoScalingAPI, stackName string) error {
 	// スタックからリソースを取得
-	resources, err := getStartStopResourcesFromStack(cfnClient, stackName)
+	resources, err := getStartStopResourcesFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return err
 	}
@@ -21,17 +21,79 @@ func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Clie
 	// 検出されたリソースのサマリーを表示
 	printResourcesSummary(resources)
 
-	errorsOccurred := false
+	return StopResources(ctx, ec2Client, rdsClient, aasClient, resources)
+}
+
+// StopActions はスタック内リソース停止の実行計画アクションを生成します
+func StopActions(ctx context.Context, cfnClient API, stackName string) ([]common.Action, error) {
+	resources, err := getStartStopResourcesFromStack(ctx, cfnClient, stackName)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []common.Action
+	add := func(resourceType, id, detail string) {
+		actions = append(actions, common.Action{
+			ResourceType: resourceType,
+			ResourceId:   id,
+			Operation:    common.OperationStop,
+			Risk:         common.RiskMedium,
+			Detail:       detail,
+		})
+	}
+	for _, id := range resources.Ec2InstanceIds {
+		add(common.ResourceEc2Instance, id, "Stack: "+stackName)
+	}
+	for _, id := range resources.RdsInstanceIds {
+		add(common.ResourceRdsInstance, id, "Stack: "+stackName)
+	}
+	for _, id := range resources.AuroraClusterIds {
+		add(common.ResourceAuroraCluster, id, "Stack: "+stackName)
+	}
+	for _, ecsInfo := range resources.EcsServiceInfo {
+		add(common.ResourceEcsService, ecsInfo.ClusterName+"/"+ecsInfo.ServiceName, "Stack: "+stackName+", 最小・最大キャパシティを0に設定")
+	}
+	return actions, nil
+}
+
+// StackResourcesFromActions は実行計画の停止アクションをStackResourcesに変換します
+func StackResourcesFromActions(actions []common.Action) (StackResources, error) {
+	var resources StackResources
+	for _, action := range actions {
+		switch action.ResourceType {
+		case common.ResourceEc2Instance:
+			resources.Ec2InstanceIds = append(resources.Ec2InstanceIds, action.ResourceId)
+		case common.ResourceRdsInstance:
+			resources.RdsInstanceIds = append(resources.RdsInstanceIds, action.ResourceId)
+		case common.ResourceAuroraCluster:
+			resources.AuroraClusterIds = append(resources.AuroraClusterIds, action.ResourceId)
+		case common.ResourceEcsService:
+			clusterName, serviceName, ok := strings.Cut(action.ResourceId, "/")
+			if !ok {
+				return resources, common.InvalidInputf("ECSサービスのIDは <クラスター名>/<サービス名> の形式で指定してください: %s", action.ResourceId)
+			}
+			resources.EcsServiceInfo = append(resources.EcsServiceInfo, EcsServiceInfo{ClusterName: clusterName, ServiceName: serviceName})
+		default:
+			return resources, fmt.Errorf("停止できないリソースタイプです: %s", action.ResourceType)
+		}
+	}
+	return resources, nil
+}
+
+// StopResources は指定したリソースを停止します
+func StopResources(ctx context.Context, ec2Client Ec2API, rdsClient RdsAPI, aasClient AutoScalingAPI, resources StackResources) error {
+	var results []common.ItemResult
 
 	// EC2インスタンスを停止
 	if len(resources.Ec2InstanceIds) > 0 {
 		for _, instanceId := range resources.Ec2InstanceIds {
-			fmt.Printf("🛑 EC2インスタンス (%s) を停止します...\n", instanceId)
-			if err := stopEc2Instance(ec2Client, instanceId); err != nil {
-				fmt.Printf("❌ EC2インスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
+			common.Progressf("🛑 EC2インスタンス (%s) を停止します...\n", instanceId)
+			err := stopEc2Instance(ctx, ec2Client, instanceId)
+			results = append(results, common.ItemResult{Item: "EC2 " + instanceId, Err: err})
+			if err != nil {
+				common.Warnf("❌ EC2インスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
 			} else {
-				fmt.Printf("✅ EC2インスタンス (%s) の停止を開始しました\n", instanceId)
+				common.Progressf("✅ EC2インスタンス (%s) の停止を開始しました\n", instanceId)
 			}
 		}
 	}
@@ -40,23 +102,25 @@ func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Clie
 	if len(resources.RdsInstanceIds) > 0 || len(resources.AuroraClusterIds) > 0 {
 		// RDSインスタンスを停止
 		for _, instanceId := range resources.RdsInstanceIds {
-			fmt.Printf("🛑 RDSインスタンス (%s) を停止します...\n", instanceId)
-			if err := stopRdsInstance(rdsClient, instanceId); err != nil {
-				fmt.Printf("❌ RDSインスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
+			common.Progressf("🛑 RDSインスタンス (%s) を停止します...\n", instanceId)
+			err := stopRdsInstance(ctx, rdsClient, instanceId)
+			results = append(results, common.ItemResult{Item: "RDS " + instanceId, Err: err})
+			if err != nil {
+				common.Warnf("❌ RDSインスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
 			} else {
-				fmt.Printf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceId)
+				common.Progressf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceId)
 			}
 		}
 
 		// Auroraクラスターを停止
 		for _, clusterId := range resources.AuroraClusterIds {
-			fmt.Printf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterId)
-			if err := stopAuroraCluster(rdsClient, clusterId); err != nil {
-				fmt.Printf("❌ Aurora DBクラスター (%s) の停止中にエラーが発生しました: %v\n", clusterId, err)
-				errorsOccurred = true
+			common.Progressf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterId)
+			err := stopAuroraCluster(ctx, rdsClient, clusterId)
+			results = append(results, common.ItemResult{Item: "Aurora " + clusterId, Err: err})
+			if err != nil {
+				common.Warnf("❌ Aurora DBクラスター (%s) の停止中にエラーが発生しました: %v\n", clusterId, err)
 			} else {
-				fmt.Printf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterId)
+				common.Progressf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterId)
 			}
 		}
 	}
@@ -64,7 +128,7 @@ func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Clie
 	// ECSサービスを停止
 	if len(resources.EcsServiceInfo) > 0 {
 		for _, ecsInfo := range resources.EcsServiceInfo {
-			fmt.Printf("🛑 ECSサービス (%s/%s) を停止します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
+			common.Progressf("🛑 ECSサービス (%s/%s) を停止します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
 			capacityOpts := ServiceCapacityOptions{
 				ClusterName: ecsInfo.ClusterName,
 				ServiceName: ecsInfo.ServiceName,
@@ -72,30 +136,31 @@ func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Clie
 				MaxCapacity: 0, // 停止するために0に設定
 			}
 
-			if err := setEcsServiceCapacity(aasClient, capacityOpts); err != nil {
-				fmt.Printf("❌ ECSサービス (%s/%s) の停止中にエラーが発生しました: %v\n",
+			err := setEcsServiceCapacity(ctx, aasClient, capacityOpts)
+			results = append(results, common.ItemResult{Item: "ECS " + ecsInfo.ClusterName + "/" + ecsInfo.ServiceName, Err: err})
+			if err != nil {
+				common.Warnf("❌ ECSサービス (%s/%s) の停止中にエラーが発生しました: %v\n",
 					ecsInfo.ClusterName, ecsInfo.ServiceName, err)
-				errorsOccurred = true
 			} else {
-				fmt.Printf("✅ ECSサービス (%s/%s) の停止を開始しました\n",
+				common.Progressf("✅ ECSサービス (%s/%s) の停止を開始しました\n",
 					ecsInfo.ClusterName, ecsInfo.ServiceName)
 			}
 		}
 	}
 
-	if errorsOccurred {
-		return fmt.Errorf("一部のリソースの停止中にエラーが発生しました")
+	if partial := (&common.PartialFailureError{Operation: "リソースの停止", Results: results}); len(partial.Failed()) > 0 {
+		return partial
 	}
 	return nil
 }
 
 // stopEc2Instance はEC2インスタンスを停止します
-func stopEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func stopEc2Instance(ctx context.Context, ec2Client Ec2API, instanceId string) error {
 	input := &ec2.StopInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StopInstances(context.Background(), input)
+	_, err := ec2Client.StopInstances(ctx, input)
 	if err != nil {
 		return fmt.Errorf("EC2インスタンス停止エラー: %w", err)
 	}
@@ -104,12 +169,12 @@ func stopEc2Instance(ec2Client *ec2.Client, instanceId string) error {
 }
 
 // stopRdsInstance はRDSインスタンスを停止します
-func stopRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func stopRdsInstance(ctx context.Context, rdsClient RdsAPI, instanceId string) error {
 	input := &rds.StopDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StopDBInstance(context.Background(), input)
+	_, err := rdsClient.StopDBInstance(ctx, input)
 	if err != nil {
 		return fmt.Errorf("RDSインスタンス停止エラー: %w", err)
 	}
@@ -118,12 +183,12 @@ func stopRdsInstance(rdsClient *rds.Client, instanceId string) error {
 }
 
 // stopAuroraCluster はAuroraクラスターを停止します
-func stopAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func stopAuroraCluster(ctx context.Context, rdsClient RdsAPI, clusterId string) error {
 	input := &rds.StopDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StopDBCluster(context.Background(), input)
+	_, err := rdsClient.StopDBCluster(ctx, input)
 	if err != nil {
 		return fmt.Errorf("auroraクラスター停止エラー: %w", err)
 	}
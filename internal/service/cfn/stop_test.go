@@ -0,0 +1,80 @@
+package cfn
+
+import (
+	"errors"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var (
+	_ Ec2API         = (*fakeaws.Ec2)(nil)
+	_ RdsAPI         = (*fakeaws.Rds)(nil)
+	_ AutoScalingAPI = (*fakeaws.AutoScaling)(nil)
+)
+
+func TestStopAllStackResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		failOp    string
+		failId    string
+		wantErr   bool
+		wantEc2   ec2types.InstanceStateName
+		wantRds   string
+		wantEcsOk bool
+	}{
+		{
+			name:      "すべてのリソースを停止",
+			wantEc2:   ec2types.InstanceStateNameStopping,
+			wantRds:   "stopping",
+			wantEcsOk: true,
+		},
+		{
+			name:      "一部の停止に失敗しても残りは停止しエラーを返す",
+			failOp:    "StopInstances",
+			failId:    "i-0123",
+			wantErr:   true,
+			wantEc2:   ec2types.InstanceStateNameRunning,
+			wantRds:   "stopping",
+			wantEcsOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfnFake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+				Name:   "my-stack",
+				Status: types.StackStatusCreateComplete,
+				Resources: []types.StackResource{
+					fakeaws.StackResource("AWS::EC2::Instance", "Web", "i-0123"),
+					fakeaws.StackResource("AWS::RDS::DBInstance", "Db", "db-1"),
+					fakeaws.StackResource("AWS::ECS::Service", "Svc", "arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/my-service"),
+				},
+			})
+			ec2Fake := fakeaws.NewEc2(map[string]ec2types.InstanceStateName{"i-0123": ec2types.InstanceStateNameRunning})
+			rdsFake := fakeaws.NewRds(map[string]string{"db-1": "available"}, nil)
+			aasFake := fakeaws.NewAutoScaling()
+			if tt.failOp != "" {
+				ec2Fake.Fail(tt.failOp, tt.failId, errors.New("unauthorized"))
+			}
+
+			err := StopAllStackResources(t.Context(), cfnFake, ec2Fake, rdsFake, aasFake, "my-stack")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StopAllStackResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := ec2Fake.State("i-0123"); got != tt.wantEc2 {
+				t.Errorf("EC2 state = %s, want %s", got, tt.wantEc2)
+			}
+			if got := rdsFake.InstanceStatus("db-1"); got != tt.wantRds {
+				t.Errorf("RDS status = %s, want %s", got, tt.wantRds)
+			}
+			capacity, ok := aasFake.Target("service/my-cluster/my-service")
+			if ok != tt.wantEcsOk || capacity != (fakeaws.Capacity{}) {
+				t.Errorf("ECS capacity = %+v (registered=%v), want 0-0", capacity, ok)
+			}
+		})
+	}
+}
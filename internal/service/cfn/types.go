@@ -1,5 +1,52 @@
 package cfn
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// API はcfnパッケージが利用するCloudFormation APIのインターフェース
+type API interface {
+	ListStackResources(ctx context.Context, params *cloudformation.ListStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStackResourcesOutput, error)
+	ListStacks(ctx context.Context, params *cloudformation.ListStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStacksOutput, error)
+	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+	DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)
+	UpdateTerminationProtection(ctx context.Context, params *cloudformation.UpdateTerminationProtectionInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateTerminationProtectionOutput, error)
+	DescribeStackEvents(ctx context.Context, params *cloudformation.DescribeStackEventsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackEventsOutput, error)
+	ListExports(ctx context.Context, params *cloudformation.ListExportsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListExportsOutput, error)
+	ListImports(ctx context.Context, params *cloudformation.ListImportsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListImportsOutput, error)
+	DetectStackDrift(ctx context.Context, params *cloudformation.DetectStackDriftInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DetectStackDriftOutput, error)
+	DescribeStackDriftDetectionStatus(ctx context.Context, params *cloudformation.DescribeStackDriftDetectionStatusInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error)
+	DescribeStackResourceDrifts(ctx context.Context, params *cloudformation.DescribeStackResourceDriftsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourceDriftsOutput, error)
+	ListChangeSets(ctx context.Context, params *cloudformation.ListChangeSetsInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListChangeSetsOutput, error)
+	DescribeChangeSet(ctx context.Context, params *cloudformation.DescribeChangeSetInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeChangeSetOutput, error)
+	ExecuteChangeSet(ctx context.Context, params *cloudformation.ExecuteChangeSetInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ExecuteChangeSetOutput, error)
+	DeleteChangeSet(ctx context.Context, params *cloudformation.DeleteChangeSetInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteChangeSetOutput, error)
+}
+
+// Ec2API はスタック内リソースの起動・停止に利用するEC2 APIのインターフェース
+type Ec2API interface {
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+}
+
+// RdsAPI はスタック内リソースの起動・停止に利用するRDS APIのインターフェース
+type RdsAPI interface {
+	StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error)
+	StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error)
+}
+
+// AutoScalingAPI はECSサービスのキャパシティ設定に利用するApplication Auto Scaling APIのインターフェース
+type AutoScalingAPI interface {
+	RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+}
+
 // StackResources はCloudFormationスタック内のリソース識別子を格納する構造体
 type StackResources struct {
 	Ec2InstanceIds   []string
@@ -31,15 +78,24 @@ type ProtectOptions struct {
 
 // DriftOptions はドリフト検出コマンドのオプション
 type DriftOptions struct {
-	Stacks []string // スタック名のリスト
-	Filter string   // スタック名のフィルター（部分一致）
-	All    bool     // すべてのスタックを対象
+	Stacks         []string // スタック名のリスト
+	Filter         string   // スタック名のフィルター（部分一致）
+	All            bool     // すべてのスタックを対象
+	Wait           bool     // ドリフト検出の完了を待機する
+	TimeoutSeconds int      // 待機のタイムアウト秒数（0の場合は DefaultDriftTimeoutSeconds）
+}
+
+// DriftShowOptions はリソースのドリフト表示コマンドのオプション
+type DriftShowOptions struct {
+	StackName      string // スタック名
+	Detect         bool   // 表示する前にドリフト検出を実行して完了を待機する
+	TimeoutSeconds int    // 待機のタイムアウト秒数（0の場合は DefaultDriftTimeoutSeconds）
 }
 
 // DriftStatusOptions はドリフト状態確認コマンドのオプション
 type DriftStatusOptions struct {
-	Stacks      []string // スタック名のリスト
-	Filter      string   // スタック名のフィルター（部分一致）
-	All         bool     // すべてのスタックを対象
-	DriftedOnly bool     // ドリフトしているスタックのみ表示
+	Stacks      []string `json:"stacks,omitempty" desc:"対象のスタック名"`              // スタック名のリスト
+	Filter      string   `json:"filter,omitempty" desc:"スタック名のフィルター（部分一致）"`     // スタック名のフィルター（部分一致）
+	All         bool     `json:"all,omitempty" desc:"ドリフト検出可能なすべてのスタックを対象にする"`  // すべてのスタックを対象
+	DriftedOnly bool     `json:"driftedOnly,omitempty" desc:"ドリフトしているスタックのみ返す"` // ドリフトしているスタックのみ表示
 }
@@ -0,0 +1,162 @@
+package cfn
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// StackResource はスタック内のリソース（ネストしたスタックのリソースを含む）
+type StackResource struct {
+	Stack        string // リソースが属するスタック名
+	LogicalId    string
+	PhysicalId   string
+	ResourceType string
+	Status       string
+
+	depth int // ルートスタックからのネストの深さ（ルートスタックのリソースは0）
+}
+
+// WalkStackResources はスタックのリソースをページングしてすべて取得し、ネストしたスタックのリソースも再帰的にたどります
+// ネストしたスタックのリソースは、そのスタックを表すリソースの直後に並べます
+func WalkStackResources(ctx context.Context, cfnClient API, stackName string) ([]StackResource, error) {
+	var resources []StackResource
+	if err := walkStackResources(ctx, cfnClient, stackName, stackName, 0, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// walkStackResources は stackId のリソースを resources に追加する
+// ネストしたスタックは物理ID（スタックID）で参照し、displayName を表示用のスタック名として使う
+func walkStackResources(ctx context.Context, cfnClient API, stackId, displayName string, depth int, resources *[]StackResource) error {
+	var nextToken *string
+	for {
+		output, err := cfnClient.ListStackResources(ctx, &cloudformation.ListStackResourcesInput{
+			StackName: aws.String(stackId),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return fmt.Errorf("cloudFormationスタック %s のリソース取得に失敗: %w", displayName, err)
+		}
+
+		for _, summary := range output.StackResourceSummaries {
+			resource := StackResource{
+				Stack:        displayName,
+				LogicalId:    aws.ToString(summary.LogicalResourceId),
+				PhysicalId:   aws.ToString(summary.PhysicalResourceId),
+				ResourceType: aws.ToString(summary.ResourceType),
+				Status:       string(summary.ResourceStatus),
+				depth:        depth,
+			}
+			*resources = append(*resources, resource)
+
+			// 作成に失敗した・削除済みのネストしたスタックはたどらない
+			if resource.ResourceType == nestedStackType && resource.PhysicalId != "" && summary.ResourceStatus != types.ResourceStatusDeleteComplete {
+				if err := walkStackResources(ctx, cfnClient, resource.PhysicalId, stackNameFromId(resource.PhysicalId), depth+1, resources); err != nil {
+					return err
+				}
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return nil
+}
+
+// FilterStackResources は resourceType に一致するリソースに絞り込みます（大文字・小文字は区別しない）
+// withAncestors が true の場合は、ツリー表示できるよう一致したリソースを含むネストしたスタックのリソースも残します
+func FilterStackResources(resources []StackResource, resourceType string, withAncestors bool) []StackResource {
+	var filtered []StackResource
+	var ancestors []int // 現在のリソースを含むネストしたスタックのインデックス（深さ順）
+	included := map[int]bool{}
+	for i, r := range resources {
+		ancestors = ancestors[:min(r.depth, len(ancestors))]
+		if strings.EqualFold(r.ResourceType, resourceType) {
+			for _, a := range ancestors {
+				if withAncestors && !included[a] {
+					included[a] = true
+					filtered = append(filtered, resources[a])
+				}
+			}
+			included[i] = true
+			filtered = append(filtered, r)
+		}
+		if r.ResourceType == nestedStackType {
+			ancestors = append(ancestors, i)
+		}
+	}
+	return filtered
+}
+
+// PrintStackResources はスタックのリソースを表形式で表示します
+func PrintStackResources(stackName string, resources []StackResource) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.stack")},
+		{Header: i18n.T("header.resource")},
+		{Header: i18n.T("header.resource_type")},
+		{Header: i18n.T("header.resource_id")},
+		{Header: i18n.T("header.status")},
+	}
+	data := make([][]string, len(resources))
+	for i, r := range resources {
+		data[i] = []string{r.Stack, r.LogicalId, r.ResourceType, r.PhysicalId, r.Status}
+	}
+	common.PrintTable(fmt.Sprintf("スタック %s のリソース一覧", stackName), columns, data)
+	fmt.Printf("\n合計: %d リソース\n", len(resources))
+}
+
+// PrintStackResourceTree はスタックのリソースをネストしたスタックごとのツリー形式で表示します
+func PrintStackResourceTree(stackName string, resources []StackResource) {
+	fmt.Println(stackName)
+	// lastAtDepth[d] は深さ d の祖先がその階層の最後の要素かどうか
+	var lastAtDepth []bool
+	for i, r := range resources {
+		last := isLastSibling(resources, i)
+		lastAtDepth = append(lastAtDepth[:r.depth], last)
+
+		var prefix strings.Builder
+		for d := 0; d < r.depth; d++ {
+			if lastAtDepth[d] {
+				prefix.WriteString("    ")
+			} else {
+				prefix.WriteString("│   ")
+			}
+		}
+		if last {
+			prefix.WriteString("└── ")
+		} else {
+			prefix.WriteString("├── ")
+		}
+
+		line := fmt.Sprintf("%s%s (%s)", prefix.String(), r.LogicalId, r.ResourceType)
+		if r.PhysicalId != "" && r.ResourceType != nestedStackType {
+			line += " " + r.PhysicalId
+		}
+		fmt.Printf("%s [%s]\n", line, r.Status)
+	}
+	fmt.Printf("\n合計: %d リソース\n", len(resources))
+}
+
+// isLastSibling は resources[i] が同じスタック内の最後のリソースかどうかを判定する
+func isLastSibling(resources []StackResource, i int) bool {
+	depth := resources[i].depth
+	for _, r := range resources[i+1:] {
+		if r.depth < depth {
+			return true
+		}
+		if r.depth == depth {
+			return false
+		}
+	}
+	return true
+}
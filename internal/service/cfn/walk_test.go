@@ -0,0 +1,134 @@
+package cfn
+
+import (
+	"slices"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// newNestedFake は app スタックが network・service のネストしたスタックを持ち、service がさらに worker を持つフェイクを返す
+func newNestedFake() *fakeaws.CloudFormation {
+	fake := fakeaws.NewCloudFormation(
+		&fakeaws.Stack{
+			Name:   "app",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::S3::Bucket", "Assets", "app-assets"),
+				fakeaws.StackResource("AWS::CloudFormation::Stack", "Network", "app-Network"),
+				fakeaws.StackResource("AWS::CloudFormation::Stack", "Service", "app-Service"),
+				fakeaws.StackResource("AWS::S3::Bucket", "Logs", "app-logs"),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Network",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::EC2::Instance", "Bastion", "i-0123"),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Service",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::ECS::Cluster", "Cluster", "my-cluster"),
+				fakeaws.StackResource("AWS::CloudFormation::Stack", "Worker", "app-Service-Worker"),
+			},
+		},
+		&fakeaws.Stack{
+			Name:   "app-Service-Worker",
+			Status: types.StackStatusCreateComplete,
+			Resources: []types.StackResource{
+				fakeaws.StackResource("AWS::ECS::Service", "Svc", "arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/worker"),
+				fakeaws.StackResource("AWS::S3::Bucket", "Spool", "app-spool"),
+			},
+		},
+	)
+	fake.PageSize = 1
+	return fake
+}
+
+func TestWalkStackResources(t *testing.T) {
+	resources, err := WalkStackResources(t.Context(), newNestedFake(), "app")
+	if err != nil {
+		t.Fatalf("WalkStackResources() error = %v", err)
+	}
+
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.Stack+"/"+r.LogicalId)
+	}
+	// ネストしたスタックのリソースはそのスタックを表すリソースの直後に並ぶ
+	want := []string{
+		"app/Assets",
+		"app/Network",
+		"app-Network/Bastion",
+		"app/Service",
+		"app-Service/Cluster",
+		"app-Service/Worker",
+		"app-Service-Worker/Svc",
+		"app-Service-Worker/Spool",
+		"app/Logs",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("WalkStackResources() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterStackResources(t *testing.T) {
+	resources, err := WalkStackResources(t.Context(), newNestedFake(), "app")
+	if err != nil {
+		t.Fatalf("WalkStackResources() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		withAncestors bool
+		want          []string
+	}{
+		{name: "一致したリソースのみ", want: []string{"Assets", "Spool", "Logs"}},
+		{name: "ネストしたスタックも残す", withAncestors: true, want: []string{"Assets", "Service", "Worker", "Spool", "Logs"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, r := range FilterStackResources(resources, "aws::s3::bucket", tt.withAncestors) {
+				got = append(got, r.LogicalId)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FilterStackResources() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAllFromNestedStack(t *testing.T) {
+	fake := newNestedFake()
+
+	instances, err := GetAllEc2FromStack(t.Context(), fake, "app")
+	if err != nil {
+		t.Fatalf("GetAllEc2FromStack() error = %v", err)
+	}
+	if !slices.Equal(instances, []string{"i-0123"}) {
+		t.Errorf("GetAllEc2FromStack() = %v, want [i-0123]", instances)
+	}
+
+	// クラスターとサービスが別のネストしたスタックにあっても対応付ける
+	services, err := GetAllEcsFromStack(t.Context(), fake, "app")
+	if err != nil {
+		t.Fatalf("GetAllEcsFromStack() error = %v", err)
+	}
+	if len(services) != 1 || services[0] != (EcsServiceInfo{ClusterName: "my-cluster", ServiceName: "worker"}) {
+		t.Errorf("GetAllEcsFromStack() = %+v", services)
+	}
+
+	buckets, _, err := GetCleanupResourcesFromStack(t.Context(), fake, "app")
+	if err != nil {
+		t.Fatalf("GetCleanupResourcesFromStack() error = %v", err)
+	}
+	if !slices.Equal(buckets, []string{"app-assets", "app-spool", "app-logs"}) {
+		t.Errorf("GetCleanupResourcesFromStack() = %v", buckets)
+	}
+}
@@ -1,98 +1,124 @@
 package cleanup
 
 import (
+	"awstk/internal/service/apply"
 	"awstk/internal/service/cfn"
+	"awstk/internal/service/common"
 	ecrsvc "awstk/internal/service/ecr"
 	logssvc "awstk/internal/service/logs"
 	s3svc "awstk/internal/service/s3"
+	"awstk/internal/service/tagging"
+	"context"
+	"errors"
 	"fmt"
 )
 
 // CleanupResources は指定した文字列を含むAWSリソースをクリーンアップします
-func CleanupResources(clients ClientSet, opts Options) error {
+func CleanupResources(ctx context.Context, clients ClientSet, opts Options) error {
+	// 一部のサービスで一覧取得に失敗しても、取得できたリソースの削除は続行する
+	actions, listErr := CleanupActions(ctx, clients, opts)
+	var partial *common.PartialFailureError
+	if listErr != nil && !errors.As(listErr, &partial) {
+		return listErr
+	}
+	if len(actions) == 0 {
+		if listErr != nil {
+			return listErr
+		}
+		return common.NotFoundf("削除対象のリソースが見つかりませんでした")
+	}
+
+	plan := common.NewPlan("cleanup all")
+	plan.Add(actions...)
+	if err := apply.Execute(ctx, clients.applyClients(), plan); err != nil {
+		return errors.Join(listErr, err)
+	}
+	if listErr != nil {
+		return listErr
+	}
+
+	common.Progressln("🎉 クリーンアップ完了！")
+	return nil
+}
+
+// CleanupActions は指定した文字列・スタック・タグの条件に一致するリソース削除の実行計画アクションを生成します
+// キーワード検索で一部のサービスの一覧取得に失敗した場合は、取得できた分のアクションと
+// 失敗したサービスを含む PartialFailureError を返します
+func CleanupActions(ctx context.Context, clients ClientSet, opts Options) ([]common.Action, error) {
 	// 事前条件チェック
-	if err := validateCleanupOptions(clients); err != nil {
-		return err
+	if err := validateCleanupOptions(clients, opts); err != nil {
+		return nil, err
 	}
 	if err := validateOptions(opts); err != nil {
-		return err
+		return nil, err
 	}
 
 	var s3BucketNames, ecrRepoNames, logGroupNames []string
+	var listResults []common.ItemResult
 	var err error
 
 	// 検索方法によって取得ロジックを分岐
 	if opts.StackName != "" {
 		// スタック名から検索する場合
-		fmt.Printf("CloudFormationスタック: %s\n", opts.StackName)
-		fmt.Println("スタックに関連するリソースの削除を開始します...")
+		common.Progressf("CloudFormationスタック: %s\n", opts.StackName)
 
-		s3BucketNames, ecrRepoNames, err = cfn.GetCleanupResourcesFromStack(clients.CfnClient, opts.StackName)
+		s3BucketNames, ecrRepoNames, err = cfn.GetCleanupResourcesFromStack(ctx, clients.CfnClient, opts.StackName)
 		if err != nil {
-			return fmt.Errorf("スタックからのリソース取得エラー: %w", err)
+			return nil, fmt.Errorf("スタックからのリソース取得エラー: %w", err)
 		}
 		// スタックからの削除では現時点でCloudWatch Logsは対象外
-		logGroupNames = []string{}
 	} else {
-		// キーワードから検索する場合
-		fmt.Printf("検索文字列: %s\n", opts.SearchString)
-		fmt.Println("検索文字列に一致するリソースの削除を開始します...")
+		// キーワードから検索する場合（タグのみ指定の場合は全件をタグで絞り込む）
+		if opts.SearchString != "" {
+			common.Progressf("検索文字列: %s\n", opts.SearchString)
+		}
 
-		s3BucketNames, err = s3svc.GetS3BucketsByFilter(clients.S3Client, opts.SearchString)
+		s3BucketNames, err = s3svc.GetS3BucketsByFilter(ctx, clients.S3Client, opts.SearchString)
+		listResults = append(listResults, common.ItemResult{Item: "S3", Err: err})
 		if err != nil {
-			fmt.Printf("❌ S3バケット一覧取得中にエラーが発生しました: %v\n", err)
-			s3BucketNames = []string{}
+			common.Warnf("❌ S3バケット一覧取得中にエラーが発生しました: %v\n", err)
 		}
 
-		ecrRepoNames, err = ecrsvc.GetEcrRepositoriesByFilter(clients.EcrClient, opts.SearchString)
+		ecrRepoNames, err = ecrsvc.GetEcrRepositoriesByFilter(ctx, clients.EcrClient, opts.SearchString)
+		listResults = append(listResults, common.ItemResult{Item: "ECR", Err: err})
 		if err != nil {
-			fmt.Printf("❌ ECRリポジトリ一覧取得中にエラーが発生しました: %v\n", err)
-			ecrRepoNames = []string{}
+			common.Warnf("❌ ECRリポジトリ一覧取得中にエラーが発生しました: %v\n", err)
 		}
 
-		logGroupNames, err = logssvc.GetLogGroupsByFilter(clients.LogsClient, opts.SearchString)
+		logGroupNames, err = logssvc.GetLogGroupsByFilter(ctx, clients.LogsClient, opts.SearchString)
+		listResults = append(listResults, common.ItemResult{Item: "CloudWatch Logs", Err: err})
 		if err != nil {
-			fmt.Printf("❌ CloudWatch Logsグループ一覧取得中にエラーが発生しました: %v\n", err)
-			logGroupNames = []string{}
+			common.Warnf("❌ CloudWatch Logsグループ一覧取得中にエラーが発生しました: %v\n", err)
 		}
 	}
 
-	// S3バケットの削除
-	fmt.Println("S3バケットの削除を開始...")
-	if len(s3BucketNames) > 0 {
-		if err := s3svc.CleanupS3Buckets(clients.S3Client, s3BucketNames); err != nil {
-			fmt.Printf("❌ S3バケットのクリーンアップ中にエラーが発生しました: %v\n", err)
+	// タグで絞り込む
+	if len(opts.Tags) > 0 {
+		common.Progressf("タグ: %s\n", opts.Tags)
+		if s3BucketNames, err = opts.Tags.Filter(ctx, clients.TagClient, tagging.ResourceS3Bucket, s3BucketNames); err != nil {
+			return nil, err
 		}
-	} else {
-		fmt.Println("  削除対象のS3バケットはありません")
-	}
-
-	// ECRリポジトリの削除
-	fmt.Println("ECRリポジトリの削除を開始...")
-	if len(ecrRepoNames) > 0 {
-		if err := ecrsvc.CleanupEcrRepositories(clients.EcrClient, ecrRepoNames); err != nil {
-			fmt.Printf("❌ ECRリポジトリのクリーンアップ中にエラーが発生しました: %v\n", err)
+		if ecrRepoNames, err = opts.Tags.Filter(ctx, clients.TagClient, tagging.ResourceEcrRepository, ecrRepoNames); err != nil {
+			return nil, err
 		}
-	} else {
-		fmt.Println("  削除対象のECRリポジトリはありません")
-	}
-
-	// CloudWatch Logsグループの削除
-	fmt.Println("CloudWatch Logsグループの削除を開始...")
-	if len(logGroupNames) > 0 {
-		if err := logssvc.CleanupLogGroups(clients.LogsClient, logGroupNames); err != nil {
-			fmt.Printf("❌ CloudWatch Logsグループのクリーンアップ中にエラーが発生しました: %v\n", err)
+		if logGroupNames, err = opts.Tags.Filter(ctx, clients.TagClient, tagging.ResourceLogGroup, logGroupNames); err != nil {
+			return nil, err
 		}
-	} else {
-		fmt.Println("  削除対象のCloudWatch Logsグループはありません")
 	}
 
-	fmt.Println("🎉 クリーンアップ完了！")
-	return nil
+	var actions []common.Action
+	actions = append(actions, s3svc.CleanupActions(s3BucketNames)...)
+	actions = append(actions, ecrsvc.CleanupActions(ecrRepoNames)...)
+	actions = append(actions, logssvc.CleanupActions(logGroupNames)...)
+
+	if partial := (&common.PartialFailureError{Operation: "削除対象の一覧取得", Results: listResults}); len(partial.Failed()) > 0 {
+		return actions, partial
+	}
+	return actions, nil
 }
 
 // validateCleanupOptions はクリーンアップオプションのバリデーションを行います
-func validateCleanupOptions(clients ClientSet) error {
+func validateCleanupOptions(clients ClientSet, opts Options) error {
 	if clients.S3Client == nil {
 		return fmt.Errorf("s3クライアントが指定されていません")
 	}
@@ -105,16 +131,19 @@ func validateCleanupOptions(clients ClientSet) error {
 	if clients.LogsClient == nil {
 		return fmt.Errorf("cloudWatchLogsクライアントが指定されていません")
 	}
+	if len(opts.Tags) > 0 && clients.TagClient == nil {
+		return fmt.Errorf("resource Groups Tagging APIクライアントが指定されていません")
+	}
 	return nil
 }
 
 // validateOptions はオプションの論理バリデーションを行います
 func validateOptions(opts Options) error {
 	if opts.SearchString != "" && opts.StackName != "" {
-		return fmt.Errorf("検索キーワードとスタック名は同時に指定できません。いずれか一方を指定してください")
+		return common.InvalidInputf("検索キーワードとスタック名は同時に指定できません。いずれか一方を指定してください")
 	}
-	if opts.SearchString == "" && opts.StackName == "" {
-		return fmt.Errorf("検索キーワードまたはスタック名のいずれかを指定してください")
+	if opts.SearchString == "" && opts.StackName == "" && len(opts.Tags) == 0 {
+		return common.InvalidInputf("検索キーワード・スタック名・タグのいずれかを指定してください")
 	}
 	return nil
 }
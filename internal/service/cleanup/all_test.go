@@ -0,0 +1,140 @@
+package cleanup
+
+import (
+	"errors"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestCleanupResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          Options
+		failListEcr   bool
+		wantExit      int // common.ExitCode(err) の期待値
+		wantBuckets   map[string]bool
+		wantRepos     map[string]bool
+		wantLogGroups map[string]bool
+	}{
+		{
+			name:          "キーワードに一致するリソースを削除",
+			opts:          Options{SearchString: "dev"},
+			wantBuckets:   map[string]bool{"dev-assets": false, "stack-bucket": true, "prod-assets": true},
+			wantRepos:     map[string]bool{"dev-api": false, "stack-repo": true},
+			wantLogGroups: map[string]bool{"/ecs/dev-api": false, "/ecs/prod-api": true},
+		},
+		{
+			name:          "スタックに含まれるS3/ECRのみ削除しロググループは対象外",
+			opts:          Options{StackName: "my-stack"},
+			wantBuckets:   map[string]bool{"dev-assets": true, "stack-bucket": false, "prod-assets": true},
+			wantRepos:     map[string]bool{"dev-api": true, "stack-repo": false},
+			wantLogGroups: map[string]bool{"/ecs/dev-api": true, "/ecs/prod-api": true},
+		},
+		{
+			name:          "一覧取得に失敗したサービスがあっても他は削除して部分失敗を返す",
+			opts:          Options{SearchString: "dev"},
+			failListEcr:   true,
+			wantExit:      common.ExitPartialFailure,
+			wantBuckets:   map[string]bool{"dev-assets": false},
+			wantRepos:     map[string]bool{"dev-api": true},
+			wantLogGroups: map[string]bool{"/ecs/dev-api": false},
+		},
+		{
+			name:          "タグに一致するリソースのみ削除",
+			opts:          Options{Tags: tagging.Selector{{Key: "env", Value: "prd", Operator: tagging.OperatorEquals}}},
+			wantBuckets:   map[string]bool{"dev-assets": true, "stack-bucket": true, "prod-assets": false},
+			wantRepos:     map[string]bool{"dev-api": true, "stack-repo": true},
+			wantLogGroups: map[string]bool{"/ecs/dev-api": true, "/ecs/prod-api": false},
+		},
+		{
+			name:          "キーワードとタグの両方に一致するリソースのみ削除",
+			opts:          Options{SearchString: "dev", Tags: tagging.Selector{{Key: "env", Value: "dev", Operator: tagging.OperatorEquals}}},
+			wantBuckets:   map[string]bool{"dev-assets": false, "prod-assets": true},
+			wantRepos:     map[string]bool{"dev-api": false},
+			wantLogGroups: map[string]bool{"/ecs/dev-api": true},
+		},
+		{
+			name:     "一致するリソースがなければ対象なし",
+			opts:     Options{SearchString: "staging"},
+			wantExit: common.ExitNotFound,
+		},
+		{
+			name:     "キーワードとスタック名の同時指定はエラー",
+			opts:     Options{SearchString: "dev", StackName: "my-stack"},
+			wantExit: common.ExitInvalidInput,
+		},
+		{
+			name:     "いずれも未指定はエラー",
+			opts:     Options{},
+			wantExit: common.ExitInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s3Fake := fakeaws.NewS3(
+				&fakeaws.Bucket{Name: "dev-assets", Versions: []fakeaws.ObjectVersion{{Key: "a", VersionId: "v1"}}},
+				&fakeaws.Bucket{Name: "stack-bucket"},
+				&fakeaws.Bucket{Name: "prod-assets"},
+			)
+			ecrFake := fakeaws.NewEcr(
+				&fakeaws.Repository{Name: "dev-api", ImageCount: 2},
+				&fakeaws.Repository{Name: "stack-repo"},
+			)
+			logsFake := fakeaws.NewLogs(
+				fakeaws.LogGroup("/ecs/dev-api", 0, 0),
+				fakeaws.LogGroup("/ecs/prod-api", 0, 0),
+			)
+			if tt.failListEcr {
+				ecrFake.Fail("DescribeRepositories", "", errors.New("access denied"))
+			}
+			cfnFake := fakeaws.NewCloudFormation(&fakeaws.Stack{
+				Name:   "my-stack",
+				Status: types.StackStatusCreateComplete,
+				Resources: []types.StackResource{
+					fakeaws.StackResource("AWS::S3::Bucket", "Bucket", "stack-bucket"),
+					fakeaws.StackResource("AWS::ECR::Repository", "Repo", "stack-repo"),
+				},
+			})
+
+			tagFake := fakeaws.NewTagging(
+				&fakeaws.TaggedResource{ARN: "arn:aws:s3:::dev-assets", Tags: map[string]string{"env": "dev"}},
+				&fakeaws.TaggedResource{ARN: "arn:aws:s3:::prod-assets", Tags: map[string]string{"env": "prd"}},
+				&fakeaws.TaggedResource{ARN: "arn:aws:ecr:ap-northeast-1:123456789012:repository/dev-api", Tags: map[string]string{"env": "dev"}},
+				&fakeaws.TaggedResource{ARN: "arn:aws:logs:ap-northeast-1:123456789012:log-group:/ecs/prod-api", Tags: map[string]string{"env": "prd"}},
+			)
+
+			err := CleanupResources(t.Context(), ClientSet{
+				S3Client:   s3Fake,
+				EcrClient:  ecrFake,
+				CfnClient:  cfnFake,
+				LogsClient: logsFake,
+				TagClient:  tagFake,
+			}, tt.opts)
+			if got := common.ExitCode(err); got != tt.wantExit {
+				t.Fatalf("ExitCode(CleanupResources()) = %d, want %d (error = %v)", got, tt.wantExit, err)
+			}
+
+			for name, want := range tt.wantBuckets {
+				if got := s3Fake.HasBucket(name); got != want {
+					t.Errorf("bucket %s exists = %v, want %v", name, got, want)
+				}
+			}
+			for name, want := range tt.wantRepos {
+				if got := ecrFake.HasRepository(name); got != want {
+					t.Errorf("repository %s exists = %v, want %v", name, got, want)
+				}
+			}
+			for name, want := range tt.wantLogGroups {
+				if got := logsFake.HasLogGroup(name); got != want {
+					t.Errorf("log group %s exists = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
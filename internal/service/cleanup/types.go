@@ -1,22 +1,36 @@
 package cleanup
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-	"github.com/aws/aws-sdk-go-v2/service/ecr"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"awstk/internal/service/apply"
+	"awstk/internal/service/cfn"
+	ecrsvc "awstk/internal/service/ecr"
+	logssvc "awstk/internal/service/logs"
+	s3svc "awstk/internal/service/s3"
+	"awstk/internal/service/tagging"
 )
 
 // ClientSet はクリーンアップ処理に必要なクライアントをまとめた構造体
 type ClientSet struct {
-	S3Client   *s3.Client
-	EcrClient  *ecr.Client
-	CfnClient  *cloudformation.Client
-	LogsClient *cloudwatchlogs.Client
+	S3Client   s3svc.API
+	EcrClient  ecrsvc.API
+	CfnClient  cfn.API
+	LogsClient logssvc.API
+	TagClient  tagging.API // Options.Tags を指定する場合のみ必要
+}
+
+// applyClients は計画の実行に使うクライアントセットに変換します
+func (c ClientSet) applyClients() apply.ClientSet {
+	return apply.ClientSet{
+		S3Client:   c.S3Client,
+		EcrClient:  c.EcrClient,
+		CfnClient:  c.CfnClient,
+		LogsClient: c.LogsClient,
+	}
 }
 
 // Options はクリーンアップ処理のパラメータを格納する構造体
 type Options struct {
-	SearchString string // 検索文字列
-	StackName    string // CloudFormationスタック名
+	SearchString string           // 検索文字列
+	StackName    string           // CloudFormationスタック名
+	Tags         tagging.Selector // タグの条件（検索文字列・スタック名と組み合わせた場合は両方に一致するリソースのみ）
 }
@@ -1,63 +1,77 @@
 package cloudfront
 
 import (
-	"bufio"
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
 	"context"
 	"fmt"
-	"os"
-	"strconv"
-	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 )
 
 // SelectDistribution は複数のディストリビューションから一つを選択します
-func SelectDistribution(client *cloudfront.Client, distributionIds []string) (string, error) {
-	fmt.Println("\n複数のCloudFrontディストリビューションが見つかりました。選択してください:")
-
-	// 各ディストリビューションの詳細情報を取得して表示
+func SelectDistribution(ctx context.Context, client API, distributionIds []string) (string, error) {
+	items := make([]picker.Item, len(distributionIds))
 	for i, id := range distributionIds {
-		input := &cloudfront.GetDistributionInput{
-			Id: &id,
-		}
+		items[i] = picker.Item{Label: id, Value: id}
 
-		result, err := client.GetDistribution(context.Background(), input)
+		result, err := client.GetDistribution(ctx, &cloudfront.GetDistributionInput{Id: aws.String(id)})
 		if err != nil {
 			// エラーが発生してもIDは表示
-			fmt.Printf("  %d. %s (詳細情報の取得に失敗)\n", i+1, id)
+			items[i].Detail = "(詳細情報の取得に失敗)"
 			continue
 		}
-
 		dist := result.Distribution
-		comment := ""
-		if dist.DistributionConfig.Comment != nil {
-			comment = *dist.DistributionConfig.Comment
+		items[i].Detail = distributionDetail(aws.ToString(dist.DomainName), aws.ToString(dist.DistributionConfig.Comment))
+	}
+
+	return selectDistribution(items)
+}
+
+// SelectDistributionFromAll はアカウント内の全ディストリビューションから一つを選択します
+func SelectDistributionFromAll(ctx context.Context, client API) (string, error) {
+	var items []picker.Item
+	paginator := cloudfront.NewListDistributionsPaginator(client, &cloudfront.ListDistributionsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("ディストリビューション一覧の取得に失敗: %w", err)
+		}
+		if page.DistributionList == nil {
+			continue
 		}
-		domainName := ""
-		if dist.DomainName != nil {
-			domainName = *dist.DomainName
+		for _, dist := range page.DistributionList.Items {
+			items = append(items, picker.Item{
+				Label:  aws.ToString(dist.Id),
+				Detail: distributionDetail(aws.ToString(dist.DomainName), aws.ToString(dist.Comment)),
+			})
 		}
-
-		fmt.Printf("  %d. %s - %s (%s)\n", i+1, id, domainName, comment)
+	}
+	if len(items) == 0 {
+		return "", common.NotFoundf("CloudFrontディストリビューションが見つかりませんでした")
 	}
 
-	// ユーザーの選択を待つ
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Print("\n番号を入力してください (1-" + fmt.Sprintf("%d", len(distributionIds)) + "): ")
+	return selectDistribution(items)
+}
 
-	input, err := reader.ReadString('\n')
+// selectDistribution は選択肢からディストリビューションを選択させる
+func selectDistribution(items []picker.Item) (string, error) {
+	selectedId, err := picker.Select(items, &picker.Options{
+		Prompt: "CloudFrontディストリビューションを選択してください",
+		Hint:   "ディストリビューションID または -S (--stack)",
+	})
 	if err != nil {
-		return "", fmt.Errorf("入力エラー: %w", err)
+		return "", err
 	}
+	common.Progressf("✅ ディストリビューション '%s' を選択しました\n", selectedId)
+	return selectedId, nil
+}
 
-	// 選択番号を解析
-	choice, err := strconv.Atoi(strings.TrimSpace(input))
-	if err != nil || choice < 1 || choice > len(distributionIds) {
-		return "", fmt.Errorf("無効な選択です")
+// distributionDetail は選択肢に表示するドメイン名とコメントを組み立てる
+func distributionDetail(domainName, comment string) string {
+	if comment == "" {
+		return domainName
 	}
-
-	selectedId := distributionIds[choice-1]
-	fmt.Printf("\n✅ ディストリビューション '%s' を選択しました\n", selectedId)
-
-	return selectedId, nil
+	return fmt.Sprintf("%s (%s)", domainName, comment)
 }
@@ -1,6 +1,7 @@
 package tenant
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"sync"
@@ -12,7 +13,7 @@ import (
 )
 
 // InvalidateTenant は特定テナントのキャッシュを無効化します
-func InvalidateTenant(client *cloudfront.Client, opts InvalidateOptions) error {
+func InvalidateTenant(ctx context.Context, client API, opts InvalidateOptions) error {
 	callerReference := fmt.Sprintf("awstk-tenant-%d", time.Now().Unix())
 
 	input := &cloudfront.CreateInvalidationForDistributionTenantInput{
@@ -26,23 +27,23 @@ func InvalidateTenant(client *cloudfront.Client, opts InvalidateOptions) error {
 		},
 	}
 
-	result, err := client.CreateInvalidationForDistributionTenant(context.Background(), input)
+	result, err := client.CreateInvalidationForDistributionTenant(ctx, input)
 	if err != nil {
 		return err
 	}
 
 	if opts.Wait {
 		// TODO: テナント無効化の完了待機実装
-		fmt.Printf("   無効化ID: %s (待機機能は未実装)\n", *result.Invalidation.Id)
+		common.Progressf("   無効化ID: %s (待機機能は未実装)\n", *result.Invalidation.Id)
 	}
 
 	return nil
 }
 
 // InvalidateAllTenants は全テナントのキャッシュを無効化します
-func InvalidateAllTenants(client *cloudfront.Client, opts InvalidateOptions) error {
+func InvalidateAllTenants(ctx context.Context, client API, opts InvalidateOptions) error {
 	// テナント一覧を取得
-	tenants, err := ListTenants(client, opts.DistributionId)
+	tenants, err := ListTenants(ctx, client, opts.DistributionId)
 	if err != nil {
 		return fmt.Errorf("テナント一覧の取得に失敗: %w", err)
 	}
@@ -51,8 +52,8 @@ func InvalidateAllTenants(client *cloudfront.Client, opts InvalidateOptions) err
 		return fmt.Errorf("テナントが見つかりませんでした")
 	}
 
-	fmt.Printf("   対象テナント数: %d\n", len(tenants))
-	fmt.Printf("   対象パス: %v\n", opts.Paths)
+	common.Progressf("   対象テナント数: %d\n", len(tenants))
+	common.Progressf("   対象パス: %v\n", opts.Paths)
 
 	// 並列処理で各テナントを無効化
 	var wg sync.WaitGroup
@@ -62,14 +63,14 @@ func InvalidateAllTenants(client *cloudfront.Client, opts InvalidateOptions) err
 		wg.Add(1)
 		go func(t TenantInfo) {
 			defer wg.Done()
-			fmt.Printf("   テナント %s を無効化中...\n", t.Id)
+			common.Progressf("   テナント %s を無効化中...\n", t.Id)
 			tenantOpts := InvalidateOptions{
 				DistributionId: opts.DistributionId,
 				TenantId:       t.Id,
 				Paths:          opts.Paths,
 				Wait:           false,
 			}
-			if err := InvalidateTenant(client, tenantOpts); err != nil {
+			if err := InvalidateTenant(ctx, client, tenantOpts); err != nil {
 				errChan <- fmt.Errorf("テナント %s の無効化に失敗: %w", t.Id, err)
 			}
 		}(tenant)
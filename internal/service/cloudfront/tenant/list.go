@@ -9,7 +9,7 @@ import (
 )
 
 // ListTenants はディストリビューションに関連付けられたテナント一覧を取得します
-func ListTenants(client *cloudfront.Client, distributionId string) ([]TenantInfo, error) {
+func ListTenants(ctx context.Context, client API, distributionId string) ([]TenantInfo, error) {
 	var tenants []TenantInfo
 	var nextMarker *string
 
@@ -24,7 +24,7 @@ func ListTenants(client *cloudfront.Client, distributionId string) ([]TenantInfo
 			input.Marker = nextMarker
 		}
 
-		result, err := client.ListDistributionTenants(context.Background(), input)
+		result, err := client.ListDistributionTenants(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -1,5 +1,17 @@
 package tenant
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+)
+
+// API はtenantパッケージが利用するCloudFront APIのインターフェース
+type API interface {
+	ListDistributionTenants(ctx context.Context, params *cloudfront.ListDistributionTenantsInput, optFns ...func(*cloudfront.Options)) (*cloudfront.ListDistributionTenantsOutput, error)
+	CreateInvalidationForDistributionTenant(ctx context.Context, params *cloudfront.CreateInvalidationForDistributionTenantInput, optFns ...func(*cloudfront.Options)) (*cloudfront.CreateInvalidationForDistributionTenantOutput, error)
+}
+
 // TenantInfo はテナント情報を保持する構造体
 type TenantInfo struct {
 	Id                       string
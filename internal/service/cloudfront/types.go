@@ -1,5 +1,21 @@
 package cloudfront
 
+import (
+	"awstk/internal/service/cloudfront/tenant"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+)
+
+// API はcloudfrontパッケージが利用するCloudFront APIのインターフェース
+type API interface {
+	tenant.API
+	GetDistribution(ctx context.Context, params *cloudfront.GetDistributionInput, optFns ...func(*cloudfront.Options)) (*cloudfront.GetDistributionOutput, error)
+	CreateInvalidation(ctx context.Context, params *cloudfront.CreateInvalidationInput, optFns ...func(*cloudfront.Options)) (*cloudfront.CreateInvalidationOutput, error)
+	ListDistributions(ctx context.Context, params *cloudfront.ListDistributionsInput, optFns ...func(*cloudfront.Options)) (*cloudfront.ListDistributionsOutput, error)
+	GetInvalidation(ctx context.Context, params *cloudfront.GetInvalidationInput, optFns ...func(*cloudfront.Options)) (*cloudfront.GetInvalidationOutput, error)
+}
+
 // DistributionInfo はCloudFrontディストリビューションの情報を保持する構造体
 type DistributionInfo struct {
 	Id         string
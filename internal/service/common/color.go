@@ -0,0 +1,35 @@
+package common
+
+import (
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/mattn/go-runewidth"
+	"golang.org/x/term"
+)
+
+// ansiEscapePattern は文字色などを指定するANSIエスケープシーケンス
+var ansiEscapePattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// colorEnabled は標準出力に色を付けるかどうか
+// NO_COLOR が設定されている場合や、標準出力が端末でない（パイプ・リダイレクト）場合は色を付けない
+var colorEnabled = sync.OnceValue(func() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+})
+
+// Red は文字列を赤色で表示するためのエスケープシーケンスで囲みます（色を付けない場合はそのまま返します）
+func Red(s string) string {
+	if !colorEnabled() || s == "" {
+		return s
+	}
+	return "\x1b[31m" + s + "\x1b[0m"
+}
+
+// displayWidth はエスケープシーケンスを除いた文字列の表示幅を返す
+func displayWidth(s string) int {
+	return runewidth.StringWidth(ansiEscapePattern.ReplaceAllString(s, ""))
+}
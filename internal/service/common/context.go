@@ -0,0 +1,35 @@
+package common
+
+import (
+	"awstk/internal/i18n"
+	"context"
+	"fmt"
+	"time"
+)
+
+// RestoreTimeout は中断後の復元処理に許可する時間
+const RestoreTimeout = 30 * time.Second
+
+// Sleep は指定時間待機します
+// 待機中にコンテキストがキャンセルされた場合は即座にエラーを返します
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return WaitCanceled(ctx)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// WaitCanceled は待機処理が中断されたことを表すエラーを返します
+func WaitCanceled(ctx context.Context) error {
+	return fmt.Errorf(i18n.T("common.wait_canceled"), WarningIcon, ctx.Err())
+}
+
+// DetachedContext は親のキャンセルを引き継がない、復元処理用の短いコンテキストを返します
+// Ctrl-Cで中断された場合でも、一時的に変更した設定を必ず元に戻すために使用します
+func DetachedContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), RestoreTimeout)
+}
@@ -1,5 +1,10 @@
 package common
 
+import (
+	"awstk/internal/i18n"
+	"fmt"
+)
+
 // エラーメッセージの絵文字定数
 const (
 	ErrorIcon   = "❌"
@@ -11,33 +16,150 @@ const (
 	PartyIcon   = "🎉"
 )
 
-// エラーメッセージフォーマット定数
+// メッセージフォーマットのキー
+// i18n.T で現在の言語のフォーマット文字列に変換して使う
+// 例: fmt.Errorf(i18n.T(common.StartErrorFormat), common.ErrorIcon, i18n.T("resource.aurora_cluster"), err)
 const (
 	// 一覧取得エラー
-	ListErrorFormat = "%s %s一覧の取得に失敗: %w"
+	ListErrorFormat = "format.list_error"
 
 	// リソース操作エラー
-	EnableErrorFormat  = "%s %s の有効化に失敗: %w"
-	DisableErrorFormat = "%s %s の無効化に失敗: %w"
-	StartErrorFormat   = "%s %s の起動に失敗: %w"
-	StopErrorFormat    = "%s %s の停止に失敗: %w"
-	DeleteErrorFormat  = "%s %s の削除に失敗: %w"
+	EnableErrorFormat  = "format.enable_error"
+	DisableErrorFormat = "format.disable_error"
+	StartErrorFormat   = "format.start_error"
+	StopErrorFormat    = "format.stop_error"
+	DeleteErrorFormat  = "format.delete_error"
 
 	// その他の操作エラー
-	CreateErrorFormat = "%s %s の作成に失敗: %w"
-	UpdateErrorFormat = "%s %s の更新に失敗: %w"
-	GetErrorFormat    = "%s %s の取得に失敗: %w"
+	CreateErrorFormat = "format.create_error"
+	UpdateErrorFormat = "format.update_error"
+	GetErrorFormat    = "format.get_error"
 
 	// 成功メッセージ
-	EnableSuccessFormat  = "%s %s を有効化しました"
-	DisableSuccessFormat = "%s %s を無効化しました"
-	StartSuccessFormat   = "%s %s を起動しました"
-	StopSuccessFormat    = "%s %s を停止しました"
-	DeleteSuccessFormat  = "%s %s を削除しました"
-	CreateSuccessFormat  = "%s %s を作成しました"
-	UpdateSuccessFormat  = "%s %s を更新しました"
+	EnableSuccessFormat  = "format.enable_success"
+	DisableSuccessFormat = "format.disable_success"
+	StartSuccessFormat   = "format.start_success"
+	StopSuccessFormat    = "format.stop_success"
+	DeleteSuccessFormat  = "format.delete_success"
+	CreateSuccessFormat  = "format.create_success"
+	UpdateSuccessFormat  = "format.update_success"
 
 	// 処理中メッセージ
-	ProcessingFormat = "%s %s を処理中..."
-	SearchingFormat  = "%s %s を検索中..."
+	ProcessingFormat = "format.processing"
+	SearchingFormat  = "format.searching"
 )
+
+// InvalidInputError はフラグ・引数・入力ファイルの指定が不正なことを表すエラー
+type InvalidInputError struct {
+	Err error
+}
+
+func (e *InvalidInputError) Error() string { return e.Err.Error() }
+func (e *InvalidInputError) Unwrap() error { return e.Err }
+
+// NotFoundError は対象のリソースが存在しない（条件に一致するリソースがない）ことを表すエラー
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+// AccessDeniedError は権限不足で操作できなかったことを表すエラー
+type AccessDeniedError struct {
+	Err error
+}
+
+func (e *AccessDeniedError) Error() string { return e.Err.Error() }
+func (e *AccessDeniedError) Unwrap() error { return e.Err }
+
+// TimeoutError は待機がタイムアウトしたことを表すエラー
+type TimeoutError struct {
+	Err error
+}
+
+func (e *TimeoutError) Error() string { return e.Err.Error() }
+func (e *TimeoutError) Unwrap() error { return e.Err }
+
+// UserAbortedError は確認プロンプトでの拒否や Ctrl-C により処理を中止したことを表すエラー
+type UserAbortedError struct{}
+
+func (e *UserAbortedError) Error() string { return i18n.T("error.user_aborted") }
+
+// ExternalExitError は外部コマンド（プラグインなど）が0以外の終了コードで終了したことを表すエラー
+// 終了コードはそのまま awstk の終了コードとして返す
+type ExternalExitError struct {
+	Name string
+	Code int
+}
+
+func (e *ExternalExitError) Error() string {
+	return fmt.Sprintf(i18n.T("error.external_exit"), e.Name, e.Code)
+}
+
+// ItemResult は一括処理の1件ごとの結果
+type ItemResult struct {
+	Item string
+	Err  error
+}
+
+// PartialFailureError は一括処理の一部（または全部）の対象で失敗したことを表すエラー
+// Results には成功したものも含めた全対象の結果を処理順に保持する
+type PartialFailureError struct {
+	Operation string // 処理内容 (e.g., "S3バケットの削除")
+	Results   []ItemResult
+}
+
+func (e *PartialFailureError) Error() string {
+	return i18n.Tf("error.partial_failure", ErrorIcon, len(e.Results), len(e.Failed()), e.Operation)
+}
+
+// Unwrap は失敗した対象のエラーを返す
+func (e *PartialFailureError) Unwrap() []error {
+	var errs []error
+	for _, r := range e.Failed() {
+		errs = append(errs, r.Err)
+	}
+	return errs
+}
+
+// Failed は失敗した対象の結果のみを返す
+func (e *PartialFailureError) Failed() []ItemResult {
+	var failed []ItemResult
+	for _, r := range e.Results {
+		if r.Err != nil {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// InvalidInputf はフォーマットしたメッセージで InvalidInputError を生成する
+func InvalidInputf(format string, args ...any) error {
+	return &InvalidInputError{Err: fmt.Errorf(format, args...)}
+}
+
+// NotFoundf はフォーマットしたメッセージで NotFoundError を生成する
+func NotFoundf(format string, args ...any) error {
+	return &NotFoundError{Err: fmt.Errorf(format, args...)}
+}
+
+// Timeoutf はフォーマットしたメッセージで TimeoutError を生成する
+func Timeoutf(format string, args ...any) error {
+	return &TimeoutError{Err: fmt.Errorf(format, args...)}
+}
+
+// CollectFailures は Run の結果から失敗した対象があれば PartialFailureError を返す
+// items は Run に渡した対象と同じ順序の表示名。すべて成功した場合は nil を返す
+func CollectFailures[R any](operation string, items []string, results []Result[R]) error {
+	itemResults := make([]ItemResult, len(results))
+	failed := false
+	for i, r := range results {
+		itemResults[i] = ItemResult{Item: items[i], Err: r.Err}
+		failed = failed || r.Err != nil
+	}
+	if !failed {
+		return nil
+	}
+	return &PartialFailureError{Operation: operation, Results: itemResults}
+}
@@ -0,0 +1,118 @@
+package common
+
+import (
+	"awstk/internal/ui/picker"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go"
+)
+
+// 終了コード
+// CIなどから「対象なし」「一部失敗」「権限不足」を区別できるよう、エラーの種類ごとに分けている
+const (
+	ExitOK             = 0   // 成功
+	ExitError          = 1   // 分類できないエラー
+	ExitInvalidInput   = 2   // フラグ・引数・入力ファイルの指定が不正
+	ExitNotFound       = 3   // 対象のリソースが存在しない・条件に一致するリソースがない
+	ExitAccessDenied   = 4   // 権限不足
+	ExitPartialFailure = 5   // 一括処理の一部の対象で失敗
+	ExitTimeout        = 6   // 待機のタイムアウト
+	ExitUserAborted    = 130 // 確認プロンプトでの拒否・Ctrl-Cによる中断
+)
+
+// accessDeniedCodes は権限不足を表すAWS APIのエラーコード
+var accessDeniedCodes = map[string]struct{}{
+	"AccessDenied":                       {},
+	"AccessDeniedException":              {},
+	"UnauthorizedOperation":              {},
+	"UnauthorizedAccess":                 {},
+	"UnauthorizedException":              {},
+	"AuthorizationError":                 {},
+	"AuthorizationErrorException":        {},
+	"Forbidden":                          {},
+	"InvalidClientTokenId":               {},
+	"ExpiredToken":                       {},
+	"ExpiredTokenException":              {},
+	"UnrecognizedClientException":        {},
+	"AccessDeniedForDependencyException": {},
+}
+
+// ExitCode はエラーの種類に対応する終了コードを返します
+// 型付きのエラーに加え、AWS APIのエラーコード・HTTPステータスからも分類します
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var invalidInput *InvalidInputError
+	var notTerminal *picker.NotTerminalError
+	var userAborted *UserAbortedError
+	var partial *PartialFailureError
+	var accessDenied *AccessDeniedError
+	var notFound *NotFoundError
+	var timeout *TimeoutError
+	var external *ExternalExitError
+	switch {
+	case errors.As(err, &external):
+		return external.Code
+	case errors.As(err, &invalidInput), errors.As(err, &notTerminal):
+		return ExitInvalidInput
+	case errors.As(err, &userAborted), errors.Is(err, picker.ErrAborted), errors.Is(err, context.Canceled):
+		return ExitUserAborted
+	case errors.As(err, &partial):
+		return partialFailureExitCode(partial)
+	case errors.As(err, &accessDenied), isAccessDeniedApiError(err):
+		return ExitAccessDenied
+	case errors.As(err, &notFound), isNotFoundApiError(err):
+		return ExitNotFound
+	case errors.As(err, &timeout), errors.Is(err, context.DeadlineExceeded):
+		return ExitTimeout
+	}
+	return ExitError
+}
+
+// partialFailureExitCode は一括処理の失敗の終了コードを返します
+// 一部でも成功していれば ExitPartialFailure、全件失敗した場合は失敗理由が共通ならその終了コード、
+// 共通でなければ ExitError を返します
+func partialFailureExitCode(e *PartialFailureError) int {
+	failed := e.Failed()
+	if len(failed) < len(e.Results) {
+		return ExitPartialFailure
+	}
+	code := ExitError
+	for i, r := range failed {
+		c := ExitCode(r.Err)
+		if i > 0 && c != code {
+			return ExitError
+		}
+		code = c
+	}
+	return code
+}
+
+// isAccessDeniedApiError はAWS APIの権限不足エラーかどうかを判定します
+func isAccessDeniedApiError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		if _, ok := accessDeniedCodes[apiErr.ErrorCode()]; ok {
+			return true
+		}
+	}
+	return httpStatusCode(err) == http.StatusForbidden
+}
+
+// isNotFoundApiError はAWS APIのリソース不存在エラーかどうかを判定します
+// (e.g., ResourceNotFoundException, NoSuchBucket, DBInstanceNotFound, RepositoryNotFoundException)
+func isNotFoundApiError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		if strings.HasPrefix(code, "NoSuch") || strings.Contains(code, "NotFound") {
+			return true
+		}
+	}
+	return httpStatusCode(err) == http.StatusNotFound
+}
@@ -0,0 +1,85 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"awstk/internal/ui/picker"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestExitCode(t *testing.T) {
+	denied := &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "not authorized"}
+	noBucket := &smithy.GenericAPIError{Code: "NoSuchBucket", Message: "bucket does not exist"}
+	partial := func(errs ...error) error {
+		results := make([]ItemResult, len(errs))
+		for i, err := range errs {
+			results[i] = ItemResult{Item: fmt.Sprintf("item-%d", i), Err: err}
+		}
+		return &PartialFailureError{Operation: "削除", Results: results}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "成功", err: nil, want: ExitOK},
+		{name: "分類できないエラー", err: errors.New("boom"), want: ExitError},
+		{name: "入力エラー", err: InvalidInputf("-f を指定してください"), want: ExitInvalidInput},
+		{name: "ラップされた入力エラー", err: fmt.Errorf("❌ エラー: %w", InvalidInputf("不正")), want: ExitInvalidInput},
+		{name: "対象なし", err: NotFoundf("削除対象がありません"), want: ExitNotFound},
+		{name: "APIのNotFound系エラーコード", err: fmt.Errorf("取得失敗: %w", noBucket), want: ExitNotFound},
+		{name: "HTTP 404", err: responseError(http.StatusNotFound), want: ExitNotFound},
+		{name: "権限不足", err: &AccessDeniedError{Err: errors.New("denied")}, want: ExitAccessDenied},
+		{name: "APIのAccessDeniedエラーコード", err: fmt.Errorf("削除失敗: %w", denied), want: ExitAccessDenied},
+		{name: "HTTP 403", err: responseError(http.StatusForbidden), want: ExitAccessDenied},
+		{name: "タイムアウト", err: Timeoutf("%d秒経過しました", 300), want: ExitTimeout},
+		{name: "コンテキストの期限切れ", err: context.DeadlineExceeded, want: ExitTimeout},
+		{name: "ユーザーによる中止", err: &UserAbortedError{}, want: ExitUserAborted},
+		{name: "選択の中止", err: fmt.Errorf("選択失敗: %w", picker.ErrAborted), want: ExitUserAborted},
+		{name: "端末でないため選択できない", err: &picker.NotTerminalError{Hint: "-i"}, want: ExitInvalidInput},
+		{name: "外部コマンドの終了コード", err: &ExternalExitError{Name: "awstk-hello", Code: 42}, want: 42},
+		{name: "コンテキストのキャンセル", err: fmt.Errorf("待機失敗: %w", context.Canceled), want: ExitUserAborted},
+		{name: "一部失敗", err: partial(nil, denied, nil), want: ExitPartialFailure},
+		{name: "全件が同じ理由で失敗", err: partial(denied, denied), want: ExitAccessDenied},
+		{name: "全件が異なる理由で失敗", err: partial(denied, noBucket), want: ExitError},
+		{name: "全件が分類できないエラーで失敗", err: partial(errors.New("a"), errors.New("b")), want: ExitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.want {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectFailures(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	if err := CollectFailures("削除", items, []Result[struct{}]{{}, {}, {}}); err != nil {
+		t.Errorf("すべて成功した場合 CollectFailures() = %v, want nil", err)
+	}
+
+	failure := errors.New("access denied")
+	err := CollectFailures("削除", items, []Result[struct{}]{{}, {Err: failure}, {}})
+	var partial *PartialFailureError
+	if !errors.As(err, &partial) {
+		t.Fatalf("CollectFailures() = %v, want *PartialFailureError", err)
+	}
+	if len(partial.Results) != len(items) {
+		t.Errorf("len(Results) = %d, want %d", len(partial.Results), len(items))
+	}
+	if failed := partial.Failed(); len(failed) != 1 || failed[0].Item != "b" {
+		t.Errorf("Failed() = %+v, want [b]", failed)
+	}
+	if !errors.Is(err, failure) {
+		t.Errorf("errors.Is(err, failure) = false, want true")
+	}
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"fmt"
+	"os"
+)
+
+// LogLevel はメッセージの出力レベルを表す型
+type LogLevel int
+
+const (
+	LogLevelQuiet   LogLevel = iota // 結果・警告・エラーのみ出力する (--quiet)
+	LogLevelNormal                  // 進捗メッセージも出力する（既定）
+	LogLevelVerbose                 // AWS APIの呼び出しなど詳細なメッセージも出力する (-v)
+	LogLevelDebug                   // AWS SDKのリクエスト・レスポンスのログも出力する (--debug)
+)
+
+// currentLogLevel は現在の出力レベル
+var currentLogLevel = LogLevelNormal
+
+// SetLogLevel は出力レベルを設定します
+func SetLogLevel(level LogLevel) {
+	currentLogLevel = level
+}
+
+// CurrentLogLevel は現在の出力レベルを返します
+func CurrentLogLevel() LogLevel {
+	return currentLogLevel
+}
+
+// Verbosef は -v 以上の場合に詳細メッセージを標準エラー出力に出力します
+func Verbosef(format string, a ...any) {
+	if currentLogLevel >= LogLevelVerbose {
+		writeMessage(func() { _, _ = fmt.Fprintf(os.Stderr, format, a...) })
+	}
+}
+
+// Debugf は --debug の場合にデバッグメッセージを標準エラー出力に出力します
+func Debugf(format string, a ...any) {
+	if currentLogLevel >= LogLevelDebug {
+		writeMessage(func() { _, _ = fmt.Fprintf(os.Stderr, format, a...) })
+	}
+}
+
+// Warnf は警告や失敗した対象のメッセージを標準エラー出力に出力します
+// --quiet の場合も出力します
+func Warnf(format string, a ...any) {
+	writeMessage(func() { _, _ = fmt.Fprintf(os.Stderr, format, a...) })
+}
+
+// Warnln は警告や失敗した対象のメッセージを改行付きで標準エラー出力に出力します
+func Warnln(a ...any) {
+	writeMessage(func() { _, _ = fmt.Fprintln(os.Stderr, a...) })
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+// captureOutput は f の実行中に標準出力・標準エラー出力へ書き出された内容を返す
+func captureOutput(t *testing.T, f func()) (stdout, stderr string) {
+	t.Helper()
+	read := func(target **os.File) func() string {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatalf("os.Pipe() error = %v", err)
+		}
+		orig := *target
+		*target = w
+		return func() string {
+			*target = orig
+			_ = w.Close()
+			out, _ := io.ReadAll(r)
+			_ = r.Close()
+			return string(out)
+		}
+	}
+	restoreStdout := read(&os.Stdout)
+	restoreStderr := read(&os.Stderr)
+	f()
+	return restoreStdout(), restoreStderr()
+}
+
+func TestLogLevel(t *testing.T) {
+	tests := []struct {
+		name       string
+		level      LogLevel
+		wantStdout string
+		wantStderr string
+	}{
+		{name: "quiet は警告のみ", level: LogLevelQuiet, wantStderr: "warn\n"},
+		{name: "既定は進捗と警告", level: LogLevelNormal, wantStdout: "progress\n", wantStderr: "warn\n"},
+		{name: "-v は詳細メッセージも出力", level: LogLevelVerbose, wantStdout: "progress\n", wantStderr: "verbose\nwarn\n"},
+		{name: "--debug はデバッグメッセージも出力", level: LogLevelDebug, wantStdout: "progress\n", wantStderr: "verbose\ndebug\nwarn\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := CurrentLogLevel()
+			SetLogLevel(tt.level)
+			t.Cleanup(func() { SetLogLevel(prev) })
+
+			stdout, stderr := captureOutput(t, func() {
+				Progressf("progress\n")
+				Verbosef("verbose\n")
+				Debugf("debug\n")
+				Warnf("warn\n")
+			})
+			if stdout != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.wantStdout)
+			}
+			if stderr != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", stderr, tt.wantStderr)
+			}
+		})
+	}
+}
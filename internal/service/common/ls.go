@@ -1,7 +1,10 @@
 package common
 
 import (
+	"awstk/internal/i18n"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -27,7 +30,7 @@ func FormatBytes(bytes int64) string {
 // FormatTimestamp はUnixミリ秒のタイムスタンプをフォーマットする関数
 func FormatTimestamp(timestamp *int64) string {
 	if timestamp == nil {
-		return "不明"
+		return i18n.T("common.unknown")
 	}
 	t := time.Unix(*timestamp/1000, (*timestamp%1000)*1000000)
 	return t.Format("2006-01-02 15:04:05")
@@ -36,7 +39,7 @@ func FormatTimestamp(timestamp *int64) string {
 // GenerateFilteredTitle はフィルタ条件に基づいてタイトルを生成
 func GenerateFilteredTitle(resourceType string, conditions ...string) string {
 	if len(conditions) == 0 {
-		return fmt.Sprintf("%s一覧", resourceType)
+		return i18n.Tf("list.title", resourceType)
 	}
 
 	// 空文字列を除外
@@ -48,20 +51,30 @@ func GenerateFilteredTitle(resourceType string, conditions ...string) string {
 	}
 
 	if len(validConditions) == 0 {
-		return fmt.Sprintf("%s一覧", resourceType)
+		return i18n.Tf("list.title", resourceType)
 	}
 
-	return fmt.Sprintf("%s%s一覧", strings.Join(validConditions, ""), resourceType)
+	return i18n.Tf("list.filtered_title", strings.Join(validConditions, i18n.T("list.condition_separator")), resourceType)
 }
 
 // FormatListError はリスト取得エラーを統一フォーマットで返す
 func FormatListError(service string, err error) error {
-	return fmt.Errorf("❌ %s一覧取得でエラー: %w", service, err)
+	return fmt.Errorf(i18n.T("list.error"), service, err)
 }
 
 // FormatEmptyMessage は該当リソースがない場合のメッセージを返す
 func FormatEmptyMessage(resourceType string) string {
-	return fmt.Sprintf("%sが見つかりませんでした", resourceType)
+	return i18n.Tf("list.empty", resourceType)
+}
+
+// FormatNoMatchMessage は絞り込みの結果、該当リソースがない場合のメッセージを返す
+func FormatNoMatchMessage(resourceType string) string {
+	return i18n.Tf("list.no_match", resourceType)
+}
+
+// FormatTotalMessage はリソースの合計数のメッセージを返す
+func FormatTotalMessage(count int, resourceType string) string {
+	return i18n.Tf("list.total_of", count, resourceType)
 }
 
 // ===== 低レベル表示関数 =====
@@ -73,7 +86,7 @@ func PrintSimpleList(output ListOutput) {
 
 	// アイテムがない場合
 	if len(output.Items) == 0 {
-		fmt.Printf("該当する%sはありませんでした\n", output.ResourceName)
+		fmt.Println(FormatNoMatchMessage(output.ResourceName))
 		return
 	}
 
@@ -84,18 +97,18 @@ func PrintSimpleList(output ListOutput) {
 
 	// 合計数表示
 	if output.ShowCount {
-		fmt.Printf("\n合計: %d個の%s\n", len(output.Items), output.ResourceName)
+		fmt.Printf("\n%s\n", FormatTotalMessage(len(output.Items), output.ResourceName))
 	}
 }
 
 // PrintNumberedList は番号付きリストを表示
 func PrintNumberedList(output ListOutput) {
 	// タイトル表示（件数付き）
-	fmt.Printf("%s: (全%d件)\n", output.Title, len(output.Items))
+	fmt.Println(i18n.Tf("list.count_title", output.Title, len(output.Items)))
 
 	// アイテムがない場合
 	if len(output.Items) == 0 {
-		fmt.Printf("%sが見つかりませんでした\n", output.ResourceName)
+		fmt.Println(FormatEmptyMessage(output.ResourceName))
 		return
 	}
 
@@ -107,10 +120,10 @@ func PrintNumberedList(output ListOutput) {
 
 // PrintStatusList はステータス付きリストを表示
 func PrintStatusList(title string, items []ListItem, resourceName string) {
-	fmt.Printf("%s: (全%d件)\n", title, len(items))
+	fmt.Println(i18n.Tf("list.count_title", title, len(items)))
 
 	if len(items) == 0 {
-		fmt.Printf("%sが見つかりませんでした\n", resourceName)
+		fmt.Println(FormatEmptyMessage(resourceName))
 		return
 	}
 
@@ -125,8 +138,13 @@ func PrintStatusList(title string, items []ListItem, resourceName string) {
 
 // PrintTable はテーブル形式でデータを表示する
 func PrintTable(title string, columns []TableColumn, data [][]string) {
+	WriteTable(os.Stdout, title, columns, data)
+}
+
+// WriteTable はテーブル形式でデータを w に書き出す
+func WriteTable(w io.Writer, title string, columns []TableColumn, data [][]string) {
 	if title != "" {
-		fmt.Printf("\n%s:\n", title)
+		_, _ = fmt.Fprintf(w, "\n%s:\n", title)
 	}
 
 	// 各列の最大幅を計算（ヘッダーとデータの中で最大値を取得）
@@ -141,7 +159,7 @@ func PrintTable(title string, columns []TableColumn, data [][]string) {
 	for _, row := range data {
 		for i, cell := range row {
 			if i < len(colWidths) {
-				cellWidth := runewidth.StringWidth(cell)
+				cellWidth := displayWidth(cell)
 				if cellWidth > colWidths[i] {
 					colWidths[i] = cellWidth
 				}
@@ -151,37 +169,37 @@ func PrintTable(title string, columns []TableColumn, data [][]string) {
 
 	// ヘッダー表示
 	for i, col := range columns {
-		fmt.Printf("%s", col.Header)
+		_, _ = fmt.Fprintf(w, "%s", col.Header)
 		if i < len(columns)-1 {
 			// 列間に2スペースを挿入
 			padding := colWidths[i] - runewidth.StringWidth(col.Header) + 2
-			fmt.Printf("%s", strings.Repeat(" ", padding))
+			_, _ = fmt.Fprintf(w, "%s", strings.Repeat(" ", padding))
 		}
 	}
-	fmt.Println()
+	_, _ = fmt.Fprintln(w)
 
 	// 区切り線
 	for i := range columns {
-		fmt.Printf("%s", strings.Repeat("-", colWidths[i]))
+		_, _ = fmt.Fprintf(w, "%s", strings.Repeat("-", colWidths[i]))
 		if i < len(columns)-1 {
-			fmt.Print("  ") // 2スペース
+			_, _ = fmt.Fprint(w, "  ") // 2スペース
 		}
 	}
-	fmt.Println()
+	_, _ = fmt.Fprintln(w)
 
 	// データ行
 	for _, row := range data {
 		for i, cell := range row {
 			if i < len(columns) {
-				fmt.Printf("%s", cell)
+				_, _ = fmt.Fprintf(w, "%s", cell)
 				if i < len(columns)-1 {
 					// 列間に2スペースを挿入
-					padding := colWidths[i] - runewidth.StringWidth(cell) + 2
-					fmt.Printf("%s", strings.Repeat(" ", padding))
+					padding := colWidths[i] - displayWidth(cell) + 2
+					_, _ = fmt.Fprintf(w, "%s", strings.Repeat(" ", padding))
 				}
 			}
 		}
-		fmt.Println()
+		_, _ = fmt.Fprintln(w)
 	}
 }
 
@@ -194,12 +212,17 @@ func DisplayList[T any](
 	toTableData func([]T) ([]TableColumn, [][]string),
 	opts *DisplayOptions,
 ) error {
+	// 機械可読形式の場合は元の構造体をそのまま出力
+	if IsMachineReadable() {
+		return RenderRecords(items)
+	}
+
 	// デフォルトオプション
 	if opts == nil {
 		opts = &DisplayOptions{}
 	}
 	if opts.EmptyMessage == "" {
-		opts.EmptyMessage = "リソースが見つかりませんでした"
+		opts.EmptyMessage = i18n.T("list.empty_default")
 	}
 
 	// フィルタ条件がある場合はタイトルに追加
@@ -219,7 +242,7 @@ func DisplayList[T any](
 
 	// 件数表示
 	if opts.ShowCount {
-		fmt.Printf("\n合計: %d件\n", len(items))
+		fmt.Printf("\n%s\n", i18n.Tf("list.total", len(items)))
 	}
 
 	return nil
@@ -233,7 +256,7 @@ func DisplaySimpleList[T any](
 	opts *DisplayOptions,
 ) error {
 	toTableData := func(items []T) ([]TableColumn, [][]string) {
-		columns := []TableColumn{{Header: "名前"}}
+		columns := []TableColumn{{Header: i18n.T("header.name")}}
 		data := make([][]string, len(items))
 		for i, item := range items {
 			data[i] = []string{getName(item)}
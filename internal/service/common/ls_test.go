@@ -0,0 +1,52 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"awstk/internal/i18n"
+)
+
+func TestListMessages(t *testing.T) {
+	tests := []struct {
+		name      string
+		lang      i18n.Lang
+		wantEmpty string
+		wantTitle string
+		wantError string
+	}{
+		{
+			name:      "日本語",
+			lang:      i18n.Ja,
+			wantEmpty: "CloudWatch Logsグループが見つかりませんでした",
+			wantTitle: "空の保存期間未設定のCloudWatch Logsグループ一覧",
+			wantError: "❌ CloudWatch Logsグループ一覧取得でエラー: denied",
+		},
+		{
+			name:      "英語ではリソース名も英語になる",
+			lang:      i18n.En,
+			wantEmpty: "No CloudWatch Logs groups found",
+			wantTitle: "List of CloudWatch Logs groups (empty, no retention)",
+			wantError: "❌ Failed to list CloudWatch Logs groups: denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := i18n.Current()
+			i18n.SetLang(tt.lang)
+			t.Cleanup(func() { i18n.SetLang(prev) })
+
+			resource := i18n.T("resource.log_group")
+			if got := FormatEmptyMessage(resource); got != tt.wantEmpty {
+				t.Errorf("FormatEmptyMessage() = %q, want %q", got, tt.wantEmpty)
+			}
+			if got := GenerateFilteredTitle(resource, i18n.T("condition.empty"), i18n.T("condition.no_retention")); got != tt.wantTitle {
+				t.Errorf("GenerateFilteredTitle() = %q, want %q", got, tt.wantTitle)
+			}
+			if got := FormatListError(resource, errors.New("denied")).Error(); got != tt.wantError {
+				t.Errorf("FormatListError() = %q, want %q", got, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package common
+
+import (
+	"awstk/internal/i18n"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat は出力形式を表す型
+type OutputFormat string
+
+const (
+	OutputFormatTable OutputFormat = "table"
+	OutputFormatJson  OutputFormat = "json"
+	OutputFormatYaml  OutputFormat = "yaml"
+	OutputFormatCsv   OutputFormat = "csv"
+	OutputFormatTsv   OutputFormat = "tsv"
+)
+
+// SupportedOutputFormats は --output で指定可能な形式の一覧
+var SupportedOutputFormats = []OutputFormat{
+	OutputFormatTable,
+	OutputFormatJson,
+	OutputFormatYaml,
+	OutputFormatCsv,
+	OutputFormatTsv,
+}
+
+// currentOutputFormat は現在の出力形式（デフォルトはテーブル）
+var currentOutputFormat = OutputFormatTable
+
+// ParseOutputFormat は文字列を OutputFormat に変換します
+func ParseOutputFormat(s string) (OutputFormat, error) {
+	if s == "" {
+		return OutputFormatTable, nil
+	}
+	for _, f := range SupportedOutputFormats {
+		if strings.EqualFold(s, string(f)) {
+			return f, nil
+		}
+	}
+	names := make([]string, len(SupportedOutputFormats))
+	for i, f := range SupportedOutputFormats {
+		names[i] = string(f)
+	}
+	return "", InvalidInputf(i18n.T("output.invalid_format"), ErrorIcon, s, strings.Join(names, "|"))
+}
+
+// SetOutputFormat は出力形式を設定します
+func SetOutputFormat(format OutputFormat) {
+	currentOutputFormat = format
+}
+
+// CurrentOutputFormat は現在の出力形式を返します
+func CurrentOutputFormat() OutputFormat {
+	return currentOutputFormat
+}
+
+// IsMachineReadable は機械可読形式（table以外）で出力するかどうかを返します
+func IsMachineReadable() bool {
+	return currentOutputFormat != OutputFormatTable
+}
+
+// progressToStderr は出力形式によらず進捗メッセージを標準エラー出力に出すかどうか
+var progressToStderr bool
+
+// SetProgressToStderr は出力形式によらず進捗メッセージを標準エラー出力に出すかを設定します
+// eval での読み込みや .env ファイルへのリダイレクトなど、標準出力に結果のみを出す必要がある場合に使用します
+func SetProgressToStderr(enabled bool) {
+	progressToStderr = enabled
+}
+
+// ProgressWriter は進捗メッセージの出力先を返します
+// 機械可読形式の場合は標準出力を汚さないよう標準エラー出力を返します
+func ProgressWriter() io.Writer {
+	if IsMachineReadable() || progressToStderr {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// Progressf は進捗メッセージを出力します（--quiet の場合は出力しません）
+func Progressf(format string, a ...any) {
+	if currentLogLevel >= LogLevelNormal {
+		writeMessage(func() { _, _ = fmt.Fprintf(ProgressWriter(), format, a...) })
+	}
+}
+
+// Progressln は進捗メッセージを改行付きで出力します（--quiet の場合は出力しません）
+func Progressln(a ...any) {
+	if currentLogLevel >= LogLevelNormal {
+		writeMessage(func() { _, _ = fmt.Fprintln(ProgressWriter(), a...) })
+	}
+}
+
+// RenderRecords はレコードのスライスを現在の出力形式で標準出力に書き出します
+func RenderRecords[T any](items []T) error {
+	return WriteRecords(os.Stdout, currentOutputFormat, items)
+}
+
+// RenderRecord は単一のレコードを現在の出力形式で標準出力に書き出します
+func RenderRecord[T any](item T) error {
+	return WriteRecord(os.Stdout, currentOutputFormat, item)
+}
+
+// WriteRecords はレコードのスライスを指定形式で書き出します
+func WriteRecords[T any](w io.Writer, format OutputFormat, items []T) error {
+	if items == nil {
+		items = []T{}
+	}
+	switch format {
+	case OutputFormatJson:
+		return writeJson(w, items)
+	case OutputFormatYaml:
+		return writeYaml(w, items)
+	case OutputFormatCsv:
+		return writeDelimited(w, ',', items)
+	case OutputFormatTsv:
+		return writeDelimited(w, '\t', items)
+	default:
+		return fmt.Errorf(i18n.T("output.table_unsupported"), ErrorIcon)
+	}
+}
+
+// WriteRecord は単一のレコードを指定形式で書き出します
+// JSON/YAMLではオブジェクトとして、CSV/TSVでは1行として出力します
+func WriteRecord[T any](w io.Writer, format OutputFormat, item T) error {
+	switch format {
+	case OutputFormatJson:
+		return writeJson(w, item)
+	case OutputFormatYaml:
+		return writeYaml(w, item)
+	default:
+		return WriteRecords(w, format, []T{item})
+	}
+}
+
+// writeJson はインデント付きJSONで書き出す
+func writeJson(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf(i18n.T("output.json_error"), err)
+	}
+	return nil
+}
+
+// writeYaml はYAMLで書き出す
+// キー名をJSONと揃えるため、一度JSONを経由して汎用値に変換してから出力する
+func writeYaml(w io.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf(i18n.T("output.yaml_error"), err)
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return fmt.Errorf(i18n.T("output.yaml_error"), err)
+	}
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(generic); err != nil {
+		return fmt.Errorf(i18n.T("output.yaml_error"), err)
+	}
+	return enc.Close()
+}
+
+// writeDelimited は構造体のエクスポートフィールドを列としてCSV/TSVで書き出す
+// 列名はJSON出力と揃えて json タグの名前を使い、レコードが0件でもヘッダー行は出力する
+func writeDelimited[T any](w io.Writer, comma rune, items []T) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	t := reflect.TypeFor[T]()
+	// 要素の型がインターフェースの場合は最初のレコードの型から列を決める
+	if t.Kind() == reflect.Interface {
+		if len(items) == 0 || reflect.ValueOf(items[0]).Kind() == reflect.Invalid {
+			return nil
+		}
+		t = reflect.TypeOf(items[0])
+	}
+
+	// リージョン付きレコードは region 列（JSON出力のキー名と同じ）を先頭に加えて元のレコードを展開する
+	regional := t.Implements(regionalItemType)
+	if regional {
+		field, _ := t.FieldByName("Item")
+		t = field.Type
+	}
+	columns := delimitedColumns(t)
+	headers := make([]string, 0, len(columns)+1)
+	if regional {
+		headers = append(headers, "region")
+	}
+	for _, c := range columns {
+		headers = append(headers, c.header)
+	}
+
+	rows := make([][]string, 0, len(items)+1)
+	rows = append(rows, headers)
+	for _, item := range items {
+		var row []string
+		v := reflect.ValueOf(item)
+		if r, ok := any(item).(regionalItem); ok {
+			region, inner := r.regionalItem()
+			row = append(row, region)
+			v = reflect.ValueOf(inner)
+		}
+		rows = append(rows, append(row, delimitedRow(v, columns)...))
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf(i18n.T("output.csv_error"), err)
+	}
+	return nil
+}
+
+// regionalItem はCSV/TSV出力時に region 列を先頭に展開するレコード
+type regionalItem interface {
+	regionalItem() (string, any)
+}
+
+var regionalItemType = reflect.TypeFor[regionalItem]()
+
+// delimitedColumn はCSV/TSVの1列
+type delimitedColumn struct {
+	header string
+	index  []int // レコードの構造体でのフィールドのインデックス（nil の場合はレコード自体の値）
+}
+
+// delimitedColumns はレコードの型から列を求める
+// 構造体以外はそのまま1列の値として扱う
+func delimitedColumns(t reflect.Type) []delimitedColumn {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return []delimitedColumn{{header: "value"}}
+	}
+
+	var columns []delimitedColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// タグで名前を付けていない埋め込み構造体はフィールドを展開する（encoding/json と同じ扱い）
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for _, c := range delimitedColumns(embedded) {
+					columns = append(columns, delimitedColumn{header: c.header, index: append([]int{i}, c.index...)})
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, delimitedColumn{header: name, index: []int{i}})
+	}
+	return columns
+}
+
+// delimitedRow はレコードの値を columns の順に文字列へ変換する
+func delimitedRow(v reflect.Value, columns []delimitedColumn) []string {
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		fv := v
+		for _, index := range c.index {
+			for fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface {
+				if fv.IsNil() {
+					fv = reflect.Value{}
+					break
+				}
+				fv = fv.Elem()
+			}
+			if !fv.IsValid() {
+				break
+			}
+			fv = fv.Field(index)
+		}
+		if fv.IsValid() {
+			row[i] = formatCell(fv)
+		}
+	}
+	return row
+}
+
+// formatCell はフィールド値をセル用の文字列に変換する
+func formatCell(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		// 入れ子の値はJSON文字列として1セルに格納する
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Sprint(v.Interface())
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
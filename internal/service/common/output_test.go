@@ -0,0 +1,76 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+type outputTestBase struct {
+	Id string `json:"id"`
+}
+
+type outputTestItem struct {
+	outputTestBase
+	Name    string     `json:"name"`
+	Size    int        `json:"size,omitempty"`
+	Created *time.Time `json:"created"`
+	Secret  string     `json:"-"`
+	Note    string
+}
+
+func TestWriteRecordsDelimited(t *testing.T) {
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	items := []outputTestItem{
+		{outputTestBase: outputTestBase{Id: "a"}, Name: "x", Size: 2, Created: &created, Secret: "s", Note: "n"},
+		{outputTestBase: outputTestBase{Id: "b"}, Name: "y, z"},
+	}
+
+	tests := []struct {
+		name   string
+		format OutputFormat
+		items  []outputTestItem
+		want   string
+	}{
+		{
+			name:   "ヘッダーは json タグの名前を使う",
+			format: OutputFormatCsv,
+			items:  items,
+			want:   "id,name,size,created,Note\na,x,2,2026-01-02T03:04:05Z,n\nb,\"y, z\",0,,\n",
+		},
+		{
+			name:   "TSV",
+			format: OutputFormatTsv,
+			items:  items[:1],
+			want:   "id\tname\tsize\tcreated\tNote\na\tx\t2\t2026-01-02T03:04:05Z\tn\n",
+		},
+		{
+			name:   "レコードが0件でもヘッダーを出力する",
+			format: OutputFormatCsv,
+			items:  nil,
+			want:   "id,name,size,created,Note\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteRecords(&buf, tt.format, tt.items); err != nil {
+				t.Fatalf("WriteRecords() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteRecords() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteRecordsDelimitedEmptyRegional(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRecords(&buf, OutputFormatCsv, []RegionalRecord[regionTestItem]{}); err != nil {
+		t.Fatalf("WriteRecords() error = %v", err)
+	}
+	if got, want := buf.String(), "region,Name,Count\n"; got != want {
+		t.Errorf("WriteRecords() = %q, want %q", got, want)
+	}
+}
@@ -1,51 +1,141 @@
 package common
 
 import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"os"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	"github.com/schollz/progressbar/v3"
+	"golang.org/x/term"
+)
+
+const (
+	// DefaultConcurrency は RunOptions.Concurrency が未指定の場合の最大同時実行数
+	DefaultConcurrency = 10
+	// DefaultMaxAttempts はスロットリング・5xxエラー時の既定の最大試行回数
+	DefaultMaxAttempts = 5
+)
+
+// バックオフの待機時間（テストから短縮できるよう変数にしている）
+var (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 20 * time.Second
 )
 
-// ParallelExecutor は並列処理を管理する構造体
-type ParallelExecutor struct {
-	maxWorkers int
-	wg         sync.WaitGroup
-	semaphore  chan struct{}
+// concurrencyOverride は --concurrency で指定された最大同時実行数（0は未指定）
+var concurrencyOverride int
+
+// SetConcurrency は --concurrency で指定された最大同時実行数を設定する
+func SetConcurrency(n int) {
+	concurrencyOverride = n
 }
 
-// NewParallelExecutor は新しいParallelExecutorを作成
-func NewParallelExecutor(maxWorkers int) *ParallelExecutor {
-	return &ParallelExecutor{
-		maxWorkers: maxWorkers,
-		semaphore:  make(chan struct{}, maxWorkers),
+// ResolveConcurrency は呼び出し元の既定値と --concurrency から実際の最大同時実行数を決める
+func ResolveConcurrency(defaultConcurrency int) int {
+	if concurrencyOverride > 0 {
+		return concurrencyOverride
+	}
+	if defaultConcurrency > 0 {
+		return defaultConcurrency
 	}
+	return DefaultConcurrency
 }
 
-// Execute はタスクを並列で実行
-func (p *ParallelExecutor) Execute(task func()) {
-	p.wg.Add(1)
-	go func() {
-		defer p.wg.Done()
-		p.semaphore <- struct{}{}        // セマフォ取得（同時実行数制限）
-		defer func() { <-p.semaphore }() // セマフォ解放
-		task()
-	}()
+// RunOptions は Run のオプション
+type RunOptions struct {
+	Concurrency int    // 最大同時実行数（0の場合はDefaultConcurrency。--concurrency指定時はそちらを優先）
+	MaxAttempts int    // スロットリング・5xxエラー時の最大試行回数（0の場合はDefaultMaxAttempts、1でリトライなし）
+	Progress    string // プログレスバーの説明（空の場合、または標準エラーが端末でない場合は表示しない）
 }
 
-// Wait はすべてのタスクの完了を待つ
-func (p *ParallelExecutor) Wait() {
-	p.wg.Wait()
+// Result は Run の1件ごとの処理結果
+type Result[R any] struct {
+	Value    R
+	Err      error
+	Attempts int // 試行回数（未実行の場合は0）
 }
 
-// ProcessResult は処理結果を保持する構造体
-type ProcessResult struct {
-	Item    string
-	Success bool
-	Error   error
+// Run は items の各要素に fn を並列で適用し、items と同じ順序で結果を返す
+// スロットリング・5xxエラーは指数バックオフ（ジッター付き）でリトライし、
+// スロットリングを検知した場合は同時実行数を一時的に下げる
+// AWS SDKのリトライで最大試行回数に達した5xxエラーは、試行回数が掛け算で増えないようリトライしない
+// スロットリングはSDKのリトライで回復しなくても、同時実行数を下げてからリトライする
+func Run[T, R any](ctx context.Context, items []T, fn func(ctx context.Context, item T) (R, error), opts *RunOptions) []Result[R] {
+	results := make([]Result[R], len(items))
+	if len(items) == 0 {
+		return results
+	}
+	if opts == nil {
+		opts = &RunOptions{}
+	}
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	workers := min(ResolveConcurrency(opts.Concurrency), len(items))
+
+	limiter := newAdaptiveLimiter(ctx, workers)
+	defer limiter.close()
+	bar := newProgressBar(opts.Progress, len(items))
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				idx := int(next.Add(1) - 1)
+				if idx >= len(items) {
+					return
+				}
+				results[idx] = runWithRetry(ctx, limiter, items[idx], fn, maxAttempts)
+				bar.add()
+			}
+		}()
+	}
+	wg.Wait()
+
+	bar.finish()
+	return results
+}
+
+// runWithRetry は1件の処理をリトライ付きで実行する
+func runWithRetry[T, R any](ctx context.Context, limiter *adaptiveLimiter, item T, fn func(ctx context.Context, item T) (R, error), maxAttempts int) Result[R] {
+	var result Result[R]
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := limiter.acquire(ctx); err != nil {
+			if result.Err == nil {
+				result.Err = err
+			}
+			return result
+		}
+		result.Value, result.Err = fn(ctx, item)
+		result.Attempts = attempt
+		limiter.release(IsThrottle(result.Err))
+
+		if result.Err == nil || !IsRetryable(result.Err) || retriedBySdk(result.Err) || attempt == maxAttempts {
+			return result
+		}
+		if err := Sleep(ctx, backoffDelay(attempt)); err != nil {
+			return result
+		}
+	}
+	return result
 }
 
-// CollectResults は並列処理の結果を収集するヘルパー関数
-func CollectResults(results []ProcessResult) (successCount, failCount int) {
+// CountResults は Run の結果から成功件数と失敗件数を数える
+func CountResults[R any](results []Result[R]) (successCount, failCount int) {
 	for _, result := range results {
-		if result.Success {
+		if result.Err == nil {
 			successCount++
 		} else {
 			failCount++
@@ -53,3 +143,189 @@ func CollectResults(results []ProcessResult) (successCount, failCount int) {
 	}
 	return
 }
+
+// IsThrottle はAPI呼び出しがスロットリングされたエラーかどうかを判定する
+func IsThrottle(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		if _, ok := retry.DefaultThrottleErrorCodes[apiErr.ErrorCode()]; ok {
+			return true
+		}
+	}
+	return httpStatusCode(err) == http.StatusTooManyRequests
+}
+
+// IsRetryable はリトライで回復が見込めるエラー（スロットリング・5xx）かどうかを判定する
+func IsRetryable(err error) bool {
+	if IsThrottle(err) {
+		return true
+	}
+	return httpStatusCode(err) >= http.StatusInternalServerError
+}
+
+// retriedBySdk はAWS SDKのリトライで最大試行回数に達し、Run ではリトライしないエラーかどうかを判定する
+// スロットリングは同時実行数を下げれば回復が見込めるため対象外とする
+func retriedBySdk(err error) bool {
+	var maxErr *retry.MaxAttemptsError
+	return errors.As(err, &maxErr) && !IsThrottle(err)
+}
+
+// httpStatusCode はエラーに含まれるHTTPステータスコードを返す（含まれない場合は0）
+func httpStatusCode(err error) int {
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.HTTPStatusCode()
+	}
+	return 0
+}
+
+// backoffDelay は attempt 回目の失敗後の待機時間を返す（指数バックオフ + ジッター）
+func backoffDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	// 待機時間の半分から全体の範囲でランダムにずらし、リトライが同時に集中しないようにする
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// adaptiveLimiter はスロットリング時に同時実行数を半減させ、成功が続くと1ずつ戻すリミッター
+type adaptiveLimiter struct {
+	mu        sync.Mutex
+	cond      *sync.Cond
+	limit     int
+	max       int
+	active    int
+	successes int
+	stop      func() bool
+}
+
+// newAdaptiveLimiter は最大同時実行数 max のリミッターを生成する
+func newAdaptiveLimiter(ctx context.Context, max int) *adaptiveLimiter {
+	l := &adaptiveLimiter{limit: max, max: max}
+	l.cond = sync.NewCond(&l.mu)
+	// キャンセル時に待機中のワーカーを起こす
+	l.stop = context.AfterFunc(ctx, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.cond.Broadcast()
+	})
+	return l
+}
+
+// acquire は実行枠が空くまで待機する
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.active >= l.limit && ctx.Err() == nil {
+		l.cond.Wait()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	l.active++
+	return nil
+}
+
+// release は実行枠を返却し、スロットリングの有無に応じて同時実行数を調整する
+func (l *adaptiveLimiter) release(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.active--
+	if throttled {
+		l.limit = max(1, l.limit/2)
+		l.successes = 0
+	} else if l.limit < l.max {
+		l.successes++
+		if l.successes >= l.limit {
+			l.limit++
+			l.successes = 0
+		}
+	}
+	l.cond.Broadcast()
+}
+
+// close はキャンセル監視を解除する
+func (l *adaptiveLimiter) close() {
+	l.stop()
+}
+
+// runProgressBar は Run のプログレスバー
+// 表示中は Progressf・Warnf などのメッセージの出力時に一時的に消し、メッセージとプログレスバーが同じ行に混ざらないようにする
+type runProgressBar struct {
+	bar *progressbar.ProgressBar
+}
+
+// activeProgressBar は表示中のプログレスバー（progressBarMu で保護する）
+var (
+	progressBarMu     sync.Mutex
+	activeProgressBar *progressbar.ProgressBar
+)
+
+// newProgressBar は標準エラーが端末の場合のみプログレスバーを生成する
+// すでに別のプログレスバーを表示中の場合（Run の入れ子）は表示しない
+func newProgressBar(description string, total int) *runProgressBar {
+	if description == "" || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return nil
+	}
+	progressBarMu.Lock()
+	defer progressBarMu.Unlock()
+	if activeProgressBar != nil {
+		return nil
+	}
+	activeProgressBar = progressbar.NewOptions(total,
+		progressbar.OptionSetWriter(os.Stderr),
+		progressbar.OptionSetDescription(description),
+		progressbar.OptionSetWidth(40),
+		progressbar.OptionShowCount(),
+		progressbar.OptionSetTheme(progressbar.Theme{
+			Saucer:        "=",
+			SaucerHead:    ">",
+			SaucerPadding: " ",
+			BarStart:      "[",
+			BarEnd:        "]",
+		}),
+		progressbar.OptionOnCompletion(func() {
+			_, _ = os.Stderr.WriteString("\n")
+		}),
+	)
+	return &runProgressBar{bar: activeProgressBar}
+}
+
+// add は処理済みの件数を1つ進める
+func (b *runProgressBar) add() {
+	if b == nil {
+		return
+	}
+	progressBarMu.Lock()
+	defer progressBarMu.Unlock()
+	_ = b.bar.Add(1)
+}
+
+// finish はプログレスバーを完了させ、メッセージの出力時に消す対象から外す
+func (b *runProgressBar) finish() {
+	if b == nil {
+		return
+	}
+	progressBarMu.Lock()
+	defer progressBarMu.Unlock()
+	_ = b.bar.Finish()
+	activeProgressBar = nil
+}
+
+// writeMessage はプログレスバーを表示中であれば消してから write でメッセージを出力し、プログレスバーを再描画する
+func writeMessage(write func()) {
+	progressBarMu.Lock()
+	defer progressBarMu.Unlock()
+	if activeProgressBar == nil {
+		write()
+		return
+	}
+	_ = activeProgressBar.Clear()
+	write()
+	_ = activeProgressBar.RenderBlank()
+}
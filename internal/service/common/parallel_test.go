@@ -0,0 +1,212 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/schollz/progressbar/v3"
+)
+
+// shortenBackoff はテスト中のバックオフ待機時間を短縮する
+func shortenBackoff(t *testing.T) {
+	t.Helper()
+	base, maxDelay := retryBaseDelay, retryMaxDelay
+	retryBaseDelay, retryMaxDelay = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() { retryBaseDelay, retryMaxDelay = base, maxDelay })
+}
+
+// responseError はHTTPステータスコード付きのSDKエラーを生成する
+func responseError(status int) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: status}},
+			Err:      errors.New("server error"),
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	shortenBackoff(t)
+	throttle := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+
+	tests := []struct {
+		name         string
+		failures     int   // 成功するまでに返すエラーの回数
+		err          error // 返すエラー
+		maxAttempts  int
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "成功", wantAttempts: 1},
+		{name: "スロットリングはリトライして成功", failures: 2, err: throttle, wantAttempts: 3},
+		{name: "5xxはリトライして成功", failures: 1, err: responseError(http.StatusServiceUnavailable), wantAttempts: 2},
+		{name: "ラップされたスロットリングもリトライ", failures: 1, err: fmt.Errorf("削除失敗: %w", throttle), wantAttempts: 2},
+		{name: "リトライ対象外のエラーは即失敗", failures: 1, err: errors.New("AccessDenied"), wantErr: true, wantAttempts: 1},
+		{name: "4xxは即失敗", failures: 1, err: responseError(http.StatusBadRequest), wantErr: true, wantAttempts: 1},
+		{name: "最大試行回数で打ち切り", failures: 10, err: throttle, maxAttempts: 3, wantErr: true, wantAttempts: 3},
+		{name: "SDKのリトライで最大試行回数に達した5xxはリトライしない", failures: 1, err: &retry.MaxAttemptsError{Attempt: 3, Err: responseError(http.StatusServiceUnavailable)}, wantErr: true, wantAttempts: 1},
+		{name: "SDKのリトライで最大試行回数に達したスロットリングはリトライ", failures: 2, err: fmt.Errorf("削除失敗: %w", &retry.MaxAttemptsError{Attempt: 3, Err: throttle}), wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			results := Run(t.Context(), []string{"a"}, func(ctx context.Context, item string) (string, error) {
+				if int(calls.Add(1)) <= tt.failures {
+					return "", tt.err
+				}
+				return item + "!", nil
+			}, &RunOptions{MaxAttempts: tt.maxAttempts})
+
+			if len(results) != 1 {
+				t.Fatalf("len(results) = %d, want 1", len(results))
+			}
+			if (results[0].Err != nil) != tt.wantErr {
+				t.Errorf("Err = %v, wantErr %v", results[0].Err, tt.wantErr)
+			}
+			if !tt.wantErr && results[0].Value != "a!" {
+				t.Errorf("Value = %q, want %q", results[0].Value, "a!")
+			}
+			if results[0].Attempts != tt.wantAttempts {
+				t.Errorf("Attempts = %d, want %d", results[0].Attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRunOrderAndConcurrency(t *testing.T) {
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+
+	var running, peak atomic.Int32
+	results := Run(t.Context(), items, func(ctx context.Context, n int) (int, error) {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if cur <= p || peak.CompareAndSwap(p, cur) {
+				break
+			}
+		}
+		// 後ろの要素ほど早く終わるようにして、完了順と結果の順序が異なる状況を作る
+		time.Sleep(time.Duration(len(items)-n) * 100 * time.Microsecond)
+		return n * 2, nil
+	}, &RunOptions{Concurrency: 4})
+
+	for i, r := range results {
+		if r.Err != nil || r.Value != i*2 {
+			t.Errorf("results[%d] = %+v, want Value %d", i, r, i*2)
+		}
+	}
+	if got := peak.Load(); got > 4 {
+		t.Errorf("最大同時実行数 = %d, want <= 4", got)
+	}
+	if success, fail := CountResults(results); success != len(items) || fail != 0 {
+		t.Errorf("CountResults() = (%d, %d), want (%d, 0)", success, fail, len(items))
+	}
+}
+
+func TestRunConcurrencyOverride(t *testing.T) {
+	SetConcurrency(1)
+	t.Cleanup(func() { SetConcurrency(0) })
+
+	var running, peak atomic.Int32
+	Run(t.Context(), []int{1, 2, 3, 4}, func(ctx context.Context, n int) (struct{}, error) {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		if cur > peak.Load() {
+			peak.Store(cur)
+		}
+		time.Sleep(time.Millisecond)
+		return struct{}{}, nil
+	}, &RunOptions{Concurrency: 4})
+
+	if got := peak.Load(); got != 1 {
+		t.Errorf("最大同時実行数 = %d, want 1", got)
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	var calls atomic.Int32
+	results := Run(ctx, []int{1, 2, 3}, func(ctx context.Context, n int) (int, error) {
+		calls.Add(1)
+		return n, nil
+	}, nil)
+
+	if calls.Load() != 0 {
+		t.Errorf("キャンセル後に %d 件実行された", calls.Load())
+	}
+	for i, r := range results {
+		if !errors.Is(r.Err, context.Canceled) || r.Attempts != 0 {
+			t.Errorf("results[%d] = %+v, want context.Canceled", i, r)
+		}
+	}
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	l := newAdaptiveLimiter(t.Context(), 8)
+	defer l.close()
+
+	acquire := func() {
+		if err := l.acquire(t.Context()); err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+	}
+
+	// スロットリングのたびに半減し、1を下回らない
+	for _, want := range []int{4, 2, 1, 1} {
+		acquire()
+		l.release(true)
+		if l.limit != want {
+			t.Errorf("limit = %d, want %d", l.limit, want)
+		}
+	}
+
+	// 現在の上限と同じ回数だけ成功が続くと1増える
+	for _, want := range []int{2, 2, 3} {
+		acquire()
+		l.release(false)
+		if l.limit != want {
+			t.Errorf("limit = %d, want %d", l.limit, want)
+		}
+	}
+}
+
+func TestWriteMessageClearsProgressBar(t *testing.T) {
+	var buf bytes.Buffer
+	activeProgressBar = progressbar.NewOptions(10, progressbar.OptionSetWriter(&buf), progressbar.OptionSetDescription("処理中"))
+	t.Cleanup(func() { activeProgressBar = nil })
+	_ = activeProgressBar.RenderBlank()
+	buf.Reset()
+
+	writeMessage(func() { buf.WriteString("✅ 完了しました\n") })
+
+	got := buf.String()
+	msg := strings.Index(got, "✅ 完了しました\n")
+	if msg < 0 {
+		t.Fatalf("メッセージが出力されていません: %q", got)
+	}
+	// メッセージの前でプログレスバーを消し、メッセージの後で再描画する
+	if !strings.HasPrefix(got, "\r") {
+		t.Errorf("メッセージの前にプログレスバーが消されていません: %q", got)
+	}
+	if !strings.Contains(got[msg:], "処理中") {
+		t.Errorf("メッセージの後にプログレスバーが再描画されていません: %q", got)
+	}
+}
@@ -0,0 +1,201 @@
+package common
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/ui/picker"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// PlanVersion は計画ファイルのフォーマットバージョン
+const PlanVersion = 1
+
+// RiskLevel はアクションの危険度を表す
+type RiskLevel string
+
+const (
+	RiskLow    RiskLevel = "low"    // リソースの状態を変えない操作（Canaryの手動実行など）
+	RiskMedium RiskLevel = "medium" // サービス影響はあるが元に戻せる操作（停止など）
+	RiskHigh   RiskLevel = "high"   // 元に戻せない操作（削除など）
+)
+
+// Operation はアクションの操作種別を表す
+type Operation string
+
+const (
+	OperationDelete Operation = "delete"
+	OperationStop   Operation = "stop"
+	OperationRun    Operation = "run"
+	OperationPut    Operation = "put"
+)
+
+// リソースタイプ（CloudFormationのリソースタイプ名に合わせる）
+const (
+	ResourceS3Bucket        = "AWS::S3::Bucket"
+	ResourceEcrRepository   = "AWS::ECR::Repository"
+	ResourceLogGroup        = "AWS::Logs::LogGroup"
+	ResourceCfnStack        = "AWS::CloudFormation::Stack"
+	ResourceEc2Instance     = "AWS::EC2::Instance"
+	ResourceRdsInstance     = "AWS::RDS::DBInstance"
+	ResourceAuroraCluster   = "AWS::RDS::DBCluster"
+	ResourceEcsService      = "AWS::ECS::Service"
+	ResourceSecret          = "AWS::SecretsManager::Secret"
+	ResourceSyntheticCanary = "AWS::Synthetics::Canary"
+	ResourceHostedZone      = "AWS::Route53::HostedZone"
+	ResourceSsmParameter    = "AWS::SSM::Parameter"
+)
+
+// Action は計画に含まれる1件の操作
+type Action struct {
+	ResourceType string    `json:"resourceType" yaml:"resourceType"`
+	ResourceId   string    `json:"resourceId" yaml:"resourceId"`
+	Operation    Operation `json:"operation" yaml:"operation"`
+	Risk         RiskLevel `json:"risk" yaml:"risk"`
+	Detail       string    `json:"detail,omitempty" yaml:"detail,omitempty"`
+	// Params は操作の実行に必要な値（登録するSSMパラメータの値など）
+	Params map[string]string `json:"params,omitempty" yaml:"params,omitempty"`
+}
+
+// Plan は破壊的コマンドが実行前に組み立てる操作の一覧
+// --plan-out で保存し、apply コマンドでそのまま実行できる
+type Plan struct {
+	Version   int       `json:"version" yaml:"version"`
+	Command   string    `json:"command" yaml:"command"`
+	Profile   string    `json:"profile,omitempty" yaml:"profile,omitempty"`
+	Region    string    `json:"region,omitempty" yaml:"region,omitempty"`
+	CreatedAt time.Time `json:"createdAt" yaml:"createdAt"`
+	Actions   []Action  `json:"actions" yaml:"actions"`
+}
+
+// NewPlan は空の計画を生成する
+func NewPlan(command string) *Plan {
+	return &Plan{
+		Version:   PlanVersion,
+		Command:   command,
+		CreatedAt: time.Now(),
+		Actions:   []Action{},
+	}
+}
+
+// Add はアクションを計画に追加する
+func (p *Plan) Add(actions ...Action) {
+	p.Actions = append(p.Actions, actions...)
+}
+
+// IsEmpty は計画にアクションが含まれていないかを返す
+func (p *Plan) IsEmpty() bool {
+	return len(p.Actions) == 0
+}
+
+// ResourceIds は指定したリソースタイプ・操作のリソースIDを計画の順序で返す
+func (p *Plan) ResourceIds(resourceType string, op Operation) []string {
+	var ids []string
+	for _, a := range p.Actions {
+		if a.ResourceType == resourceType && a.Operation == op {
+			ids = append(ids, a.ResourceId)
+		}
+	}
+	return ids
+}
+
+// MaxRisk は計画に含まれるアクションの最大の危険度を返す
+func (p *Plan) MaxRisk() RiskLevel {
+	max := RiskLow
+	for _, a := range p.Actions {
+		if riskRank(a.Risk) > riskRank(max) {
+			max = a.Risk
+		}
+	}
+	return max
+}
+
+// Render は計画を表形式（または --output で指定した形式）で表示する
+func (p *Plan) Render() error {
+	if IsMachineReadable() {
+		return RenderRecord(p)
+	}
+	return DisplayList(p.Actions, i18n.T("plan.title"), planToTableData, &DisplayOptions{
+		ShowCount:    true,
+		EmptyMessage: i18n.T("plan.empty"),
+	})
+}
+
+// Save は計画をJSONファイルとして保存する
+func (p *Plan) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf(i18n.T("plan.json_error"), err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf(i18n.T("plan.write_error"), path, err)
+	}
+	return nil
+}
+
+// LoadPlan は保存された計画ファイルを読み込む
+func LoadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("plan.read_error"), path, err)
+	}
+	var plan Plan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf(i18n.T("plan.parse_error"), path, err)
+	}
+	if plan.Version != PlanVersion {
+		return nil, fmt.Errorf(i18n.T("plan.unsupported_version"), plan.Version, PlanVersion)
+	}
+	for i, a := range plan.Actions {
+		if a.ResourceType == "" || a.ResourceId == "" || a.Operation == "" {
+			return nil, fmt.Errorf(i18n.T("plan.invalid_action"), i+1)
+		}
+	}
+	return &plan, nil
+}
+
+// ConfirmPlan は計画の実行可否をユーザーに確認する
+func ConfirmPlan(p *Plan) bool {
+	return picker.Confirm(i18n.Tf("plan.confirm", len(p.Actions), p.MaxRisk()))
+}
+
+// planToTableData は計画のアクションを表形式のデータに変換する
+func planToTableData(actions []Action) ([]TableColumn, [][]string) {
+	columns := []TableColumn{
+		{Header: i18n.T("header.operation")},
+		{Header: i18n.T("header.resource_type")},
+		{Header: i18n.T("header.resource_id")},
+		{Header: i18n.T("header.risk")},
+		{Header: i18n.T("header.detail")},
+	}
+	data := make([][]string, len(actions))
+	for i, a := range actions {
+		data[i] = []string{string(a.Operation), a.ResourceType, a.ResourceId, riskWithEmoji(a.Risk), a.Detail}
+	}
+	return columns, data
+}
+
+// riskWithEmoji は危険度に絵文字を付与する
+func riskWithEmoji(risk RiskLevel) string {
+	switch risk {
+	case RiskHigh:
+		return "🔴 " + string(risk)
+	case RiskMedium:
+		return "🟡 " + string(risk)
+	default:
+		return "🟢 " + string(risk)
+	}
+}
+
+// riskRank は危険度を比較用の数値に変換する
+func riskRank(risk RiskLevel) int {
+	switch risk {
+	case RiskHigh:
+		return 2
+	case RiskMedium:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPlanSaveAndLoad(t *testing.T) {
+	plan := NewPlan("awstk s3 cleanup")
+	plan.Profile = "dev"
+	plan.Region = "ap-northeast-1"
+	plan.Add(
+		Action{ResourceType: ResourceS3Bucket, ResourceId: "dev-assets", Operation: OperationDelete, Risk: RiskHigh},
+		Action{ResourceType: ResourceEc2Instance, ResourceId: "i-0123", Operation: OperationStop, Risk: RiskMedium},
+	)
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	if err := plan.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got, err := LoadPlan(path)
+	if err != nil {
+		t.Fatalf("LoadPlan() error = %v", err)
+	}
+	if got.Command != plan.Command || got.Profile != plan.Profile || got.Region != plan.Region {
+		t.Errorf("LoadPlan() = %+v, want %+v", got, plan)
+	}
+	if !got.CreatedAt.Equal(plan.CreatedAt) {
+		t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, plan.CreatedAt)
+	}
+	if !reflect.DeepEqual(got.Actions, plan.Actions) {
+		t.Errorf("Actions = %+v, want %+v", got.Actions, plan.Actions)
+	}
+	if ids := got.ResourceIds(ResourceS3Bucket, OperationDelete); !reflect.DeepEqual(ids, []string{"dev-assets"}) {
+		t.Errorf("ResourceIds() = %v", ids)
+	}
+	if risk := got.MaxRisk(); risk != RiskHigh {
+		t.Errorf("MaxRisk() = %s, want %s", risk, RiskHigh)
+	}
+}
+
+func TestLoadPlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "JSONとして不正", body: "{"},
+		{name: "未対応のバージョン", body: `{"version": 99, "actions": []}`},
+		{name: "リソースIDのないアクション", body: `{"version": 1, "actions": [{"resourceType": "AWS::S3::Bucket", "operation": "delete"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "plan.json")
+			if err := os.WriteFile(path, []byte(tt.body), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadPlan(path); err == nil {
+				t.Fatal("LoadPlan() error = nil, want error")
+			}
+		})
+	}
+}
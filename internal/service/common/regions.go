@@ -0,0 +1,124 @@
+package common
+
+import (
+	"awstk/internal/i18n"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxRegionWorkers はリージョン横断取得の同時実行数
+const MaxRegionWorkers = 8
+
+// RegionResult はリージョンごとの取得結果
+type RegionResult[T any] struct {
+	Region string
+	Items  []T
+	Err    error
+}
+
+// RegionalRecord は取得元のリージョン名を付与したレコード
+// 機械可読形式では Item のフィールドに region を加えたフラットなレコードとして出力される
+type RegionalRecord[T any] struct {
+	Region string
+	Item   T
+}
+
+// FetchRegions は各リージョンに対して fetch を並列実行し、regions と同じ順序で結果を返します
+func FetchRegions[T any](ctx context.Context, regions []string, fetch func(ctx context.Context, region string) ([]T, error)) []RegionResult[T] {
+	runResults := Run(ctx, regions, fetch, &RunOptions{Concurrency: MaxRegionWorkers})
+	results := make([]RegionResult[T], len(regions))
+	for i, r := range runResults {
+		results[i] = RegionResult[T]{Region: regions[i], Items: r.Value, Err: r.Err}
+	}
+	return results
+}
+
+// MergeRegionResults は成功したリージョンの結果を結合します
+// 失敗したリージョン（オプトイン未完了・SCPによる拒否など）は警告として表示し、
+// すべてのリージョンで失敗した場合のみエラーを返します
+func MergeRegionResults[T any](results []RegionResult[T]) ([]RegionalRecord[T], error) {
+	var records []RegionalRecord[T]
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			Warnf(i18n.T("region.fetch_skipped"), WarningIcon, r.Region, r.Err)
+			errs = append(errs, fmt.Errorf("%s: %w", r.Region, r.Err))
+			continue
+		}
+		for _, item := range r.Items {
+			records = append(records, RegionalRecord[T]{Region: r.Region, Item: item})
+		}
+	}
+	if len(results) > 0 && len(errs) == len(results) {
+		return nil, fmt.Errorf(i18n.T("region.all_failed"), ErrorIcon, errors.Join(errs...))
+	}
+	return records, nil
+}
+
+// DisplayRegionalList はリージョン横断の取得結果をリージョン列付きの1つのテーブルとして表示します
+// toTableData はアイテム1件につき1行を返す必要があります
+func DisplayRegionalList[T any](
+	results []RegionResult[T],
+	title string,
+	toTableData func([]T) ([]TableColumn, [][]string),
+	opts *DisplayOptions,
+) error {
+	records, err := MergeRegionResults(results)
+	if err != nil {
+		return err
+	}
+
+	return DisplayList(
+		records,
+		title,
+		func(records []RegionalRecord[T]) ([]TableColumn, [][]string) {
+			items := make([]T, len(records))
+			for i, r := range records {
+				items[i] = r.Item
+			}
+			columns, data := toTableData(items)
+			columns = append([]TableColumn{{Header: i18n.T("header.region")}}, columns...)
+			for i := range data {
+				data[i] = append([]string{records[i].Region}, data[i]...)
+			}
+			return columns, data
+		},
+		opts,
+	)
+}
+
+// MarshalJSON は region を先頭に加えた Item のフィールドをフラットに出力します
+// キー名は他のレコードの json タグと揃えて小文字で始め、Item がオブジェクトでない場合は {"region": ..., "item": ...} として出力します
+func (r RegionalRecord[T]) MarshalJSON() ([]byte, error) {
+	region, err := json.Marshal(r.Region)
+	if err != nil {
+		return nil, err
+	}
+	item, err := json.Marshal(r.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"region":`)
+	buf.Write(region)
+	switch {
+	case bytes.Equal(item, []byte("{}")):
+	case len(item) > 0 && item[0] == '{':
+		buf.WriteByte(',')
+		buf.Write(item[1 : len(item)-1])
+	default:
+		buf.WriteString(`,"item":`)
+		buf.Write(item)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// regionalItem はCSV/TSV出力時に region 列を先頭に展開するためのメソッド
+func (r RegionalRecord[T]) regionalItem() (string, any) {
+	return r.Region, r.Item
+}
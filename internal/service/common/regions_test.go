@@ -0,0 +1,121 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type regionTestItem struct {
+	Name  string
+	Count int
+}
+
+func TestFetchRegions(t *testing.T) {
+	regions := []string{"ap-northeast-1", "us-east-1", "eu-west-1"}
+	// 後ろのリージョンほど早く完了させても regions の順序で返ること
+	delays := map[string]time.Duration{
+		"ap-northeast-1": 30 * time.Millisecond,
+		"us-east-1":      10 * time.Millisecond,
+		"eu-west-1":      0,
+	}
+
+	results := FetchRegions(t.Context(), regions, func(ctx context.Context, region string) ([]regionTestItem, error) {
+		time.Sleep(delays[region])
+		if region == "us-east-1" {
+			return nil, errors.New("AccessDenied")
+		}
+		return []regionTestItem{{Name: region}}, nil
+	})
+
+	if len(results) != len(regions) {
+		t.Fatalf("len(results) = %d, want %d", len(results), len(regions))
+	}
+	for i, r := range results {
+		if r.Region != regions[i] {
+			t.Errorf("results[%d].Region = %q, want %q", i, r.Region, regions[i])
+		}
+	}
+	if results[1].Err == nil {
+		t.Error("us-east-1 のエラーが返されていません")
+	}
+}
+
+func TestMergeRegionResults(t *testing.T) {
+	tests := []struct {
+		name        string
+		results     []RegionResult[regionTestItem]
+		wantRegions []string
+		wantErr     bool
+	}{
+		{
+			name: "一部リージョンの失敗は警告としてスキップ",
+			results: []RegionResult[regionTestItem]{
+				{Region: "ap-northeast-1", Items: []regionTestItem{{Name: "a"}, {Name: "b"}}},
+				{Region: "me-south-1", Err: errors.New("OptInRequired")},
+				{Region: "us-east-1", Items: []regionTestItem{{Name: "c"}}},
+			},
+			wantRegions: []string{"ap-northeast-1", "ap-northeast-1", "us-east-1"},
+		},
+		{
+			name: "全リージョン失敗はエラー",
+			results: []RegionResult[regionTestItem]{
+				{Region: "ap-northeast-1", Err: errors.New("AccessDenied")},
+				{Region: "us-east-1", Err: errors.New("AccessDenied")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "結果が空でもエラーにしない",
+			results: []RegionResult[regionTestItem]{
+				{Region: "ap-northeast-1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := MergeRegionResults(tt.results)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MergeRegionResults() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(records) != len(tt.wantRegions) {
+				t.Fatalf("len(records) = %d, want %d", len(records), len(tt.wantRegions))
+			}
+			for i, r := range records {
+				if r.Region != tt.wantRegions[i] {
+					t.Errorf("records[%d].Region = %q, want %q", i, r.Region, tt.wantRegions[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRegionalRecordOutput(t *testing.T) {
+	records := []RegionalRecord[regionTestItem]{
+		{Region: "us-east-1", Item: regionTestItem{Name: "x", Count: 2}},
+	}
+
+	tests := []struct {
+		format OutputFormat
+		want   string
+	}{
+		{format: OutputFormatJson, want: `"region": "us-east-1",` + "\n    \"Name\": \"x\""},
+		{format: OutputFormatCsv, want: "region,Name,Count\nus-east-1,x,2\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteRecords(&buf, tt.format, records); err != nil {
+				t.Fatalf("WriteRecords() error = %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.want) {
+				t.Errorf("出力に %q が含まれていません:\n%s", tt.want, buf.String())
+			}
+		})
+	}
+}
@@ -1,55 +1,85 @@
 package ec2
 
 import (
-	"bufio"
+	"awstk/internal/i18n"
 	"context"
 	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"slices"
 
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"awstk/internal/service/cfn"
 	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
+	"awstk/internal/ui/picker"
 )
 
 // ListEc2Instances cmdから呼ばれるメイン関数（Get + Display）
-func ListEc2Instances(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) error {
+func ListEc2Instances(ctx context.Context, clients ClientSet, opts ListOptions) error {
 	// Get: データ取得
-	instances, err := getEc2Instances(ec2Client, cfnClient, stackName)
+	instances, err := getEc2Instances(ctx, clients, opts)
 	if err != nil {
-		if stackName != "" {
+		if opts.StackName != "" {
 			return fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
 		}
-		return common.FormatListError("EC2インスタンス", err)
+		return common.FormatListError(i18n.T("resource.ec2_instance"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		instances,
-		"EC2インスタンス一覧",
+		common.GenerateFilteredTitle(i18n.T("resource.ec2_instance")),
 		ec2InstancesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "EC2インスタンスが見つかりませんでした",
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.ec2_instance")),
+		},
+	)
+}
+
+// ListEc2InstancesInRegions 複数リージョンのEC2インスタンスを並列取得し、リージョン列付きで表示
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListEc2InstancesInRegions(ctx context.Context, regions []string, clientsFor func(region string) ClientSet, opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Instance, error) {
+		return getEc2Instances(ctx, clientsFor(region), opts)
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.ec2_instance")),
+		ec2InstancesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.ec2_instance")),
 		},
 	)
 }
 
 // getEc2Instances データ取得内部関数
-func getEc2Instances(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	if stackName != "" {
-		return getEc2InstancesByStackName(ec2Client, cfnClient, stackName)
+func getEc2Instances(ctx context.Context, clients ClientSet, opts ListOptions) ([]Instance, error) {
+	var instances []Instance
+	var err error
+	if opts.StackName != "" {
+		instances, err = getEc2InstancesByStackName(ctx, clients.Ec2Client, clients.CfnClient, opts.StackName)
+	} else {
+		instances, err = GetAllEc2Instances(ctx, clients.Ec2Client)
+	}
+	if err != nil || len(opts.Tags) == 0 {
+		return instances, err
+	}
+
+	// タグで絞り込む
+	ids, err := opts.Tags.Names(ctx, clients.TagClient, tagging.ResourceEc2Instance)
+	if err != nil {
+		return nil, err
 	}
-	return getAllEc2Instances(ec2Client)
+	return slices.DeleteFunc(instances, func(ins Instance) bool { return !slices.Contains(ids, ins.InstanceId) }), nil
 }
 
-// getAllEc2Instances 現在のリージョンの全EC2インスタンスを取得
-func getAllEc2Instances(ec2Client *ec2.Client) ([]Instance, error) {
-	result, err := ec2Client.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{})
+// GetAllEc2Instances 現在のリージョンの全EC2インスタンスを取得
+func GetAllEc2Instances(ctx context.Context, ec2Client API) ([]Instance, error) {
+	result, err := ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{})
 	if err != nil {
 		return nil, fmt.Errorf("EC2インスタンス一覧の取得に失敗: %w", err)
 	}
@@ -83,8 +113,8 @@ func getAllEc2Instances(ec2Client *ec2.Client) ([]Instance, error) {
 }
 
 // getEc2InstancesByStackName 指定されたCloudFormationスタック名でフィルタリングしたEC2インスタンス一覧を取得
-func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	ids, err := cfn.GetAllEc2FromStack(cfnClient, stackName)
+func getEc2InstancesByStackName(ctx context.Context, ec2Client API, cfnClient cfn.API, stackName string) ([]Instance, error) {
+	ids, err := cfn.GetAllEc2FromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +123,7 @@ func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation
 		return []Instance{}, nil
 	}
 
-	all, err := getAllEc2Instances(ec2Client)
+	all, err := GetAllEc2Instances(ctx, ec2Client)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +146,9 @@ func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation
 // ec2InstancesToTableData EC2インスタンス情報をテーブルデータに変換
 func ec2InstancesToTableData(instances []Instance) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "インスタンスID"},
-		{Header: "インスタンス名"},
-		{Header: "状態"},
+		{Header: i18n.T("header.instance_id")},
+		{Header: i18n.T("header.instance_name")},
+		{Header: i18n.T("header.state")},
 	}
 
 	data := make([][]string, len(instances))
@@ -133,63 +163,30 @@ func ec2InstancesToTableData(instances []Instance) ([]common.TableColumn, [][]st
 }
 
 // SelectInstanceInteractively EC2インスタンス一覧を表示してユーザーに選択させる
-func SelectInstanceInteractively(ec2Client *ec2.Client) (string, error) {
-	fmt.Println("EC2インスタンス一覧を取得中...")
-
-	instances, err := getAllEc2Instances(ec2Client)
+func SelectInstanceInteractively(ctx context.Context, ec2Client API) (string, error) {
+	instances, err := GetAllEc2Instances(ctx, ec2Client)
 	if err != nil {
 		return "", fmt.Errorf("❌ EC2インスタンス一覧の取得に失敗: %w", err)
 	}
-
 	if len(instances) == 0 {
-		return "", fmt.Errorf("❌ 利用可能なEC2インスタンスが見つかりません")
+		return "", common.NotFoundf("❌ 利用可能なEC2インスタンスが見つかりません")
 	}
 
-	// インスタンス一覧を表示
-	fmt.Println("\n📋 利用可能なEC2インスタンス:")
-
-	columns := []common.TableColumn{
-		{Header: "番号"},
-		{Header: "インスタンスID"},
-		{Header: "インスタンス名"},
-		{Header: "状態"},
-	}
-
-	data := make([][]string, len(instances))
-	for i, instance := range instances {
-		data[i] = []string{
-			fmt.Sprintf("%d", i+1),
-			instance.InstanceId,
-			instance.InstanceName,
-			instance.State,
+	items := make([]picker.Item, len(instances))
+	for i, ins := range instances {
+		items[i] = picker.Item{
+			Label:  ins.InstanceName,
+			Detail: fmt.Sprintf("%s (%s)", ins.InstanceId, ins.State),
+			Value:  ins.InstanceId,
 		}
 	}
-
-	common.PrintTable("EC2インスタンス一覧", columns, data)
-
-	// ユーザーに選択させる
-	fmt.Print("\n操作するインスタンスの番号を入力してください: ")
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
-	if err != nil {
-		return "", fmt.Errorf("❌ 入力の読み取りに失敗: %w", err)
-	}
-
-	// 入力を数値に変換
-	input = strings.TrimSpace(input)
-	selectedNum, err := strconv.Atoi(input)
+	instanceId, err := picker.Select(items, &picker.Options{
+		Prompt: "操作するEC2インスタンスを選択してください",
+		Hint:   "-i",
+	})
 	if err != nil {
-		return "", fmt.Errorf("❌ 無効な番号です: %s", input)
+		return "", err
 	}
-
-	// 範囲チェック
-	if selectedNum < 1 || selectedNum > len(instances) {
-		return "", fmt.Errorf("❌ 番号は1から%dの間で入力してください", len(instances))
-	}
-
-	selectedInstance := instances[selectedNum-1]
-	fmt.Printf("✅ 選択されたインスタンス: %s (%s)\n",
-		selectedInstance.InstanceName, selectedInstance.InstanceId)
-
-	return selectedInstance.InstanceId, nil
+	common.Progressf("✅ 選択されたインスタンス: %s\n", instanceId)
+	return instanceId, nil
 }
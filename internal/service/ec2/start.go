@@ -8,12 +8,12 @@ import (
 )
 
 // StartEc2Instance はEC2インスタンスを起動します
-func StartEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func StartEc2Instance(ctx context.Context, ec2Client API, instanceId string) error {
 	input := &ec2.StartInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StartInstances(context.Background(), input)
+	_, err := ec2Client.StartInstances(ctx, input)
 	if err != nil {
 		return fmt.Errorf("EC2インスタンス起動エラー: %w", err)
 	}
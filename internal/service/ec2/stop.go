@@ -8,12 +8,12 @@ import (
 )
 
 // StopEc2Instance はEC2インスタンスを停止します
-func StopEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func StopEc2Instance(ctx context.Context, ec2Client API, instanceId string) error {
 	input := &ec2.StopInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StopInstances(context.Background(), input)
+	_, err := ec2Client.StopInstances(ctx, input)
 	if err != nil {
 		return fmt.Errorf("EC2インスタンス停止エラー: %w", err)
 	}
@@ -0,0 +1,45 @@
+package ec2
+
+import (
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var _ API = (*fakeaws.Ec2)(nil)
+
+func TestStopEc2Instance(t *testing.T) {
+	tests := []struct {
+		name       string
+		instanceId string
+		wantErr    bool
+		wantState  types.InstanceStateName
+	}{
+		{
+			name:       "起動中のインスタンスを停止",
+			instanceId: "i-0123",
+			wantState:  types.InstanceStateNameStopping,
+		},
+		{
+			name:       "存在しないインスタンスはエラー",
+			instanceId: "i-missing",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewEc2(map[string]types.InstanceStateName{"i-0123": types.InstanceStateNameRunning})
+
+			err := StopEc2Instance(t.Context(), fake, tt.instanceId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StopEc2Instance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := fake.State(tt.instanceId); got != tt.wantState {
+				t.Errorf("state = %q, want %q", got, tt.wantState)
+			}
+		})
+	}
+}
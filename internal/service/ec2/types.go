@@ -1,14 +1,31 @@
 package ec2
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/tagging"
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
+// API はec2パッケージが利用するEC2 APIのインターフェース
+type API interface {
+	DescribeInstances(ctx context.Context, params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
+	StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+}
+
 // ClientSet はEC2関連の操作に必要なクライアントをまとめた構造体
 type ClientSet struct {
-	Ec2Client *ec2.Client
-	CfnClient *cloudformation.Client
+	Ec2Client API
+	CfnClient cfn.API
+	TagClient tagging.API
+}
+
+// ListOptions はEC2インスタンス一覧取得のオプション
+type ListOptions struct {
+	StackName string           // CloudFormationスタック名で絞り込む
+	Tags      tagging.Selector // タグで絞り込む
 }
 
 // Instance Ec2Instance EC2インスタンスの情報を格納する構造体
@@ -4,21 +4,20 @@ import (
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
-	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 )
 
 // GetEcrRepositoriesByFilter はフィルターに一致するECRリポジトリ名の一覧を取得します
-func GetEcrRepositoriesByFilter(ecrClient *ecr.Client, searchString string) ([]string, error) {
+func GetEcrRepositoriesByFilter(ctx context.Context, ecrClient API, searchString string) ([]string, error) {
 	// リポジトリ一覧を取得
 	listReposInput := &ecr.DescribeRepositoriesInput{}
 	foundRepos := []string{}
 
 	// ページネーション対応
 	for {
-		listReposOutput, err := ecrClient.DescribeRepositories(context.Background(), listReposInput)
+		listReposOutput, err := ecrClient.DescribeRepositories(ctx, listReposInput)
 		if err != nil {
 			return nil, fmt.Errorf("ecrリポジトリ一覧取得エラー: %w", err)
 		}
@@ -26,7 +25,7 @@ func GetEcrRepositoriesByFilter(ecrClient *ecr.Client, searchString string) ([]s
 		for _, repo := range listReposOutput.Repositories {
 			if common.MatchesFilter(*repo.RepositoryName, searchString) {
 				foundRepos = append(foundRepos, *repo.RepositoryName)
-				fmt.Printf("🔍 検出されたECRリポジトリ: %s\n", *repo.RepositoryName)
+				common.Progressf("🔍 検出されたECRリポジトリ: %s\n", *repo.RepositoryName)
 			}
 		}
 
@@ -39,76 +38,72 @@ func GetEcrRepositoriesByFilter(ecrClient *ecr.Client, searchString string) ([]s
 	return foundRepos, nil
 }
 
+// CleanupActions はECRリポジトリ削除の実行計画アクションを生成します
+func CleanupActions(repoNames []string) []common.Action {
+	actions := make([]common.Action, 0, len(repoNames))
+	for _, name := range repoNames {
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceEcrRepository,
+			ResourceId:   name,
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+			Detail:       "イメージごと強制削除",
+		})
+	}
+	return actions
+}
+
 // CleanupEcrRepositories は指定したECRリポジトリ一覧を削除します
-func CleanupEcrRepositories(ecrClient *ecr.Client, repoNames []string) error {
+func CleanupEcrRepositories(ctx context.Context, ecrClient API, repoNames []string) error {
 	if len(repoNames) == 0 {
 		return nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(repoNames) < maxWorkers {
-		maxWorkers = len(repoNames)
-	}
+	maxWorkers := min(common.ResolveConcurrency(common.DefaultConcurrency), len(repoNames))
+	common.Progressf("🚀 %d個のリポジトリを最大%d並列で削除します...\n\n", len(repoNames), maxWorkers)
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(repoNames))
-	resultsMutex := &sync.Mutex{}
-
-	fmt.Printf("🚀 %d個のリポジトリを最大%d並列で削除します...\n\n", len(repoNames), maxWorkers)
-
-	for i, repoName := range repoNames {
-		idx := i
-		repo := repoName
-		executor.Execute(func() {
-			fmt.Printf("リポジトリ %s を削除中...\n", repo)
-
-			// リポジトリの削除（強制削除フラグで内部のイメージも含めて削除）
-			_, err := ecrClient.DeleteRepository(context.Background(), &ecr.DeleteRepositoryInput{
-				RepositoryName: aws.String(repo),
-				Force:          true, // 強制削除（イメージが残っていても削除）
-			})
-
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ リポジトリ %s の削除に失敗しました: %v\n", repo, err)
-				results[idx] = common.ProcessResult{Item: repo, Success: false, Error: err}
-			} else {
-				fmt.Printf("✅ リポジトリ %s を削除しました\n", repo)
-				results[idx] = common.ProcessResult{Item: repo, Success: true}
-			}
-			resultsMutex.Unlock()
+	results := common.Run(ctx, repoNames, func(ctx context.Context, repo string) (struct{}, error) {
+		// リポジトリの削除（強制削除フラグで内部のイメージも含めて削除）
+		_, err := ecrClient.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+			RepositoryName: aws.String(repo),
+			Force:          true, // 強制削除（イメージが残っていても削除）
 		})
+		return struct{}{}, err
+	}, &common.RunOptions{Progress: "リポジトリ削除中"})
+
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ リポジトリ %s の削除に失敗しました: %v\n", repoNames[i], r.Err)
+		} else {
+			common.Progressf("✅ リポジトリ %s を削除しました\n", repoNames[i])
+		}
 	}
 
-	executor.Wait()
-
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	successCount, failCount := common.CountResults(results)
+	common.Progressf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
 
-	return nil
+	return common.CollectFailures("ECRリポジトリの削除", repoNames, results)
 }
 
 // CleanupRepositoriesByFilter はフィルターに基づいてリポジトリを削除する
-func CleanupRepositoriesByFilter(ecrClient *ecr.Client, filter string) error {
+func CleanupRepositoriesByFilter(ctx context.Context, ecrClient API, filter string) error {
 	// フィルターに一致するリポジトリを取得
-	repositories, err := GetEcrRepositoriesByFilter(ecrClient, filter)
+	repositories, err := GetEcrRepositoriesByFilter(ctx, ecrClient, filter)
 	if err != nil {
 		return fmt.Errorf("❌ ECRリポジトリ一覧取得エラー: %w", err)
 	}
 
 	if len(repositories) == 0 {
-		fmt.Printf("フィルター '%s' に一致するECRリポジトリが見つかりませんでした\n", filter)
-		return nil
+		return common.NotFoundf("フィルター '%s' に一致するECRリポジトリが見つかりませんでした", filter)
 	}
 
 	// リポジトリを削除
-	err = CleanupEcrRepositories(ecrClient, repositories)
+	err = CleanupEcrRepositories(ctx, ecrClient, repositories)
 	if err != nil {
 		return fmt.Errorf("❌ ECRリポジトリ削除エラー: %w", err)
 	}
 
-	fmt.Println("✅ ECRリポジトリの削除が完了しました")
+	common.Progressln("✅ ECRリポジトリの削除が完了しました")
 	return nil
 }
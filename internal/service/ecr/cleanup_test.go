@@ -0,0 +1,86 @@
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+)
+
+var _ API = (*fakeaws.Ecr)(nil)
+
+func TestCleanupRepositoriesByFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		filter     string
+		pageSize   int
+		failId     string
+		wantExit   int // common.ExitCode(err) の期待値
+		wantExists map[string]bool
+	}{
+		{
+			name:   "イメージを含むリポジトリも強制削除",
+			filter: "dev-",
+			wantExists: map[string]bool{
+				"dev-api":  false,
+				"dev-web":  false,
+				"prod-api": true,
+			},
+		},
+		{
+			name:     "ページネーションをまたいで検索",
+			filter:   "api",
+			pageSize: 1,
+			wantExists: map[string]bool{
+				"dev-api":  false,
+				"dev-web":  true,
+				"prod-api": false,
+			},
+		},
+		{
+			name:     "削除に失敗したリポジトリは残る",
+			filter:   "dev-",
+			failId:   "dev-web",
+			wantExit: common.ExitPartialFailure,
+			wantExists: map[string]bool{
+				"dev-api":  false,
+				"dev-web":  true,
+				"prod-api": true,
+			},
+		},
+		{
+			name:     "一致するリポジトリがなければ対象なし",
+			filter:   "staging",
+			wantExit: common.ExitNotFound,
+			wantExists: map[string]bool{
+				"dev-api": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewEcr(
+				&fakeaws.Repository{Name: "dev-api", ImageCount: 3},
+				&fakeaws.Repository{Name: "dev-web"},
+				&fakeaws.Repository{Name: "prod-api", ImageCount: 1},
+			)
+			fake.PageSize = tt.pageSize
+			if tt.failId != "" {
+				fake.Fail("DeleteRepository", tt.failId, errors.New("access denied"))
+			}
+
+			err := CleanupRepositoriesByFilter(t.Context(), fake, tt.filter)
+			if got := common.ExitCode(err); got != tt.wantExit {
+				t.Errorf("ExitCode(CleanupRepositoriesByFilter()) = %d, want %d (error = %v)", got, tt.wantExit, err)
+			}
+
+			for name, want := range tt.wantExists {
+				if got := fake.HasRepository(name); got != want {
+					t.Errorf("repository %s exists = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package ecr
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"errors"
@@ -12,7 +13,7 @@ import (
 )
 
 // ListEcrRepositories はECRリポジトリの一覧を取得する関数
-func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
+func ListEcrRepositories(ctx context.Context, client API) ([]RepositoryInfo, error) {
 	var repositories []RepositoryInfo
 	var nextToken *string
 
@@ -21,7 +22,7 @@ func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
 			NextToken: nextToken,
 		}
 
-		result, err := client.DescribeRepositories(context.Background(), input)
+		result, err := client.DescribeRepositories(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("リポジトリ一覧取得エラー: %w", err)
 		}
@@ -47,20 +48,20 @@ func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
 }
 
 // GetRepositoryImageCount はリポジトリ内のイメージ数を取得する関数
-func GetRepositoryImageCount(client *ecr.Client, repoName string) (int, error) {
+func GetRepositoryImageCount(ctx context.Context, client API, repoName string) (int, error) {
 	input := &ecr.DescribeImagesInput{
 		RepositoryName: aws.String(repoName),
 		MaxResults:     aws.Int32(1), // カウントだけ必要なので最小限に
 	}
 
-	result, err := client.DescribeImages(context.Background(), input)
+	result, err := client.DescribeImages(ctx, input)
 	if err != nil {
 		return 0, err
 	}
 
 	// NextTokenがある場合は、全件取得してカウント
 	if result.NextToken != nil {
-		imageDetails, err := getRepositoryImageDetails(client, repoName)
+		imageDetails, err := getRepositoryImageDetails(ctx, client, repoName)
 		if err != nil {
 			return 0, err
 		}
@@ -71,7 +72,7 @@ func GetRepositoryImageCount(client *ecr.Client, repoName string) (int, error) {
 }
 
 // getRepositoryImageDetails はリポジトリ内のイメージ詳細を取得する関数
-func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.ImageDetail, error) {
+func getRepositoryImageDetails(ctx context.Context, client API, repoName string) ([]types.ImageDetail, error) {
 	var imageDetails []types.ImageDetail
 	var nextToken *string
 
@@ -81,7 +82,7 @@ func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.Ima
 			NextToken:      nextToken,
 		}
 
-		result, err := client.DescribeImages(context.Background(), input)
+		result, err := client.DescribeImages(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -98,11 +99,11 @@ func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.Ima
 }
 
 // FilterEmptyRepositories は空のリポジトリのみを返す関数
-func FilterEmptyRepositories(client *ecr.Client, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
+func FilterEmptyRepositories(ctx context.Context, client API, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
 	var emptyRepos []RepositoryInfo
 
 	for _, repo := range repositories {
-		imageCount, err := GetRepositoryImageCount(client, repo.RepositoryName)
+		imageCount, err := GetRepositoryImageCount(ctx, client, repo.RepositoryName)
 		if err != nil {
 			return nil, fmt.Errorf("イメージ数取得エラー (%s): %w", repo.RepositoryName, err)
 		}
@@ -117,11 +118,11 @@ func FilterEmptyRepositories(client *ecr.Client, repositories []RepositoryInfo)
 }
 
 // FilterNoLifecycleRepositories はライフサイクルポリシーが未設定のリポジトリのみを返す関数
-func FilterNoLifecycleRepositories(client *ecr.Client, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
+func FilterNoLifecycleRepositories(ctx context.Context, client API, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
 	var noLifecycleRepos []RepositoryInfo
 
 	for _, repo := range repositories {
-		hasLifecycle, err := CheckLifecyclePolicy(client, repo.RepositoryName)
+		hasLifecycle, err := CheckLifecyclePolicy(ctx, client, repo.RepositoryName)
 		if err != nil {
 			return nil, fmt.Errorf("ライフサイクルポリシー確認エラー (%s): %w", repo.RepositoryName, err)
 		}
@@ -136,8 +137,8 @@ func FilterNoLifecycleRepositories(client *ecr.Client, repositories []Repository
 }
 
 // CheckLifecyclePolicy はリポジトリにライフサイクルポリシーが設定されているか確認する関数
-func CheckLifecyclePolicy(client *ecr.Client, repoName string) (bool, error) {
-	_, err := client.GetLifecyclePolicy(context.Background(), &ecr.GetLifecyclePolicyInput{
+func CheckLifecyclePolicy(ctx context.Context, client API, repoName string) (bool, error) {
+	_, err := client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
 		RepositoryName: aws.String(repoName),
 	})
 
@@ -153,9 +154,9 @@ func CheckLifecyclePolicy(client *ecr.Client, repoName string) (bool, error) {
 }
 
 // EnrichRepositoryDetails はリポジトリの詳細情報を取得して追加する関数
-func EnrichRepositoryDetails(client *ecr.Client, repo *RepositoryInfo) error {
+func EnrichRepositoryDetails(ctx context.Context, client API, repo *RepositoryInfo) error {
 	// イメージ詳細を取得
-	imageDetails, err := getRepositoryImageDetails(client, repo.RepositoryName)
+	imageDetails, err := getRepositoryImageDetails(ctx, client, repo.RepositoryName)
 	if err != nil {
 		return fmt.Errorf("イメージ詳細取得エラー: %w", err)
 	}
@@ -169,7 +170,7 @@ func EnrichRepositoryDetails(client *ecr.Client, repo *RepositoryInfo) error {
 	}
 
 	// ライフサイクルポリシーの有無を確認
-	repo.HasLifecycle, _ = CheckLifecyclePolicy(client, repo.RepositoryName)
+	repo.HasLifecycle, _ = CheckLifecyclePolicy(ctx, client, repo.RepositoryName)
 
 	return nil
 }
@@ -193,39 +194,34 @@ func DisplayRepositoryDetails(repo RepositoryInfo) {
 }
 
 // ListRepositories はオプションに基づいてリポジトリ一覧を取得・表示する
-func ListRepositories(ecrClient *ecr.Client, opts ListOptions) error {
+func ListRepositories(ctx context.Context, ecrClient API, opts ListOptions) error {
 	// リポジトリ一覧を取得
-	repositories, err := ListEcrRepositories(ecrClient)
+	repositories, err := ListEcrRepositories(ctx, ecrClient)
 	if err != nil {
-		return common.FormatListError("ECRリポジトリ", err)
+		return common.FormatListError(i18n.T("resource.ecr_repository"), err)
 	}
 
-	if len(repositories) == 0 {
-		fmt.Println(common.FormatEmptyMessage("ECRリポジトリ"))
+	if len(repositories) == 0 && !common.IsMachineReadable() {
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.ecr_repository")))
 		return nil
 	}
 
 	// フィルタリング処理
-	filteredRepos := repositories
-	var conditions []string
-
-	if opts.EmptyOnly {
-		conditions = append(conditions, "空の")
-		filteredRepos, err = FilterEmptyRepositories(ecrClient, filteredRepos)
-		if err != nil {
-			return fmt.Errorf("❌ 空リポジトリチェックでエラー: %w", err)
-		}
+	filteredRepos, err := filterRepositories(ctx, ecrClient, repositories, opts)
+	if err != nil {
+		return err
 	}
+	conditions := filterConditions(opts)
 
-	if opts.NoLifecycle {
-		conditions = append(conditions, "ライフサイクルポリシー未設定の")
-		filteredRepos, err = FilterNoLifecycleRepositories(ecrClient, filteredRepos)
-		if err != nil {
-			return fmt.Errorf("❌ ライフサイクルポリシーチェックでエラー: %w", err)
+	// 機械可読形式の場合はリポジトリ情報をそのまま出力
+	if common.IsMachineReadable() {
+		if opts.ShowDetails {
+			enrichRepositories(ctx, ecrClient, filteredRepos)
 		}
+		return common.RenderRecords(filteredRepos)
 	}
 
-	title := common.GenerateFilteredTitle("ECRリポジトリ", conditions...)
+	title := common.GenerateFilteredTitle(i18n.T("resource.ecr_repository"), conditions...)
 
 	// 結果表示
 	if !opts.ShowDetails {
@@ -233,12 +229,118 @@ func ListRepositories(ecrClient *ecr.Client, opts ListOptions) error {
 		displaySimpleList(filteredRepos, title)
 	} else {
 		// 詳細表示
-		displayDetailedList(ecrClient, filteredRepos, title)
+		displayDetailedList(ctx, ecrClient, filteredRepos, title)
 	}
 
 	return nil
 }
 
+// ListRepositoriesInRegions は複数リージョンのリポジトリを並列取得し、リージョン列付きで表示する
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListRepositoriesInRegions(ctx context.Context, regions []string, clientFor func(region string) API, opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]RepositoryInfo, error) {
+		client := clientFor(region)
+		repositories, err := ListEcrRepositories(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		filtered, err := filterRepositories(ctx, client, repositories, opts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.ShowDetails {
+			enrichRepositories(ctx, client, filtered)
+		}
+		return filtered, nil
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.ecr_repository"), filterConditions(opts)...),
+		func(repos []RepositoryInfo) ([]common.TableColumn, [][]string) {
+			return repositoriesToTableData(repos, opts.ShowDetails)
+		},
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.ecr_repository")),
+		},
+	)
+}
+
+// filterRepositories はオプションに応じてリポジトリを絞り込む
+func filterRepositories(ctx context.Context, ecrClient API, repos []RepositoryInfo, opts ListOptions) ([]RepositoryInfo, error) {
+	var err error
+	if opts.EmptyOnly {
+		repos, err = FilterEmptyRepositories(ctx, ecrClient, repos)
+		if err != nil {
+			return nil, fmt.Errorf("❌ 空リポジトリチェックでエラー: %w", err)
+		}
+	}
+
+	if opts.NoLifecycle {
+		repos, err = FilterNoLifecycleRepositories(ctx, ecrClient, repos)
+		if err != nil {
+			return nil, fmt.Errorf("❌ ライフサイクルポリシーチェックでエラー: %w", err)
+		}
+	}
+	return repos, nil
+}
+
+// filterConditions はタイトルに表示する絞り込み条件を返す
+func filterConditions(opts ListOptions) []string {
+	var conditions []string
+	if opts.EmptyOnly {
+		conditions = append(conditions, i18n.T("condition.empty"))
+	}
+	if opts.NoLifecycle {
+		conditions = append(conditions, i18n.T("condition.no_lifecycle"))
+	}
+	return conditions
+}
+
+// repositoriesToTableData はリポジトリ情報をテーブルデータに変換する
+func repositoriesToTableData(repos []RepositoryInfo, showDetails bool) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.repository_name")},
+		{Header: "URI"},
+	}
+	if showDetails {
+		columns = append(columns,
+			common.TableColumn{Header: i18n.T("header.image_count")},
+			common.TableColumn{Header: i18n.T("header.size")},
+			common.TableColumn{Header: i18n.T("header.lifecycle_policy")},
+		)
+	}
+
+	data := make([][]string, len(repos))
+	for i, repo := range repos {
+		row := []string{repo.RepositoryName, repo.RepositoryUri}
+		if showDetails {
+			lifecycleStatus := "設定済み"
+			if !repo.HasLifecycle {
+				lifecycleStatus = "未設定"
+			}
+			row = append(row,
+				fmt.Sprintf("%d", repo.ImageCount),
+				common.FormatBytes(repo.SizeInBytes),
+				lifecycleStatus,
+			)
+		}
+		data[i] = row
+	}
+	return columns, data
+}
+
+// enrichRepositories はリポジトリ一覧の詳細情報をまとめて取得する
+// 取得に失敗したリポジトリは警告を出して基本情報のまま残す
+func enrichRepositories(ctx context.Context, ecrClient API, repos []RepositoryInfo) {
+	for i := range repos {
+		if err := EnrichRepositoryDetails(ctx, ecrClient, &repos[i]); err != nil {
+			common.Progressf("⚠️  %s の詳細取得エラー: %v\n", repos[i].RepositoryName, err)
+		}
+	}
+}
+
 // displaySimpleList はリポジトリ一覧をシンプル形式で表示
 func displaySimpleList(repos []RepositoryInfo, title string) {
 	names := make([]string, len(repos))
@@ -248,25 +350,25 @@ func displaySimpleList(repos []RepositoryInfo, title string) {
 	common.PrintSimpleList(common.ListOutput{
 		Title:        title,
 		Items:        names,
-		ResourceName: "リポジトリ",
+		ResourceName: i18n.T("resource.repository"),
 		ShowCount:    true,
 	})
 }
 
 // displayDetailedList はリポジトリ一覧を詳細形式で表示
-func displayDetailedList(ecrClient *ecr.Client, repos []RepositoryInfo, title string) {
+func displayDetailedList(ctx context.Context, ecrClient API, repos []RepositoryInfo, title string) {
 	fmt.Printf("%s:\n", title)
 	if len(repos) == 0 {
-		fmt.Println("該当するリポジトリはありませんでした")
+		fmt.Println(common.FormatNoMatchMessage(i18n.T("resource.repository")))
 		return
 	}
 
 	for i := range repos {
-		if err := EnrichRepositoryDetails(ecrClient, &repos[i]); err != nil {
+		if err := EnrichRepositoryDetails(ctx, ecrClient, &repos[i]); err != nil {
 			fmt.Printf("  - %s (詳細取得エラー: %v)\n", repos[i].RepositoryName, err)
 			continue
 		}
 		DisplayRepositoryDetails(repos[i])
 	}
-	fmt.Printf("\n合計: %d個のリポジトリ\n", len(repos))
+	fmt.Printf("\n%s\n", common.FormatTotalMessage(len(repos), i18n.T("resource.repository")))
 }
@@ -3,6 +3,7 @@ package ecs
 import (
 	awsCtx "awstk/internal/aws"
 	"awstk/internal/cli"
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
@@ -11,11 +12,11 @@ import (
 )
 
 // GetRunningTask 実行中のタスクを取得する
-func GetRunningTask(ecsClient *ecs.Client, clusterName, serviceName string) (string, error) {
-	fmt.Println("🔍 実行中のタスクを検索中...")
+func GetRunningTask(ctx context.Context, ecsClient API, clusterName, serviceName string) (string, error) {
+	common.Progressln("🔍 実行中のタスクを検索中...")
 
 	// タスク一覧を取得
-	taskList, err := ecsClient.ListTasks(context.Background(), &ecs.ListTasksInput{
+	taskList, err := ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
 		Cluster:     aws.String(clusterName),
 		ServiceName: aws.String(serviceName),
 	})
@@ -29,7 +30,7 @@ func GetRunningTask(ecsClient *ecs.Client, clusterName, serviceName string) (str
 
 	// 最初のタスクを使用
 	taskId := taskList.TaskArns[0]
-	fmt.Println("✅ 実行中のタスク '" + taskId + "' を検出しました")
+	common.Progressln("✅ 実行中のタスク '" + taskId + "' を検出しました")
 
 	return taskId, nil
 }
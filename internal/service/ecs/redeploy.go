@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"time"
@@ -11,8 +12,8 @@ import (
 )
 
 // ForceRedeployService はECSサービスを強制再デプロイします
-func ForceRedeployService(ecsClient *ecs.Client, clusterName, serviceName string) error {
-	fmt.Printf("🚀 ECSサービス '%s' を強制再デプロイします...\n", serviceName)
+func ForceRedeployService(ctx context.Context, ecsClient API, clusterName, serviceName string) error {
+	common.Progressf("🚀 ECSサービス '%s' を強制再デプロイします...\n", serviceName)
 
 	updateInput := &ecs.UpdateServiceInput{
 		Cluster:            aws.String(clusterName),
@@ -20,19 +21,19 @@ func ForceRedeployService(ecsClient *ecs.Client, clusterName, serviceName string
 		ForceNewDeployment: true,
 	}
 
-	_, err := ecsClient.UpdateService(context.Background(), updateInput)
+	_, err := ecsClient.UpdateService(ctx, updateInput)
 
 	if err != nil {
 		return fmt.Errorf("サービスの強制再デプロイに失敗しました: %w", err)
 	}
 
-	fmt.Println("✅ 強制再デプロイを開始しました")
+	common.Progressln("✅ 強制再デプロイを開始しました")
 	return nil
 }
 
 // WaitForDeploymentComplete はECSサービスのデプロイが完了するまで待機します
-func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions) error {
-	fmt.Println("⏳ デプロイ完了を待機しています...")
+func WaitForDeploymentComplete(ctx context.Context, ecsClient API, opts WaitDeploymentOptions) error {
+	common.Progressln("⏳ デプロイ完了を待機しています...")
 
 	start := time.Now()
 	timeout := time.Duration(opts.TimeoutSeconds) * time.Second
@@ -40,10 +41,14 @@ func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions
 	defer ticker.Stop()
 
 	for {
-		<-ticker.C
+		select {
+		case <-ctx.Done():
+			return common.WaitCanceled(ctx)
+		case <-ticker.C:
+		}
 
 		// サービスの詳細を取得
-		resp, err := ecsClient.DescribeServices(context.Background(), &ecs.DescribeServicesInput{
+		resp, err := ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
 			Cluster:  aws.String(opts.ClusterName),
 			Services: []string{opts.ServiceName},
 		})
@@ -76,18 +81,18 @@ func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions
 
 		// 経過時間と進捗状況を表示
 		elapsed := time.Since(start).Round(time.Second)
-		fmt.Printf("⏱️ 経過時間: %s - デプロイ状況: %s - 実行中タスク: %d / 希望タスク数: %d\n",
+		common.Progressf("⏱️ 経過時間: %s - デプロイ状況: %s - 実行中タスク: %d / 希望タスク数: %d\n",
 			elapsed, deploymentStatus, runningCount, desiredCount)
 
 		// デプロイ完了の確認
 		if deploymentStatus == "PRIMARY" && runningCount == desiredCount && desiredCount > 0 {
-			fmt.Println("✅ デプロイが完了しました")
+			common.Progressln("✅ デプロイが完了しました")
 			return nil
 		}
 
 		// タイムアウトのチェック
 		if time.Since(start) > timeout {
-			return fmt.Errorf("タイムアウト: %d秒経過しましたがデプロイは完了していません", opts.TimeoutSeconds)
+			return common.Timeoutf("タイムアウト: %d秒経過しましたがデプロイは完了していません", opts.TimeoutSeconds)
 		}
 	}
 }
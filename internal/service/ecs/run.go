@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"errors"
 	"fmt"
@@ -13,19 +14,23 @@ import (
 )
 
 // waitForTaskStopped はタスクが停止するまで待機し、コンテナの終了コードを返します
-func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error) {
-	fmt.Println("⏳ タスクの完了を待機中...")
+func waitForTaskStopped(ctx context.Context, ecsClient API, opts waitTaskOptions) (int, error) {
+	common.Progressln("⏳ タスクの完了を待機中...")
 
 	timeout := time.Duration(opts.TimeoutSeconds) * time.Second
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
 	startTime := time.Now()
 
 	for {
 		select {
+		case <-ctx.Done():
+			return -1, common.WaitCanceled(ctx)
 		case <-ticker.C:
 			// タスクの状態を確認
-			resp, err := ecsClient.DescribeTasks(context.Background(), &ecs.DescribeTasksInput{
+			resp, err := ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
 				Cluster: aws.String(opts.ClusterName),
 				Tasks:   []string{opts.TaskArn},
 			})
@@ -42,7 +47,7 @@ func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error
 
 			// 経過時間と状態を表示
 			elapsed := time.Since(startTime).Round(time.Second)
-			fmt.Printf("⏱️ 経過時間: %s - タスク状態: %s\n", elapsed, lastStatus)
+			common.Progressf("⏱️ 経過時間: %s - タスク状態: %s\n", elapsed, lastStatus)
 
 			// タスクが停止した場合
 			if lastStatus == "STOPPED" {
@@ -65,14 +70,14 @@ func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error
 				return -1, fmt.Errorf("コンテナ '%s' がタスク内に見つかりません。利用可能なコンテナ: %s",
 					opts.ContainerName, strings.Join(containerNames, ", "))
 			}
-		case <-time.After(timeout):
-			return -1, fmt.Errorf("タイムアウト: %d秒経過しましたがタスクは停止していません", opts.TimeoutSeconds)
+		case <-deadline.C:
+			return -1, common.Timeoutf("タイムアウト: %d秒経過しましたがタスクは停止していません", opts.TimeoutSeconds)
 		}
 	}
 }
 
 // RunAndWaitForTask はECSタスクを実行し、完了するまで待機します
-func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (int, error) {
+func RunAndWaitForTask(ctx context.Context, ecsClient API, opts RunAndWaitForTaskOptions) (int, error) {
 	// タスク定義とネットワーク設定を決定
 	var taskDefArn string
 	var networkConfig *types.NetworkConfiguration
@@ -80,18 +85,18 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 	if opts.TaskDefinition != "" {
 		// タスク定義が直接指定された場合はそれを使用
 		taskDefArn = opts.TaskDefinition
-		fmt.Println("🔍 指定されたタスク定義を使用します: " + taskDefArn)
+		common.Progressln("🔍 指定されたタスク定義を使用します: " + taskDefArn)
 	} else {
 		// サービスからタスク定義を取得
-		fmt.Println("🔍 サービスの情報を取得中...")
-		service, err := describeService(ecsClient, opts.ClusterName, opts.ServiceName)
+		common.Progressln("🔍 サービスの情報を取得中...")
+		service, err := describeService(ctx, ecsClient, opts.ClusterName, opts.ServiceName)
 		if err != nil {
 			return -1, err
 		}
 
 		taskDefArn = *service.TaskDefinition
 		networkConfig = service.NetworkConfiguration
-		fmt.Println("🔍 サービスのタスク定義を使用します: " + taskDefArn)
+		common.Progressln("🔍 サービスのタスク定義を使用します: " + taskDefArn)
 	}
 
 	// コマンドをオーバーライド
@@ -111,7 +116,7 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 			ContainerOverrides: containerOverrides,
 		}
 
-		fmt.Printf("🔍 コンテナ '%s' で実行するコマンド: %s\n", opts.ContainerName, opts.Command)
+		common.Progressf("🔍 コンテナ '%s' で実行するコマンド: %s\n", opts.ContainerName, opts.Command)
 	}
 
 	// タスク実行パラメータを設定
@@ -132,8 +137,8 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 	}
 
 	// タスクを実行
-	fmt.Println("🚀 タスクを実行中...")
-	runResult, err := ecsClient.RunTask(context.Background(), runTaskInput)
+	common.Progressln("🚀 タスクを実行中...")
+	runResult, err := ecsClient.RunTask(ctx, runTaskInput)
 	if err != nil {
 		return -1, fmt.Errorf("タスクの実行に失敗しました: %w", err)
 	}
@@ -143,7 +148,7 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 	}
 
 	taskArn := *runResult.Tasks[0].TaskArn
-	fmt.Println("✅ タスクが開始されました: " + taskArn)
+	common.Progressln("✅ タスクが開始されました: " + taskArn)
 
 	// タスクが停止するまで待機
 	waitTaskOpts := waitTaskOptions{
@@ -152,7 +157,7 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 		ContainerName:  opts.ContainerName,
 		TimeoutSeconds: opts.TimeoutSeconds,
 	}
-	exitCode, err := waitForTaskStopped(ecsClient, waitTaskOpts)
+	exitCode, err := waitForTaskStopped(ctx, ecsClient, waitTaskOpts)
 	if err != nil {
 		return -1, err
 	}
@@ -0,0 +1,94 @@
+package ecs
+
+import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// SelectClusterAndService はECSクラスターとサービスを一覧から順に選択させます
+func SelectClusterAndService(ctx context.Context, ecsClient API) (string, string, error) {
+	clusterNames, err := ListClusterNames(ctx, ecsClient)
+	if err != nil {
+		return "", "", err
+	}
+	if len(clusterNames) == 0 {
+		return "", "", common.NotFoundf("❌ ECSクラスターが見つかりません")
+	}
+	clusterName, err := picker.Select(nameItems(clusterNames), &picker.Options{
+		Prompt: "ECSクラスターを選択してください",
+		Hint:   "-c (--cluster) と -s (--service)、または -S (--stack)",
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	serviceNames, err := ListServiceNames(ctx, ecsClient, clusterName)
+	if err != nil {
+		return "", "", err
+	}
+	if len(serviceNames) == 0 {
+		return "", "", common.NotFoundf("❌ クラスター '%s' にECSサービスが見つかりません", clusterName)
+	}
+	serviceName, err := picker.Select(nameItems(serviceNames), &picker.Options{
+		Prompt: fmt.Sprintf("クラスター '%s' のECSサービスを選択してください", clusterName),
+		Hint:   "-c (--cluster) と -s (--service)、または -S (--stack)",
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	common.Progressf("✅ 選択されたECSサービス: %s/%s\n", clusterName, serviceName)
+	return clusterName, serviceName, nil
+}
+
+// ListClusterNames はECSクラスター名の一覧を取得します
+func ListClusterNames(ctx context.Context, ecsClient API) ([]string, error) {
+	var names []string
+	paginator := ecs.NewListClustersPaginator(ecsClient, &ecs.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("❌ ECSクラスター一覧の取得に失敗: %w", err)
+		}
+		for _, arn := range page.ClusterArns {
+			names = append(names, nameFromArn(arn))
+		}
+	}
+	return names, nil
+}
+
+// ListServiceNames は指定したクラスターのECSサービス名の一覧を取得します
+func ListServiceNames(ctx context.Context, ecsClient API, clusterName string) ([]string, error) {
+	var names []string
+	paginator := ecs.NewListServicesPaginator(ecsClient, &ecs.ListServicesInput{Cluster: &clusterName})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("❌ ECSサービス一覧の取得に失敗: %w", err)
+		}
+		for _, arn := range page.ServiceArns {
+			names = append(names, nameFromArn(arn))
+		}
+	}
+	return names, nil
+}
+
+// nameFromArn はARN末尾のリソース名を返す
+// (e.g., "arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/my-service" → "my-service")
+func nameFromArn(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// nameItems は名前の一覧を選択肢に変換する
+func nameItems(names []string) []picker.Item {
+	items := make([]picker.Item, len(names))
+	for i, name := range names {
+		items[i] = picker.Item{Label: name}
+	}
+	return items
+}
@@ -1,14 +1,13 @@
 package ecs
 
 import (
+	"awstk/internal/service/common"
+	"context"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // StartEcsService はECSサービスを起動します
-func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StartServiceOptions) error {
+func StartEcsService(ctx context.Context, ecsClient API, aasClient AutoScalingAPI, opts StartServiceOptions) error {
 	capacityOpts := ServiceCapacityOptions{
 		ClusterName: opts.ClusterName,
 		ServiceName: opts.ServiceName,
@@ -16,8 +15,8 @@ func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cl
 		MaxCapacity: opts.MaxCapacity,
 	}
 
-	fmt.Println("🚀 サービスの起動を開始します...")
-	err := SetEcsServiceCapacity(aasClient, capacityOpts)
+	common.Progressln("🚀 サービスの起動を開始します...")
+	err := SetEcsServiceCapacity(ctx, aasClient, capacityOpts)
 	if err != nil {
 		return fmt.Errorf("❌ エラー: %w", err)
 	}
@@ -28,7 +27,7 @@ func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cl
 		TargetRunningCount: opts.MinCapacity,
 		TimeoutSeconds:     opts.TimeoutSeconds,
 	}
-	err = waitForServiceStatus(ecsClient, waitOpts)
+	err = waitForServiceStatus(ctx, ecsClient, waitOpts)
 	if err != nil {
 		return fmt.Errorf("❌ サービス起動監視エラー: %w", err)
 	}
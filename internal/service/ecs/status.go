@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
@@ -11,9 +12,9 @@ import (
 )
 
 // GetServiceStatus はECSサービスの状態を取得する
-func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StatusOptions) (*serviceStatus, error) {
+func GetServiceStatus(ctx context.Context, ecsClient API, aasClient AutoScalingAPI, opts StatusOptions) (*serviceStatus, error) {
 	// サービス情報を取得
-	serviceResp, err := ecsClient.DescribeServices(context.Background(), &ecs.DescribeServicesInput{
+	serviceResp, err := ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
 		Cluster:  &opts.ClusterName,
 		Services: []string{opts.ServiceName},
 	})
@@ -47,17 +48,17 @@ func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.C
 	}
 
 	// タスク詳細を取得
-	tasks, err := getTaskDetails(ecsClient, opts.ClusterName, opts.ServiceName)
+	tasks, err := getTaskDetails(ctx, ecsClient, opts.ClusterName, opts.ServiceName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task details: %w", err)
 	}
 	status.Tasks = tasks
 
 	// Auto Scaling設定を取得
-	autoScaling, err := getAutoScalingInfo(aasClient, opts.ClusterName, opts.ServiceName)
+	autoScaling, err := getAutoScalingInfo(ctx, aasClient, opts.ClusterName, opts.ServiceName)
 	if err != nil {
 		// Auto Scalingが設定されていない場合はエラーではない
-		fmt.Printf("ℹ️  Auto Scaling情報の取得に失敗しました（設定されていない可能性があります）: %v\n", err)
+		common.Progressf("ℹ️  Auto Scaling情報の取得に失敗しました（設定されていない可能性があります）: %v\n", err)
 	} else {
 		status.AutoScaling = autoScaling
 	}
@@ -66,9 +67,9 @@ func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.C
 }
 
 // getTaskDetails はサービスに関連するタスクの詳細を取得する
-func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]taskInfo, error) {
+func getTaskDetails(ctx context.Context, ecsClient API, clusterName, serviceName string) ([]taskInfo, error) {
 	// サービスのタスクARNを取得
-	tasksResp, err := ecsClient.ListTasks(context.Background(), &ecs.ListTasksInput{
+	tasksResp, err := ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
 		Cluster:     &clusterName,
 		ServiceName: &serviceName,
 	})
@@ -81,7 +82,7 @@ func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]t
 	}
 
 	// タスクの詳細情報を取得
-	taskDetailsResp, err := ecsClient.DescribeTasks(context.Background(), &ecs.DescribeTasksInput{
+	taskDetailsResp, err := ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
 		Cluster: &clusterName,
 		Tasks:   tasksResp.TaskArns,
 	})
@@ -119,11 +120,11 @@ func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]t
 }
 
 // getAutoScalingInfo はAuto Scalingの設定情報を取得する
-func getAutoScalingInfo(autoScalingClient *applicationautoscaling.Client, clusterName, serviceName string) (*autoScalingInfo, error) {
+func getAutoScalingInfo(ctx context.Context, autoScalingClient AutoScalingAPI, clusterName, serviceName string) (*autoScalingInfo, error) {
 	resourceId := fmt.Sprintf("service/%s/%s", clusterName, serviceName)
 
 	// Scalable Targetsを取得
-	targetsResp, err := autoScalingClient.DescribeScalableTargets(context.Background(), &applicationautoscaling.DescribeScalableTargetsInput{
+	targetsResp, err := autoScalingClient.DescribeScalableTargets(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
 		ServiceNamespace: autoscalingtypes.ServiceNamespaceEcs,
 		ResourceIds:      []string{resourceId},
 	})
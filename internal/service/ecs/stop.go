@@ -1,14 +1,13 @@
 package ecs
 
 import (
+	"awstk/internal/service/common"
+	"context"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // StopEcsService はECSサービスを停止します
-func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StopServiceOptions) error {
+func StopEcsService(ctx context.Context, ecsClient API, aasClient AutoScalingAPI, opts StopServiceOptions) error {
 	// キャパシティ設定オプションを作成（停止のため0に設定）
 	capacityOpts := ServiceCapacityOptions{
 		ClusterName: opts.ClusterName,
@@ -18,8 +17,8 @@ func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cli
 	}
 
 	// キャパシティを設定
-	fmt.Println("🛑 サービスの停止を開始します...")
-	err := SetEcsServiceCapacity(aasClient, capacityOpts)
+	common.Progressln("🛑 サービスの停止を開始します...")
+	err := SetEcsServiceCapacity(ctx, aasClient, capacityOpts)
 	if err != nil {
 		return fmt.Errorf("❌ エラー: %w", err)
 	}
@@ -31,7 +30,7 @@ func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cli
 		TargetRunningCount: 0,
 		TimeoutSeconds:     opts.TimeoutSeconds,
 	}
-	err = waitForServiceStatus(ecsClient, waitOpts)
+	err = waitForServiceStatus(ctx, ecsClient, waitOpts)
 	if err != nil {
 		return fmt.Errorf("❌ サービス停止監視エラー: %w", err)
 	}
@@ -1,5 +1,29 @@
 package ecs
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// API はecsパッケージが利用するECS APIのインターフェース
+type API interface {
+	DescribeServices(ctx context.Context, params *ecs.DescribeServicesInput, optFns ...func(*ecs.Options)) (*ecs.DescribeServicesOutput, error)
+	UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error)
+	ListTasks(ctx context.Context, params *ecs.ListTasksInput, optFns ...func(*ecs.Options)) (*ecs.ListTasksOutput, error)
+	DescribeTasks(ctx context.Context, params *ecs.DescribeTasksInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTasksOutput, error)
+	RunTask(ctx context.Context, params *ecs.RunTaskInput, optFns ...func(*ecs.Options)) (*ecs.RunTaskOutput, error)
+	ListClusters(ctx context.Context, params *ecs.ListClustersInput, optFns ...func(*ecs.Options)) (*ecs.ListClustersOutput, error)
+	ListServices(ctx context.Context, params *ecs.ListServicesInput, optFns ...func(*ecs.Options)) (*ecs.ListServicesOutput, error)
+}
+
+// AutoScalingAPI はecsパッケージが利用するApplication Auto Scaling APIのインターフェース
+type AutoScalingAPI interface {
+	DescribeScalableTargets(ctx context.Context, params *applicationautoscaling.DescribeScalableTargetsInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error)
+	RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+}
+
 // ServiceCapacityOptions はECSサービスのキャパシティ設定用パラメータを格納する構造体
 type ServiceCapacityOptions struct {
 	ClusterName string
@@ -72,9 +96,9 @@ type WaitDeploymentOptions struct {
 
 // ResolveOptions はECSクラスター名とサービス名の解決オプション
 type ResolveOptions struct {
-	StackName   string // オプション: CloudFormationスタック名
-	ClusterName string // オプション: ECSクラスター名（スタック名が指定されていない場合は必須）
-	ServiceName string // オプション: ECSサービス名（スタック名が指定されていない場合は必須）
+	StackName   string `json:"stackName,omitempty" desc:"ECSサービスを含むCloudFormationスタック名"`      // オプション: CloudFormationスタック名
+	ClusterName string `json:"clusterName,omitempty" desc:"ECSクラスター名（stackName を指定しない場合は必須）"` // オプション: ECSクラスター名（スタック名が指定されていない場合は必須）
+	ServiceName string `json:"serviceName,omitempty" desc:"ECSサービス名（stackName を指定しない場合は必須）"`  // オプション: ECSサービス名（スタック名が指定されていない場合は必須）
 }
 
 // serviceStatus はECSサービスの状態情報を格納する構造体
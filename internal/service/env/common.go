@@ -1,11 +1,11 @@
 package env
 
-import "fmt"
+import "awstk/internal/service/common"
 
 // ValidateVariable は変数名が有効かチェック
 func ValidateVariable(variable string) error {
 	if _, ok := SupportedVariables[variable]; !ok {
-		return fmt.Errorf("❌ エラー: '%s' はサポートされていない変数です。stack または profile を指定してください", variable)
+		return common.InvalidInputf("❌ エラー: '%s' はサポートされていない変数です。stack または profile を指定してください", variable)
 	}
 	return nil
 }
@@ -0,0 +1,86 @@
+package env
+
+import (
+	"awstk/internal/service/common"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// 変数の出力形式
+const (
+	ExportFormatEnv    = "env"    // export NAME='value'（eval で読み込める形式）
+	ExportFormatDotenv = "dotenv" // NAME="value"（.env ファイルの形式）
+	ExportFormatJson   = "json"   // {"NAME": "value"}
+)
+
+// ExportFormats は --format で指定できる出力形式
+var ExportFormats = []string{ExportFormatEnv, ExportFormatDotenv, ExportFormatJson}
+
+// shellSafePattern はクォートせずにシェルへ渡せる値
+var shellSafePattern = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// invalidNameChars は環境変数名に使えない文字
+var invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// ValidateExportFormat は出力形式が有効かチェック
+func ValidateExportFormat(format string) error {
+	if slices.Contains(ExportFormats, format) {
+		return nil
+	}
+	return common.InvalidInputf("❌ エラー: '%s' はサポートされていない形式です。%s のいずれかを指定してください", format, strings.Join(ExportFormats, ", "))
+}
+
+// VariableName は任意の名前を環境変数名として使える形に変換する
+// 英数字・アンダースコア以外の文字はアンダースコアに置き換え、数字で始まる場合は先頭にアンダースコアを付ける
+func VariableName(name string) string {
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// ShellQuote は値をシェルの単語として安全に渡せるようクォートする
+// クォートが不要な値はそのまま返す
+func ShellQuote(value string) string {
+	if shellSafePattern.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// WriteVariables は names の順に変数を指定した形式で w に書き込む
+// 変数名は VariableName で変換してから出力する
+func WriteVariables(w io.Writer, format string, names []string, values map[string]string) error {
+	switch format {
+	case ExportFormatEnv:
+		for _, name := range names {
+			if _, err := fmt.Fprintf(w, "export %s=%s\n", VariableName(name), ShellQuote(values[name])); err != nil {
+				return err
+			}
+		}
+	case ExportFormatDotenv:
+		for _, name := range names {
+			// ダブルクォートで囲み、.env の読み込み時に解釈される文字をエスケープする
+			value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`).Replace(values[name])
+			if _, err := fmt.Fprintf(w, "%s=\"%s\"\n", VariableName(name), value); err != nil {
+				return err
+			}
+		}
+	case ExportFormatJson:
+		vars := make(map[string]string, len(names))
+		for _, name := range names {
+			vars[VariableName(name)] = values[name]
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(vars)
+	default:
+		return ValidateExportFormat(format)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package env
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteVariables(t *testing.T) {
+	names := []string{"ApiUrl", "prod-Db:Password", "1Key"}
+	values := map[string]string{
+		"ApiUrl":           "https://example.com/v1",
+		"prod-Db:Password": `it's "$ecret"`,
+		"1Key":             "a b",
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: ExportFormatEnv,
+			want: "export ApiUrl=https://example.com/v1\n" +
+				"export prod_Db_Password='it'\\''s \"$ecret\"'\n" +
+				"export _1Key='a b'\n",
+		},
+		{
+			format: ExportFormatDotenv,
+			want: "ApiUrl=\"https://example.com/v1\"\n" +
+				"prod_Db_Password=\"it's \\\"\\$ecret\\\"\"\n" +
+				"_1Key=\"a b\"\n",
+		},
+		{
+			format: ExportFormatJson,
+			want:   "{\n  \"ApiUrl\": \"https://example.com/v1\",\n  \"_1Key\": \"a b\",\n  \"prod_Db_Password\": \"it's \\\"$ecret\\\"\"\n}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteVariables(&buf, tt.format, names, values); err != nil {
+				t.Fatalf("WriteVariables() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("WriteVariables() =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+
+	if err := WriteVariables(&bytes.Buffer{}, "yaml", names, values); err == nil {
+		t.Error("WriteVariables(yaml) error = nil, want error")
+	}
+}
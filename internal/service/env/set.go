@@ -9,5 +9,5 @@ func GetExportCommand(variable, value string) (string, error) {
 	}
 
 	v := SupportedVariables[variable]
-	return fmt.Sprintf("export %s=%s", v.Name, value), nil
+	return fmt.Sprintf("export %s=%s", v.Name, ShellQuote(value)), nil
 }
@@ -1,26 +1,32 @@
 package env
 
 import (
-	"fmt"
-	"os"
+	"awstk/internal/config"
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
 )
 
-// ShowAllVariables はすべてのサポートされている環境変数を表示
-func ShowAllVariables() {
-	fmt.Println("📋 AWS関連の環境変数の状態:")
-	fmt.Println()
-
-	// 順序を固定するため、明示的に順番を定義
-	order := []string{"profile", "stack"}
-
-	for _, key := range order {
-		v := SupportedVariables[key]
-		value := os.Getenv(v.Name)
-
-		if value != "" {
-			fmt.Printf("  %s (%s): %s\n", v.Description, v.Name, value)
-		} else {
-			fmt.Printf("  %s (%s): 未設定\n", v.Description, v.Name)
-		}
-	}
+// ShowSettings は解決済みの設定値とその取得元を表示
+func ShowSettings(settings []config.Setting) error {
+	return common.DisplayList(
+		settings,
+		common.GenerateFilteredTitle(i18n.T("resource.setting")),
+		func(items []config.Setting) ([]common.TableColumn, [][]string) {
+			columns := []common.TableColumn{
+				{Header: i18n.T("header.item")},
+				{Header: i18n.T("header.value")},
+				{Header: i18n.T("header.source")},
+			}
+			data := make([][]string, len(items))
+			for i, s := range items {
+				value := s.Value
+				if value == "" {
+					value = "未設定"
+				}
+				data[i] = []string{s.Key, value, s.SourceLabel()}
+			}
+			return columns, data
+		},
+		nil,
+	)
 }
@@ -0,0 +1,254 @@
+package group
+
+import (
+	aurorasvc "awstk/internal/service/aurora"
+	canarysvc "awstk/internal/service/canary"
+	ec2svc "awstk/internal/service/ec2"
+	ecssvc "awstk/internal/service/ecs"
+	rdssvc "awstk/internal/service/rds"
+	schedulesvc "awstk/internal/service/schedule"
+	"awstk/internal/service/tagging"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+)
+
+// kind はリソースの種類ごとの状態取得・起動・停止の処理
+type kind struct {
+	label   string // 表示名
+	tagType string // タグで検索する際のリソースの種類
+	status  func(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error)
+	start   func(ctx context.Context, clients ClientSet, r Resource) error
+	stop    func(ctx context.Context, clients ClientSet, r Resource) error
+}
+
+// kinds はグループに含められるリソースの種類
+var kinds = map[string]kind{
+	TypeEc2: {
+		label:   "EC2インスタンス",
+		tagType: tagging.ResourceEc2Instance,
+		status:  ec2Status,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return ec2svc.StartEc2Instance(ctx, clients.Ec2Client, r.Id)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return ec2svc.StopEc2Instance(ctx, clients.Ec2Client, r.Id)
+		},
+	},
+	TypeRds: {
+		label:   "RDSインスタンス",
+		tagType: tagging.ResourceRdsInstance,
+		status:  rdsStatus,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return rdssvc.StartRdsInstance(ctx, clients.RdsClient, r.Id)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return rdssvc.StopRdsInstance(ctx, clients.RdsClient, r.Id)
+		},
+	},
+	TypeAurora: {
+		label:   "Aurora DBクラスター",
+		tagType: tagging.ResourceRdsCluster,
+		status:  auroraStatus,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return aurorasvc.StartAuroraCluster(ctx, clients.RdsClient, r.Id)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return aurorasvc.StopAuroraCluster(ctx, clients.RdsClient, r.Id)
+		},
+	},
+	TypeEcs: {
+		label:   "ECSサービス",
+		tagType: tagging.ResourceEcsService,
+		status:  ecsStatus,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return setEcsCapacity(ctx, clients, r, r.MinCapacity, r.MaxCapacity)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return setEcsCapacity(ctx, clients, r, 0, 0)
+		},
+	},
+	TypeCanary: {
+		label:   "Canary",
+		tagType: tagging.ResourceSyntheticsCanary,
+		status:  canaryStatus,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return canarysvc.EnableCanary(ctx, clients.CanaryClient, r.Id)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return canarysvc.DisableCanary(ctx, clients.CanaryClient, r.Id)
+		},
+	},
+	TypeSchedule: {
+		label:   "スケジュール",
+		tagType: tagging.ResourceEventsRule,
+		status:  scheduleStatus,
+		start: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return schedulesvc.EnableSchedule(ctx, clients.EventBridgeClient, clients.SchedulerClient, r.Id)
+		},
+		stop: func(ctx context.Context, clients ClientSet, r Resource) error {
+			return schedulesvc.DisableSchedule(ctx, clients.EventBridgeClient, clients.SchedulerClient, r.Id)
+		},
+	},
+}
+
+// Types はグループに含められるリソースの種類を起動順の既定値で返します
+func Types() []string {
+	return []string{TypeRds, TypeAurora, TypeEc2, TypeEcs, TypeCanary, TypeSchedule}
+}
+
+// label はリソースの表示名を返す (e.g., RDSインスタンス (my-db))
+func (r Resource) label() string {
+	return fmt.Sprintf("%s (%s)", kinds[r.Type].label, r.Id)
+}
+
+// ec2Status はEC2インスタンスの状態を取得する
+func ec2Status(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	out, err := clients.Ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{InstanceIds: []string{r.Id}})
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	for _, reservation := range out.Reservations {
+		for _, instance := range reservation.Instances {
+			if instance.State == nil {
+				continue
+			}
+			state := string(instance.State.Name)
+			switch state {
+			case "running":
+				return state, PhaseRunning, nil
+			case "pending":
+				return state, PhaseStarting, nil
+			case "stopping", "shutting-down":
+				return state, PhaseStopping, nil
+			case "stopped", "terminated":
+				return state, PhaseStopped, nil
+			}
+			return state, PhaseUnknown, nil
+		}
+	}
+	return "", PhaseUnknown, fmt.Errorf("EC2インスタンス '%s' が見つかりません", r.Id)
+}
+
+// rdsStatus はRDSインスタンスの状態を取得する
+func rdsStatus(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	out, err := clients.RdsClient.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(r.Id)})
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	if len(out.DBInstances) == 0 {
+		return "", PhaseUnknown, fmt.Errorf("RDSインスタンス '%s' が見つかりません", r.Id)
+	}
+	state := aws.ToString(out.DBInstances[0].DBInstanceStatus)
+	return state, rdsPhase(state), nil
+}
+
+// auroraStatus はAurora DBクラスターの状態を取得する
+func auroraStatus(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	out, err := clients.RdsClient.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(r.Id)})
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	if len(out.DBClusters) == 0 {
+		return "", PhaseUnknown, fmt.Errorf("Aurora DBクラスター '%s' が見つかりません", r.Id)
+	}
+	state := aws.ToString(out.DBClusters[0].Status)
+	return state, rdsPhase(state), nil
+}
+
+// rdsPhase はRDSインスタンス・Aurora DBクラスターの状態を段階に変換する
+func rdsPhase(state string) Phase {
+	switch state {
+	case "available":
+		return PhaseRunning
+	case "starting":
+		return PhaseStarting
+	case "stopping":
+		return PhaseStopping
+	case "stopped":
+		return PhaseStopped
+	}
+	return PhaseUnknown
+}
+
+// ecsStatus はECSサービスのタスク数（実行中/希望）を取得する
+func ecsStatus(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	clusterName, serviceName, _ := strings.Cut(r.Id, "/")
+	out, err := clients.EcsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterName),
+		Services: []string{serviceName},
+	})
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	if len(out.Services) == 0 {
+		return "", PhaseUnknown, fmt.Errorf("ECSサービス '%s' が見つかりません", r.Id)
+	}
+	running, desired := out.Services[0].RunningCount, out.Services[0].DesiredCount
+	state := fmt.Sprintf("%d/%d", running, desired)
+	switch {
+	case desired == 0 && running == 0:
+		return state, PhaseStopped, nil
+	case desired == 0:
+		return state, PhaseStopping, nil
+	case running == desired:
+		return state, PhaseRunning, nil
+	}
+	return state, PhaseStarting, nil
+}
+
+// setEcsCapacity はECSサービスの最小・最大キャパシティを設定する
+func setEcsCapacity(ctx context.Context, clients ClientSet, r Resource, minCapacity, maxCapacity int) error {
+	clusterName, serviceName, _ := strings.Cut(r.Id, "/")
+	return ecssvc.SetEcsServiceCapacity(ctx, clients.AutoScalingClient, ecssvc.ServiceCapacityOptions{
+		ClusterName: clusterName,
+		ServiceName: serviceName,
+		MinCapacity: minCapacity,
+		MaxCapacity: maxCapacity,
+	})
+}
+
+// canaryStatus はCanaryの状態を取得する
+func canaryStatus(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	out, err := clients.CanaryClient.DescribeCanaries(ctx, &synthetics.DescribeCanariesInput{Names: []string{r.Id}})
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	if len(out.Canaries) == 0 || out.Canaries[0].Status == nil {
+		return "", PhaseUnknown, fmt.Errorf("Canary '%s' が見つかりません", r.Id)
+	}
+	state := string(out.Canaries[0].Status.State)
+	switch state {
+	case canarysvc.CanaryStateRunning:
+		return state, PhaseRunning, nil
+	case canarysvc.CanaryStateStarting:
+		return state, PhaseStarting, nil
+	case canarysvc.CanaryStateStopping:
+		return state, PhaseStopping, nil
+	case canarysvc.CanaryStateStopped, canarysvc.CanaryStateReady:
+		return state, PhaseStopped, nil
+	}
+	return state, PhaseUnknown, nil
+}
+
+// scheduleStatus はスケジュール（EventBridge Rule / Scheduler）の状態を取得する
+// 有効なスケジュールを起動中、無効なスケジュールを停止中として扱う
+func scheduleStatus(ctx context.Context, clients ClientSet, r Resource) (string, Phase, error) {
+	state, err := schedulesvc.GetScheduleState(ctx, clients.EventBridgeClient, clients.SchedulerClient, r.Id)
+	if err != nil {
+		return "", PhaseUnknown, err
+	}
+	switch state {
+	case "ENABLED":
+		return state, PhaseRunning, nil
+	case "DISABLED":
+		return state, PhaseStopped, nil
+	}
+	return state, PhaseUnknown, nil
+}
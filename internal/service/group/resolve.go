@@ -0,0 +1,153 @@
+// Package group は種類の異なるリソースをグループとしてまとめて起動・停止する機能を提供します
+//
+// グループは設定ファイルの groups に段階（tiers）の一覧として定義し、
+// 起動時は定義した順に、停止時は逆順に段階ごとに処理します。
+package group
+
+import (
+	"awstk/internal/config"
+	"awstk/internal/service/common"
+	rdssvc "awstk/internal/service/rds"
+	"awstk/internal/service/tagging"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Resolve は設定ファイルのグループ定義を解決します
+// tag で指定したリソースは Resource Groups Tagging API で検索してリソースIDに展開します
+func Resolve(ctx context.Context, clients ClientSet, name string, def *config.Group) (Group, error) {
+	g := Group{Name: name}
+	if len(def.Tiers) == 0 {
+		return g, common.InvalidInputf("グループ '%s' に tiers が定義されていません", name)
+	}
+	for i, t := range def.Tiers {
+		tier := Tier{Name: t.Name}
+		if tier.Name == "" {
+			tier.Name = fmt.Sprintf("tier%d", i+1)
+		}
+		for _, res := range t.Resources {
+			resources, err := resolveResource(ctx, clients, res)
+			if err != nil {
+				return g, fmt.Errorf("グループ '%s' の %s: %w", name, tier.Name, err)
+			}
+			tier.Resources = append(tier.Resources, resources...)
+		}
+		g.Tiers = append(g.Tiers, tier)
+	}
+	return g, nil
+}
+
+// FromTags はタグの条件に一致するリソースから既定の順序のグループを作成します
+// 起動順は DB（RDS・Aurora）→ アプリケーション（EC2・ECS）→ 監視（Canary・スケジュール）です
+func FromTags(ctx context.Context, clients ClientSet, selector tagging.Selector) (Group, error) {
+	g := Group{Name: selector.String()}
+	tiers := []struct {
+		name  string
+		types []string
+	}{
+		{name: "db", types: []string{TypeRds, TypeAurora}},
+		{name: "app", types: []string{TypeEc2, TypeEcs}},
+		{name: "monitoring", types: []string{TypeCanary, TypeSchedule}},
+	}
+	for _, t := range tiers {
+		tier := Tier{Name: t.name}
+		for _, resourceType := range t.types {
+			resources, err := findTagged(ctx, clients, resourceType, selector, DefaultMinCapacity, DefaultMaxCapacity)
+			if err != nil {
+				return g, err
+			}
+			tier.Resources = append(tier.Resources, resources...)
+		}
+		if len(tier.Resources) > 0 {
+			g.Tiers = append(g.Tiers, tier)
+		}
+	}
+	if len(g.Tiers) == 0 {
+		return g, common.NotFoundf("タグ (%s) に一致するリソースが見つかりませんでした", selector)
+	}
+	return g, nil
+}
+
+// NewResource は種類とIDを指定してリソースを作成します
+// ECSサービスのキャパシティは 0 の場合に既定値を使います
+func NewResource(resourceType, id string, minCapacity, maxCapacity int) (Resource, error) {
+	if id == "" {
+		return Resource{}, common.InvalidInputf("%s のリソースIDを指定してください", resourceType)
+	}
+	resources, err := resolveResource(context.Background(), ClientSet{}, config.GroupResource{Type: resourceType, Id: id, Min: minCapacity, Max: maxCapacity})
+	if err != nil {
+		return Resource{}, err
+	}
+	return resources[0], nil
+}
+
+// resolveResource はグループ定義の1件をリソースの一覧に変換する
+func resolveResource(ctx context.Context, clients ClientSet, res config.GroupResource) ([]Resource, error) {
+	if _, ok := kinds[res.Type]; !ok {
+		return nil, common.InvalidInputf("未対応のリソースの種類です: '%s' (対応: %s)", res.Type, strings.Join(Types(), ", "))
+	}
+	if (res.Id == "") == (len(res.Tag) == 0) {
+		return nil, common.InvalidInputf("%s のリソースには id と tag のどちらか一方を指定してください", res.Type)
+	}
+
+	var minCapacity, maxCapacity int
+	if res.Type == TypeEcs {
+		minCapacity, maxCapacity = res.Min, res.Max
+		if minCapacity == 0 {
+			minCapacity = DefaultMinCapacity
+		}
+		if maxCapacity == 0 {
+			maxCapacity = max(DefaultMaxCapacity, minCapacity)
+		}
+		if minCapacity > maxCapacity {
+			return nil, common.InvalidInputf("ECSサービスの min (%d) は max (%d) 以下にしてください", minCapacity, maxCapacity)
+		}
+	}
+
+	if res.Id != "" {
+		if res.Type == TypeEcs && !strings.Contains(res.Id, "/") {
+			return nil, common.InvalidInputf("ECSサービスの id は <クラスター名>/<サービス名> の形式で指定してください: %s", res.Id)
+		}
+		return []Resource{{Type: res.Type, Id: res.Id, MinCapacity: minCapacity, MaxCapacity: maxCapacity}}, nil
+	}
+
+	selector, err := tagging.ParseSelector(res.Tag)
+	if err != nil {
+		return nil, common.InvalidInputf("%v", err)
+	}
+	return findTagged(ctx, clients, res.Type, selector, minCapacity, maxCapacity)
+}
+
+// findTagged はタグの条件に一致する指定した種類のリソースを取得する
+// RDSインスタンスは、インスタンス単位で起動・停止できないAuroraクラスターのメンバーを除外する
+func findTagged(ctx context.Context, clients ClientSet, resourceType string, selector tagging.Selector, minCapacity, maxCapacity int) ([]Resource, error) {
+	if clients.TagClient == nil {
+		return nil, fmt.Errorf("タグで指定する場合は TagClient が必要です")
+	}
+	names, err := selector.Names(ctx, clients.TagClient, kinds[resourceType].tagType)
+	if err != nil {
+		return nil, err
+	}
+	if resourceType == TypeRds && len(names) > 0 {
+		if clients.RdsClient == nil {
+			return nil, fmt.Errorf("RDSインスタンスをタグで指定する場合は RdsClient が必要です")
+		}
+		names, err = rdssvc.ExcludeClusterMembers(ctx, clients.RdsClient, names)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resources := make([]Resource, 0, len(names))
+	for _, name := range names {
+		r := Resource{Type: resourceType, Id: name}
+		if resourceType == TypeEcs {
+			if !strings.Contains(name, "/") {
+				return nil, fmt.Errorf("ECSサービス '%s' のクラスター名を特定できません（旧形式のARNです）", name)
+			}
+			r.MinCapacity, r.MaxCapacity = minCapacity, maxCapacity
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
@@ -0,0 +1,130 @@
+package group
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"awstk/internal/config"
+	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
+	"awstk/internal/testutil/fakeaws"
+)
+
+func TestResolve(t *testing.T) {
+	tagClient := fakeaws.NewTagging(
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:ecs:ap-northeast-1:123456789012:service/dev-cluster/api", Tags: map[string]string{"env": "dev"}},
+	)
+
+	tests := []struct {
+		name    string
+		def     config.Group
+		want    []Tier
+		wantErr bool
+	}{
+		{
+			name: "id とタグで指定",
+			def: config.Group{Tiers: []config.GroupTier{
+				{Name: "db", Resources: []config.GroupResource{{Type: "rds", Id: "my-db"}}},
+				{Resources: []config.GroupResource{
+					{Type: "ec2", Tag: []string{"env=dev"}},
+					{Type: "ecs", Tag: []string{"env=dev"}, Min: 2, Max: 4},
+				}},
+			}},
+			want: []Tier{
+				{Name: "db", Resources: []Resource{{Type: TypeRds, Id: "my-db"}}},
+				{Name: "tier2", Resources: []Resource{
+					{Type: TypeEc2, Id: "i-dev"},
+					{Type: TypeEcs, Id: "dev-cluster/api", MinCapacity: 2, MaxCapacity: 4},
+				}},
+			},
+		},
+		{
+			name: "ECSサービスのキャパシティの既定値",
+			def: config.Group{Tiers: []config.GroupTier{
+				{Name: "app", Resources: []config.GroupResource{{Type: "ecs", Id: "dev-cluster/api", Min: 3}}},
+			}},
+			want: []Tier{
+				{Name: "app", Resources: []Resource{{Type: TypeEcs, Id: "dev-cluster/api", MinCapacity: 3, MaxCapacity: 3}}},
+			},
+		},
+		{
+			name:    "tiers がない",
+			def:     config.Group{},
+			wantErr: true,
+		},
+		{
+			name: "未対応の種類",
+			def: config.Group{Tiers: []config.GroupTier{
+				{Resources: []config.GroupResource{{Type: "lambda", Id: "fn"}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "id と tag の両方を指定",
+			def: config.Group{Tiers: []config.GroupTier{
+				{Resources: []config.GroupResource{{Type: "ec2", Id: "i-0123", Tag: []string{"env=dev"}}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "ECSサービスの id にクラスター名がない",
+			def: config.Group{Tiers: []config.GroupTier{
+				{Resources: []config.GroupResource{{Type: "ecs", Id: "api"}}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Resolve(t.Context(), ClientSet{TagClient: tagClient}, "dev", &tt.def)
+			if tt.wantErr {
+				var invalid *common.InvalidInputError
+				if !errors.As(err, &invalid) {
+					t.Fatalf("Resolve() error = %v, want *common.InvalidInputError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Tiers, tt.want) {
+				t.Errorf("Resolve() = %+v, want %+v", got.Tiers, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromTags(t *testing.T) {
+	tagClient := fakeaws.NewTagging(
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:cluster:dev-cluster", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:dev-cluster-writer", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:dev-db", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:prd-db", Tags: map[string]string{"env": "prd"}},
+	)
+	// Auroraクラスターのメンバーはタグが一致しても rds として扱わない
+	rdsClient := fakeaws.NewRds(map[string]string{"dev-cluster-writer": "available", "dev-db": "available", "prd-db": "available"}, map[string]string{"dev-cluster": "available"})
+	rdsClient.ClusterMembers = map[string]string{"dev-cluster-writer": "dev-cluster"}
+	clients := ClientSet{TagClient: tagClient, RdsClient: rdsClient}
+
+	got, err := FromTags(t.Context(), clients, tagging.Selector{{Key: "env", Value: "dev", Operator: tagging.OperatorEquals}})
+	if err != nil {
+		t.Fatalf("FromTags() error = %v", err)
+	}
+	want := []Tier{
+		{Name: "db", Resources: []Resource{{Type: TypeRds, Id: "dev-db"}, {Type: TypeAurora, Id: "dev-cluster"}}},
+		{Name: "app", Resources: []Resource{{Type: TypeEc2, Id: "i-dev"}}},
+	}
+	if !reflect.DeepEqual(got.Tiers, want) {
+		t.Errorf("FromTags() = %+v, want %+v", got.Tiers, want)
+	}
+
+	_, err = FromTags(t.Context(), clients, tagging.Selector{{Key: "env", Value: "stg", Operator: tagging.OperatorEquals}})
+	var notFound *common.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("FromTags() error = %v, want *common.NotFoundError", err)
+	}
+}
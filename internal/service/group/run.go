@@ -0,0 +1,202 @@
+package group
+
+import (
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DefaultTimeoutSeconds は1段階あたりの待機タイムアウトの既定値（秒）
+// RDS・Auroraの起動・停止には10分以上かかることがあるため長めにしている
+const DefaultTimeoutSeconds = 1200
+
+// pollInterval は段階の完了を確認する間隔（テストから短縮できるよう変数にしている）
+var pollInterval = 15 * time.Second
+
+// operation は起動・停止の処理内容
+type operation struct {
+	name   string // 表示名 (e.g., 起動)
+	icon   string
+	target Phase // 完了とみなす段階
+	skip   Phase // 操作を省略する遷移中の段階
+	stop   bool
+}
+
+var (
+	startOperation = operation{
+		name:   "起動",
+		icon:   "🚀",
+		target: PhaseRunning,
+		skip:   PhaseStarting,
+	}
+	stopOperation = operation{
+		name:   "停止",
+		icon:   "🛑",
+		target: PhaseStopped,
+		skip:   PhaseStopping,
+		stop:   true,
+	}
+)
+
+// Start はグループのリソースを段階の順に起動します
+// 各段階の起動が完了してから次の段階に進み、失敗したリソースがある場合は以降の段階を実行しません
+func Start(ctx context.Context, clients ClientSet, g Group, opts Options) error {
+	return run(ctx, clients, g.Tiers, startOperation, opts)
+}
+
+// Stop はグループのリソースを段階の逆順に停止します
+// 各段階の停止が完了してから次の段階に進み、失敗したリソースがある場合は以降の段階を実行しません
+func Stop(ctx context.Context, clients ClientSet, g Group, opts Options) error {
+	return run(ctx, clients, StopOrder(g), stopOperation, opts)
+}
+
+// StartResource は1件のリソースを起動します（起動済み・起動中の場合は何もしません）
+func StartResource(ctx context.Context, clients ClientSet, r Resource) error {
+	return applyOperation(ctx, clients, r, startOperation)
+}
+
+// StopResource は1件のリソースを停止します（停止済み・停止中の場合は何もしません）
+func StopResource(ctx context.Context, clients ClientSet, r Resource) error {
+	return applyOperation(ctx, clients, r, stopOperation)
+}
+
+// Wait はリソースが起動済み（stop が true の場合は停止済み）になるまで待機します
+func Wait(ctx context.Context, clients ClientSet, r Resource, stop bool, timeoutSeconds int) error {
+	op := startOperation
+	if stop {
+		op = stopOperation
+	}
+	return waitTier(ctx, clients, Tier{Name: r.label(), Resources: []Resource{r}}, op, timeoutSeconds)
+}
+
+// StopOrder は停止する順（定義の逆順）に段階を返します
+func StopOrder(g Group) []Tier {
+	tiers := slices.Clone(g.Tiers)
+	slices.Reverse(tiers)
+	return tiers
+}
+
+// PrintPlan は実行する順に段階とリソースを表示します（--dry-run 用）
+func PrintPlan(g Group, stop bool) {
+	op, tiers := startOperation, g.Tiers
+	if stop {
+		op, tiers = stopOperation, StopOrder(g)
+	}
+	fmt.Printf("📋 グループ '%s' の%s順序:\n", g.Name, op.name)
+	for i, tier := range tiers {
+		fmt.Printf("  %d. %s\n", i+1, tier.Name)
+		for _, r := range tier.Resources {
+			fmt.Printf("     - %s\n", r.label())
+		}
+	}
+}
+
+// run は段階ごとにリソースを並列で操作し、次の段階に進む前に完了を待つ
+func run(ctx context.Context, clients ClientSet, tiers []Tier, op operation, opts Options) error {
+	for i, tier := range tiers {
+		common.Progressf("\n%s [%d/%d] %s を%sします\n", op.icon, i+1, len(tiers), tier.Name, op.name)
+
+		items := make([]string, len(tier.Resources))
+		for j, r := range tier.Resources {
+			items[j] = r.label()
+		}
+		results := common.Run(ctx, tier.Resources, func(ctx context.Context, r Resource) (struct{}, error) {
+			return struct{}{}, applyOperation(ctx, clients, r, op)
+		}, nil)
+		for j, r := range results {
+			if r.Err != nil {
+				common.Warnf("❌ %s: %v\n", items[j], r.Err)
+			}
+		}
+		if err := common.CollectFailures(tier.Name+"の"+op.name, items, results); err != nil {
+			if i < len(tiers)-1 {
+				common.Progressln("⏭️  以降の段階は実行しません")
+			}
+			return err
+		}
+
+		// 最後の段階は完了を待たない
+		if opts.NoWait || i == len(tiers)-1 {
+			continue
+		}
+		if err := waitTier(ctx, clients, tier, op, opts.TimeoutSeconds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyOperation はリソースを起動・停止する
+// 既に目標の段階にある、または遷移中のリソースは操作しない
+func applyOperation(ctx context.Context, clients ClientSet, r Resource, op operation) error {
+	k := kinds[r.Type]
+	state, phase, err := k.status(ctx, clients, r)
+	if err != nil {
+		return fmt.Errorf("状態の取得に失敗: %w", err)
+	}
+	if phase == op.target || phase == op.skip {
+		common.Progressf("ℹ️  %s は既に%s済みまたは%s中です (%s)\n", r.label(), op.name, op.name, state)
+		return nil
+	}
+	apply := k.start
+	if op.stop {
+		apply = k.stop
+	}
+	if err := apply(ctx, clients, r); err != nil {
+		return err
+	}
+	common.Progressf("✅ %s の%sを開始しました\n", r.label(), op.name)
+	return nil
+}
+
+// waitTier は段階内のすべてのリソースが目標の段階になるまで待機する
+func waitTier(ctx context.Context, clients ClientSet, tier Tier, op operation, timeoutSeconds int) error {
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = DefaultTimeoutSeconds
+	}
+	common.Progressf("⏳ %s の%s完了を待機しています...\n", tier.Name, op.name)
+
+	start := time.Now()
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	for {
+		pending, err := pendingResources(ctx, clients, tier, op.target)
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			common.Progressf("✅ %s の%sが完了しました\n", tier.Name, op.name)
+			return nil
+		}
+
+		elapsed := time.Since(start).Round(time.Second)
+		common.Progressf("⏱️ 経過時間: %s - 完了: %d / %d (待機中: %v)\n",
+			elapsed, len(tier.Resources)-len(pending), len(tier.Resources), pending)
+
+		if time.Since(start) > timeout {
+			return common.Timeoutf("タイムアウト: %d秒経過しましたが %s の%sが完了していません: %v", timeoutSeconds, tier.Name, op.name, pending)
+		}
+
+		select {
+		case <-ctx.Done():
+			return common.WaitCanceled(ctx)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// pendingResources は目標の段階になっていないリソースの表示名を返す
+func pendingResources(ctx context.Context, clients ClientSet, tier Tier, target Phase) ([]string, error) {
+	var pending []string
+	for _, r := range tier.Resources {
+		_, phase, err := kinds[r.Type].status(ctx, clients, r)
+		if err != nil {
+			return nil, fmt.Errorf("%s の状態の取得に失敗: %w", r.label(), err)
+		}
+		if phase != target {
+			pending = append(pending, r.label())
+		}
+	}
+	return pending, nil
+}
@@ -0,0 +1,121 @@
+package group
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+var _ RdsAPI = (*fakeaws.Rds)(nil)
+
+// testGroup は db（RDS・Aurora）→ app（EC2）の2段階のグループ
+var testGroup = Group{
+	Name: "dev",
+	Tiers: []Tier{
+		{Name: "db", Resources: []Resource{{Type: TypeRds, Id: "my-db"}, {Type: TypeAurora, Id: "my-cluster"}}},
+		{Name: "app", Resources: []Resource{{Type: TypeEc2, Id: "i-0123"}}},
+	},
+}
+
+func TestStart(t *testing.T) {
+	tests := []struct {
+		name          string
+		instances     map[string]string
+		clusters      map[string]string
+		ec2State      types.InstanceStateName
+		failRds       bool
+		wantErr       bool
+		wantRdsStarts int
+		wantEc2Starts int
+	}{
+		{
+			name:          "すべての段階を起動",
+			instances:     map[string]string{"my-db": "stopped"},
+			clusters:      map[string]string{"my-cluster": "stopped"},
+			ec2State:      types.InstanceStateNameStopped,
+			wantRdsStarts: 1,
+			wantEc2Starts: 1,
+		},
+		{
+			name:          "起動済みのリソースは操作しない",
+			instances:     map[string]string{"my-db": "available"},
+			clusters:      map[string]string{"my-cluster": "starting"},
+			ec2State:      types.InstanceStateNameStopped,
+			wantRdsStarts: 0,
+			wantEc2Starts: 1,
+		},
+		{
+			name:          "前の段階が失敗したら以降の段階は実行しない",
+			instances:     map[string]string{"my-db": "stopped"},
+			clusters:      map[string]string{"my-cluster": "stopped"},
+			ec2State:      types.InstanceStateNameStopped,
+			failRds:       true,
+			wantErr:       true,
+			wantRdsStarts: 1,
+			wantEc2Starts: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdsFake := fakeaws.NewRds(tt.instances, tt.clusters)
+			ec2Fake := fakeaws.NewEc2(map[string]types.InstanceStateName{"i-0123": tt.ec2State})
+			if tt.failRds {
+				rdsFake.Fail("StartDBInstance", "my-db", errors.New("InvalidDBInstanceState"))
+			}
+			clients := ClientSet{Ec2Client: ec2Fake, RdsClient: rdsFake}
+
+			err := Start(t.Context(), clients, testGroup, Options{NoWait: true})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Start() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				var partial *common.PartialFailureError
+				if !errors.As(err, &partial) {
+					t.Errorf("Start() error = %T, want *common.PartialFailureError", err)
+				}
+			}
+			if got := rdsFake.CallCount("StartDBInstance"); got != tt.wantRdsStarts {
+				t.Errorf("StartDBInstance の呼び出し回数 = %d, want %d", got, tt.wantRdsStarts)
+			}
+			if got := ec2Fake.CallCount("StartInstances"); got != tt.wantEc2Starts {
+				t.Errorf("StartInstances の呼び出し回数 = %d, want %d", got, tt.wantEc2Starts)
+			}
+		})
+	}
+}
+
+func TestStopReverseOrder(t *testing.T) {
+	rdsFake := fakeaws.NewRds(map[string]string{"my-db": "available"}, map[string]string{"my-cluster": "available"})
+	ec2Fake := fakeaws.NewEc2(map[string]types.InstanceStateName{"i-0123": types.InstanceStateNameRunning})
+	ec2Fake.Fail("StopInstances", "i-0123", errors.New("UnauthorizedOperation"))
+	clients := ClientSet{Ec2Client: ec2Fake, RdsClient: rdsFake}
+
+	if err := Stop(t.Context(), clients, testGroup, Options{NoWait: true}); err == nil {
+		t.Fatal("Stop() error = nil, want error")
+	}
+	// 停止は app → db の順のため、app の失敗で db は停止しない
+	if got := rdsFake.CallCount("StopDBInstance") + rdsFake.CallCount("StopDBCluster"); got != 0 {
+		t.Errorf("db の停止が呼ばれた: %v", rdsFake.Calls())
+	}
+}
+
+func TestWaitTierTimeout(t *testing.T) {
+	pollInterval = 100 * time.Millisecond
+	t.Cleanup(func() { pollInterval = 15 * time.Second })
+
+	rdsFake := fakeaws.NewRds(map[string]string{"my-db": "starting", "my-other-db": "available"}, nil)
+	clients := ClientSet{RdsClient: rdsFake}
+	tier := Tier{Name: "db", Resources: []Resource{{Type: TypeRds, Id: "my-db"}, {Type: TypeRds, Id: "my-other-db"}}}
+
+	err := waitTier(t.Context(), clients, tier, startOperation, 1)
+	var timeout *common.TimeoutError
+	if !errors.As(err, &timeout) {
+		t.Fatalf("waitTier() error = %v, want *common.TimeoutError", err)
+	}
+}
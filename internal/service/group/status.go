@@ -0,0 +1,82 @@
+package group
+
+import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
+	"context"
+)
+
+// GetStatus はグループ内の各リソースの状態を段階の順に取得します
+// 状態を取得できなかったリソースはエラー内容を State に設定します
+func GetStatus(ctx context.Context, clients ClientSet, g Group) []Status {
+	type entry struct {
+		tier     string
+		resource Resource
+	}
+	var entries []entry
+	for _, tier := range g.Tiers {
+		for _, r := range tier.Resources {
+			entries = append(entries, entry{tier: tier.Name, resource: r})
+		}
+	}
+
+	results := common.Run(ctx, entries, func(ctx context.Context, e entry) (Status, error) {
+		state, phase, err := kinds[e.resource.Type].status(ctx, clients, e.resource)
+		if err != nil {
+			return Status{}, err
+		}
+		return Status{State: state, Phase: phase}, nil
+	}, nil)
+
+	statuses := make([]Status, len(entries))
+	for i, e := range entries {
+		s := results[i].Value
+		if results[i].Err != nil {
+			s = Status{State: results[i].Err.Error(), Phase: PhaseUnknown}
+		}
+		s.Tier, s.Type, s.Id = e.tier, e.resource.Type, e.resource.Id
+		statuses[i] = s
+	}
+	return statuses
+}
+
+// ShowStatus はグループ内の各リソースの状態を表示します
+func ShowStatus(ctx context.Context, clients ClientSet, g Group) error {
+	return common.DisplayList(
+		GetStatus(ctx, clients, g),
+		i18n.Tf("group.status_title", g.Name),
+		statusesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("group.empty"),
+		},
+	)
+}
+
+// statusesToTableData はリソースの状態をテーブル表示用のデータに変換する
+func statusesToTableData(statuses []Status) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("header.tier")},
+		{Header: i18n.T("header.resource_type")},
+		{Header: i18n.T("header.resource_id")},
+		{Header: i18n.T("header.state")},
+	}
+	data := make([][]string, len(statuses))
+	for i, s := range statuses {
+		data[i] = []string{s.Tier, kinds[s.Type].label, s.Id, phaseIcon(s.Phase) + " " + s.State}
+	}
+	return columns, data
+}
+
+// phaseIcon は段階に応じた絵文字を返す
+func phaseIcon(phase Phase) string {
+	switch phase {
+	case PhaseRunning:
+		return "🟢"
+	case PhaseStopped:
+		return "🔴"
+	case PhaseStarting, PhaseStopping:
+		return "🟡"
+	}
+	return "⚪"
+}
@@ -0,0 +1,92 @@
+package group
+
+import (
+	aurorasvc "awstk/internal/service/aurora"
+	canarysvc "awstk/internal/service/canary"
+	ec2svc "awstk/internal/service/ec2"
+	ecssvc "awstk/internal/service/ecs"
+	rdssvc "awstk/internal/service/rds"
+	schedulesvc "awstk/internal/service/schedule"
+	"awstk/internal/service/tagging"
+)
+
+// RdsAPI はRDSインスタンスとAurora DBクラスターの起動・停止に利用するRDS APIのインターフェース
+type RdsAPI interface {
+	rdssvc.API
+	aurorasvc.API
+}
+
+// ClientSet はグループの起動・停止に必要なクライアントをまとめた構造体
+type ClientSet struct {
+	Ec2Client         ec2svc.API
+	RdsClient         RdsAPI
+	EcsClient         ecssvc.API
+	AutoScalingClient ecssvc.AutoScalingAPI
+	CanaryClient      canarysvc.API
+	EventBridgeClient schedulesvc.EventBridgeAPI
+	SchedulerClient   schedulesvc.SchedulerAPI
+	TagClient         tagging.API
+}
+
+// リソースの種類（設定ファイルの type に指定する値）
+const (
+	TypeEc2      = "ec2"
+	TypeRds      = "rds"
+	TypeAurora   = "aurora"
+	TypeEcs      = "ecs"
+	TypeCanary   = "canary"
+	TypeSchedule = "schedule"
+)
+
+// ECSサービスのキャパシティの既定値（設定ファイルで min / max を省略した場合）
+const (
+	DefaultMinCapacity = 1
+	DefaultMaxCapacity = 2
+)
+
+// Phase はリソースの起動・停止の段階
+type Phase string
+
+const (
+	PhaseRunning  Phase = "running"
+	PhaseStarting Phase = "starting"
+	PhaseStopping Phase = "stopping"
+	PhaseStopped  Phase = "stopped"
+	PhaseUnknown  Phase = "unknown"
+)
+
+// Resource はグループに含まれる個々のリソース
+type Resource struct {
+	Type        string // リソースの種類 (e.g., ec2, rds, ecs)
+	Id          string // リソースID（ECSサービスは <クラスター名>/<サービス名>）
+	MinCapacity int    // ECSサービスの起動時の最小キャパシティ
+	MaxCapacity int    // ECSサービスの起動時の最大キャパシティ
+}
+
+// Tier は同時に起動・停止するリソースの段階
+type Tier struct {
+	Name      string
+	Resources []Resource
+}
+
+// Group はリソースIDまで解決済みのグループ
+// Tiers は起動する順に並び、停止時は逆順に処理する
+type Group struct {
+	Name  string
+	Tiers []Tier
+}
+
+// Status はリソースの現在の状態
+type Status struct {
+	Tier  string
+	Type  string
+	Id    string
+	State string // サービスごとの状態 (e.g., running, available, 2/2)
+	Phase Phase
+}
+
+// Options はグループの起動・停止のオプション
+type Options struct {
+	NoWait         bool // 次の段階に進む前に起動・停止の完了を待たない
+	TimeoutSeconds int  // 1段階あたりの待機タイムアウト（秒）
+}
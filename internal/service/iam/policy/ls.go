@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
@@ -13,28 +14,28 @@ import (
 // ListOptions is defined in types.go
 
 // List prints IAM customer managed policies. If UnattachedOnly is true, only unattached ones are printed.
-func List(client *sdkiam.Client, opts ListOptions) error {
+func List(ctx context.Context, client API, opts ListOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
 
 	if opts.UnattachedOnly {
-		items, err := listUnusedPolicies(client, opts)
+		items, err := listUnusedPolicies(ctx, client, opts)
 		if err != nil {
 			return err
 		}
-		_ = common.DisplayList(items, "未使用のカスタマー管理ポリシー", toUnusedPoliciesTable, &common.DisplayOptions{
+		_ = common.DisplayList(items, common.GenerateFilteredTitle(i18n.T("resource.customer_policy"), i18n.T("condition.unused")), toUnusedPoliciesTable, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のカスタマー管理ポリシー"),
+			EmptyMessage: common.FormatNoMatchMessage(i18n.T("resource.customer_policy")),
 		})
 		return nil
 	}
 
-	items, err := listAllPolicies(client, opts)
+	items, err := listAllPolicies(ctx, client, opts)
 	if err != nil {
 		return err
 	}
-	_ = common.DisplayList(items, "カスタマー管理ポリシー一覧", toPolicyItemsTable, &common.DisplayOptions{ShowCount: true})
+	_ = common.DisplayList(items, common.GenerateFilteredTitle(i18n.T("resource.customer_policy")), toPolicyItemsTable, &common.DisplayOptions{ShowCount: true})
 	return nil
 }
 
@@ -42,13 +43,13 @@ func List(client *sdkiam.Client, opts ListOptions) error {
 
 // PolicyItem and UnusedPolicy are defined in types.go
 
-func listAllPolicies(client *sdkiam.Client, opts ListOptions) ([]PolicyItem, error) {
+func listAllPolicies(ctx context.Context, client API, opts ListOptions) ([]PolicyItem, error) {
 	paginator := sdkiam.NewListPoliciesPaginator(client, &sdkiam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
 	var items []PolicyItem
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("カスタマー管理ポリシー", err)
+			return nil, common.FormatListError(i18n.T("resource.customer_policy"), err)
 		}
 		for _, p := range page.Policies {
 			name := aws.ToString(p.PolicyName)
@@ -65,13 +66,13 @@ func listAllPolicies(client *sdkiam.Client, opts ListOptions) ([]PolicyItem, err
 	return items, nil
 }
 
-func listUnusedPolicies(client *sdkiam.Client, opts ListOptions) ([]UnusedPolicy, error) {
+func listUnusedPolicies(ctx context.Context, client API, opts ListOptions) ([]UnusedPolicy, error) {
 	paginator := sdkiam.NewListPoliciesPaginator(client, &sdkiam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
 	var out []UnusedPolicy
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("カスタマー管理ポリシー", err)
+			return nil, common.FormatListError(i18n.T("resource.customer_policy"), err)
 		}
 		for _, pol := range page.Policies {
 			name := aws.ToString(pol.PolicyName)
@@ -104,7 +105,7 @@ func matchesAnyFilter(name string, filters []string) bool {
 }
 
 func toUnusedPoliciesTable(items []UnusedPolicy) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ポリシー名"}, {Header: "理由"}}
+	cols := []common.TableColumn{{Header: i18n.T("header.policy_name")}, {Header: i18n.T("header.reason")}}
 	rows := make([][]string, len(items))
 	for i, p := range items {
 		rows[i] = []string{p.Name, p.Note}
@@ -113,7 +114,7 @@ func toUnusedPoliciesTable(items []UnusedPolicy) ([]common.TableColumn, [][]stri
 }
 
 func toPolicyItemsTable(items []PolicyItem) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ポリシー名"}, {Header: "attachments"}}
+	cols := []common.TableColumn{{Header: i18n.T("header.policy_name")}, {Header: "attachments"}}
 	rows := make([][]string, len(items))
 	for i, p := range items {
 		rows[i] = []string{p.Name, fmt.Sprintf("%d", p.AttachmentCount)}
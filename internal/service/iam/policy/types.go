@@ -1,5 +1,16 @@
 package policy
 
+import (
+	"context"
+
+	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// API is the subset of the IAM API used by this package.
+type API interface {
+	ListPolicies(ctx context.Context, params *sdkiam.ListPoliciesInput, optFns ...func(*sdkiam.Options)) (*sdkiam.ListPoliciesOutput, error)
+}
+
 // ListOptions defines options for listing IAM policies.
 type ListOptions struct {
 	UnattachedOnly bool
@@ -1,11 +1,11 @@
 package role
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -16,41 +16,41 @@ import (
 // ListOptions is defined in types.go
 
 // List prints IAM roles. If UnusedDays > 0, it prints only unused roles.
-func List(client *sdkiam.Client, opts ListOptions) error {
+func List(ctx context.Context, client API, opts ListOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
 
 	// -1: 引数なし（never used のみ）  /  >0: 指定日数以上未使用
 	if opts.UnusedDays == -1 {
-		items, err := listNeverUsedRoles(client, opts)
+		items, err := listNeverUsedRoles(ctx, client, opts)
 		if err != nil {
 			return err
 		}
-		_ = common.DisplayList(items, "未使用のIAMロール", toUnusedRolesTable, &common.DisplayOptions{
+		_ = common.DisplayList(items, common.GenerateFilteredTitle(i18n.T("resource.iam_role"), i18n.T("condition.unused")), toUnusedRolesTable, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のIAMロール"),
+			EmptyMessage: common.FormatNoMatchMessage(i18n.T("resource.iam_role")),
 		})
 		return nil
 	}
 
 	if opts.UnusedDays > 0 {
-		items, err := listUnusedRoles(client, opts)
+		items, err := listUnusedRoles(ctx, client, opts)
 		if err != nil {
 			return err
 		}
-		_ = common.DisplayList(items, "未使用のIAMロール", toUnusedRolesTable, &common.DisplayOptions{
+		_ = common.DisplayList(items, common.GenerateFilteredTitle(i18n.T("resource.iam_role"), i18n.T("condition.unused")), toUnusedRolesTable, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のIAMロール"),
+			EmptyMessage: common.FormatNoMatchMessage(i18n.T("resource.iam_role")),
 		})
 		return nil
 	}
 
-	items, err := listAllRoles(client, opts)
+	items, err := listAllRoles(ctx, client, opts)
 	if err != nil {
 		return err
 	}
-	_ = common.DisplayList(items, "IAMロール一覧", toRoleItemsTable, &common.DisplayOptions{ShowCount: true})
+	_ = common.DisplayList(items, common.GenerateFilteredTitle(i18n.T("resource.iam_role")), toRoleItemsTable, &common.DisplayOptions{ShowCount: true})
 	return nil
 }
 
@@ -58,13 +58,13 @@ func List(client *sdkiam.Client, opts ListOptions) error {
 
 // RoleItem and UnusedRole are defined in types.go
 
-func listAllRoles(client *sdkiam.Client, opts ListOptions) ([]RoleItem, error) {
+func listAllRoles(ctx context.Context, client API, opts ListOptions) ([]RoleItem, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("resource.iam_role"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
@@ -87,36 +87,25 @@ func listAllRoles(client *sdkiam.Client, opts ListOptions) ([]RoleItem, error) {
 	}
 
 	// last-used 並列取得
-	exec := common.NewParallelExecutor(8)
-	var mu sync.Mutex
-	for i := range roleItems {
-		idx := i
-		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleItems[idx].Name)})
-			if err != nil {
-				return
-			}
-			var last *time.Time
-			if outRole.Role.RoleLastUsed != nil && outRole.Role.RoleLastUsed.LastUsedDate != nil {
-				t := *outRole.Role.RoleLastUsed.LastUsedDate
-				last = &t
-			}
-			mu.Lock()
-			roleItems[idx].LastUsed = last
-			mu.Unlock()
-		})
+	names := make([]string, len(roleItems))
+	for i, item := range roleItems {
+		names[i] = item.Name
+	}
+	for i, r := range getRoles(ctx, client, names) {
+		if r.Err == nil {
+			roleItems[i].LastUsed = lastUsedDate(r.Value)
+		}
 	}
-	exec.Wait()
 	return roleItems, nil
 }
 
-func listUnusedRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
+func listUnusedRoles(ctx context.Context, client API, opts ListOptions) ([]UnusedRole, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("resource.iam_role"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
@@ -136,34 +125,44 @@ func listUnusedRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, err
 		names = append(names, name)
 	}
 
-	exec := common.NewParallelExecutor(8)
-	var mu sync.Mutex
 	var out []UnusedRole
-	for _, rn := range names {
-		roleName := rn
-		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleName)})
-			if err != nil {
-				return
-			}
-			var last *time.Time
-			if outRole.Role.RoleLastUsed != nil && outRole.Role.RoleLastUsed.LastUsedDate != nil {
-				t := *outRole.Role.RoleLastUsed.LastUsedDate
-				last = &t
-			}
-			if last == nil || last.Before(cutoff) {
-				mu.Lock()
-				out = append(out, UnusedRole{Name: roleName, Arn: aws.ToString(outRole.Role.Arn), LastUsed: last})
-				mu.Unlock()
-			}
-		})
+	for i, r := range getRoles(ctx, client, names) {
+		if r.Err != nil {
+			continue
+		}
+		last := lastUsedDate(r.Value)
+		if last == nil || last.Before(cutoff) {
+			out = append(out, UnusedRole{Name: names[i], Arn: aws.ToString(r.Value.Arn), LastUsed: last})
+		}
 	}
-	exec.Wait()
 	return out, nil
 }
 
 // ===== 判定/整形ヘルパー =====
 
+// maxRoleWorkers はロール詳細取得の同時実行数
+const maxRoleWorkers = 8
+
+// getRoles はロール詳細（最終使用日時を含む）を並列で取得し、names と同じ順序で結果を返す
+func getRoles(ctx context.Context, client API, names []string) []common.Result[*types.Role] {
+	return common.Run(ctx, names, func(ctx context.Context, name string) (*types.Role, error) {
+		out, err := client.GetRole(ctx, &sdkiam.GetRoleInput{RoleName: aws.String(name)})
+		if err != nil {
+			return nil, err
+		}
+		return out.Role, nil
+	}, &common.RunOptions{Concurrency: maxRoleWorkers, Progress: "ロール情報取得中"})
+}
+
+// lastUsedDate はロールの最終使用日時を返す（未使用の場合はnil）
+func lastUsedDate(role *types.Role) *time.Time {
+	if role.RoleLastUsed == nil || role.RoleLastUsed.LastUsedDate == nil {
+		return nil
+	}
+	t := *role.RoleLastUsed.LastUsedDate
+	return &t
+}
+
 func isServiceLinkedRole(r types.Role) bool    { return aws.ToString(r.Path) == "/aws-service-role/" }
 func isServiceLinkedRoleName(name string) bool { return strings.HasPrefix(name, "AWSServiceRoleFor") }
 func matchesAnyFilter(name string, filters []string) bool {
@@ -191,7 +190,7 @@ func formatLastUsedLocal(t *time.Time) string {
 }
 
 func toRoleItemsTable(items []RoleItem) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ロール名"}, {Header: "最終使用"}, {Header: "備考"}}
+	cols := []common.TableColumn{{Header: i18n.T("header.role_name")}, {Header: i18n.T("header.last_used")}, {Header: i18n.T("header.note")}}
 	rows := make([][]string, len(items))
 	for i, r := range items {
 		note := ""
@@ -204,7 +203,7 @@ func toRoleItemsTable(items []RoleItem) ([]common.TableColumn, [][]string) {
 }
 
 func toUnusedRolesTable(items []UnusedRole) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ロール名"}, {Header: "最終使用"}}
+	cols := []common.TableColumn{{Header: i18n.T("header.role_name")}, {Header: i18n.T("header.last_used")}}
 	rows := make([][]string, len(items))
 	for i, r := range items {
 		rows[i] = []string{r.Name, formatLastUsedLocal(r.LastUsed)}
@@ -214,13 +213,13 @@ func toUnusedRolesTable(items []UnusedRole) ([]common.TableColumn, [][]string) {
 
 // ===== never used 抽出 =====
 
-func listNeverUsedRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
+func listNeverUsedRoles(ctx context.Context, client API, opts ListOptions) ([]UnusedRole, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("resource.iam_role"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
@@ -238,28 +237,15 @@ func listNeverUsedRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole,
 		names = append(names, name)
 	}
 
-	exec := common.NewParallelExecutor(8)
-	var mu sync.Mutex
 	var out []UnusedRole
-	for _, rn := range names {
-		roleName := rn
-		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleName)})
-			if err != nil {
-				return
-			}
-			var last *time.Time
-			if outRole.Role.RoleLastUsed != nil && outRole.Role.RoleLastUsed.LastUsedDate != nil {
-				t := *outRole.Role.RoleLastUsed.LastUsedDate
-				last = &t
-			}
-			if last == nil {
-				mu.Lock()
-				out = append(out, UnusedRole{Name: roleName, Arn: aws.ToString(outRole.Role.Arn), LastUsed: last})
-				mu.Unlock()
-			}
-		})
+	for i, r := range getRoles(ctx, client, names) {
+		if r.Err != nil {
+			continue
+		}
+		last := lastUsedDate(r.Value)
+		if last == nil {
+			out = append(out, UnusedRole{Name: names[i], Arn: aws.ToString(r.Value.Arn), LastUsed: last})
+		}
 	}
-	exec.Wait()
 	return out, nil
 }
@@ -1,6 +1,17 @@
 package role
 
-import "time"
+import (
+	"context"
+	"time"
+
+	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// API is the subset of the IAM API used by this package.
+type API interface {
+	ListRoles(ctx context.Context, params *sdkiam.ListRolesInput, optFns ...func(*sdkiam.Options)) (*sdkiam.ListRolesOutput, error)
+	GetRole(ctx context.Context, params *sdkiam.GetRoleInput, optFns ...func(*sdkiam.Options)) (*sdkiam.GetRoleOutput, error)
+}
 
 // ListOptions defines options for listing IAM roles.
 type ListOptions struct {
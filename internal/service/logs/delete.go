@@ -4,73 +4,66 @@ import (
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
-	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 )
 
 // DeleteLogGroups は指定されたオプションに基づいてロググループを削除します
-func DeleteLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) error {
+func DeleteLogGroups(ctx context.Context, client API, opts DeleteOptions) error {
 	// 削除対象のロググループを収集
-	targetGroups, err := collectTargetLogGroups(client, opts)
+	targetGroups, err := CollectTargetLogGroups(ctx, client, opts)
 	if err != nil {
 		return fmt.Errorf("削除対象の収集に失敗: %w", err)
 	}
 
 	if len(targetGroups) == 0 {
-		fmt.Println("削除対象のロググループがありません")
-		return nil
+		return common.NotFoundf("削除対象のロググループがありません")
 	}
 
-	// 並列実行数を設定（最大20並列）
-	maxWorkers := 20
-	if len(targetGroups) < maxWorkers {
-		maxWorkers = len(targetGroups)
-	}
+	common.Progressf("🗑️  %d個のロググループを最大%d並列で削除します...\n\n", len(targetGroups), resolveDeleteWorkers(len(targetGroups)))
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(targetGroups))
-	resultsMutex := &sync.Mutex{}
-
-	fmt.Printf("🗑️  %d個のロググループを最大%d並列で削除します...\n\n", len(targetGroups), maxWorkers)
-
-	for i, logGroupName := range targetGroups {
-		idx := i
-		groupName := logGroupName
-		executor.Execute(func() {
-			fmt.Printf("削除中: %s ... ", groupName)
-
-			_, err := client.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
-				LogGroupName: &groupName,
-			})
-
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ 失敗 (%v)\n", err)
-				results[idx] = common.ProcessResult{Item: groupName, Success: false, Error: err}
-			} else {
-				fmt.Println("✅ 完了")
-				results[idx] = common.ProcessResult{Item: groupName, Success: true}
-			}
-			resultsMutex.Unlock()
-		})
+	results := deleteLogGroups(ctx, client, targetGroups)
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("削除中: %s ... ❌ 失敗 (%v)\n", targetGroups[i], r.Err)
+		} else {
+			common.Progressf("削除中: %s ... ✅ 完了\n", targetGroups[i])
+		}
 	}
 
-	executor.Wait()
-
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	successCount, failCount := common.CountResults(results)
+	common.Progressf("\n削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
 
-	if failCount > 0 {
-		return fmt.Errorf("%d個のロググループの削除に失敗しました", failCount)
+	return common.CollectFailures("ロググループの削除", targetGroups, results)
+}
+
+// DeleteActions は指定されたオプションに基づいてロググループ削除の実行計画アクションを生成します
+func DeleteActions(ctx context.Context, client API, opts DeleteOptions) ([]common.Action, error) {
+	targetGroups, err := CollectTargetLogGroups(ctx, client, opts)
+	if err != nil {
+		return nil, fmt.Errorf("削除対象の収集に失敗: %w", err)
 	}
+	return CleanupActions(targetGroups), nil
+}
 
-	return nil
+// CleanupActions はロググループ削除の実行計画アクションを生成します
+func CleanupActions(logGroupNames []string) []common.Action {
+	actions := make([]common.Action, 0, len(logGroupNames))
+	for _, name := range logGroupNames {
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceLogGroup,
+			ResourceId:   name,
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+			Detail:       "ログストリームごと削除",
+		})
+	}
+	return actions
 }
 
-// collectTargetLogGroups は削除対象のロググループを収集します
-func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) ([]string, error) {
+// CollectTargetLogGroups は削除対象のロググループを収集します（位置引数で指定したものとフィルターに一致するもの）
+func CollectTargetLogGroups(ctx context.Context, client API, opts DeleteOptions) ([]string, error) {
 	var targetGroups []string
 
 	// 位置引数で指定されたロググループを追加
@@ -81,7 +74,7 @@ func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) (
 	// フィルターが指定されている場合
 	if opts.Filter != "" {
 		// すべてのロググループを取得
-		allGroups, err := ListLogGroups(client)
+		allGroups, err := ListLogGroups(ctx, client)
 		if err != nil {
 			return nil, err
 		}
@@ -108,9 +101,9 @@ func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) (
 }
 
 // GetLogGroupsByFilter はフィルターに一致するロググループを取得します（cleanup allから呼ばれる用）
-func GetLogGroupsByFilter(client *cloudwatchlogs.Client, searchString string) ([]string, error) {
+func GetLogGroupsByFilter(ctx context.Context, client API, searchString string) ([]string, error) {
 	// すべてのロググループを取得
-	allGroups, err := ListLogGroups(client)
+	allGroups, err := ListLogGroups(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("ロググループ一覧取得エラー: %w", err)
 	}
@@ -119,7 +112,7 @@ func GetLogGroupsByFilter(client *cloudwatchlogs.Client, searchString string) ([
 	for _, group := range allGroups {
 		if common.MatchesFilter(*group.LogGroupName, searchString) {
 			matchedGroups = append(matchedGroups, *group.LogGroupName)
-			fmt.Printf("🔍 検出されたロググループ: %s\n", *group.LogGroupName)
+			common.Progressf("🔍 検出されたロググループ: %s\n", *group.LogGroupName)
 		}
 	}
 
@@ -127,50 +120,43 @@ func GetLogGroupsByFilter(client *cloudwatchlogs.Client, searchString string) ([
 }
 
 // CleanupLogGroups は指定したロググループ一覧を削除します（cleanup allから呼ばれる用）
-func CleanupLogGroups(client *cloudwatchlogs.Client, logGroupNames []string) error {
+func CleanupLogGroups(ctx context.Context, client API, logGroupNames []string) error {
 	if len(logGroupNames) == 0 {
 		return nil
 	}
 
-	// 並列実行数を設定（最大20並列）
-	maxWorkers := 20
-	if len(logGroupNames) < maxWorkers {
-		maxWorkers = len(logGroupNames)
-	}
+	common.Progressf("🚀 %d個のロググループを最大%d並列で削除します...\n\n", len(logGroupNames), resolveDeleteWorkers(len(logGroupNames)))
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(logGroupNames))
-	resultsMutex := &sync.Mutex{}
-
-	fmt.Printf("🚀 %d個のロググループを最大%d並列で削除します...\n\n", len(logGroupNames), maxWorkers)
-
-	for i, logGroupName := range logGroupNames {
-		idx := i
-		groupName := logGroupName
-		executor.Execute(func() {
-			fmt.Printf("ロググループ %s を削除中...\n", groupName)
-
-			_, err := client.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
-				LogGroupName: &groupName,
-			})
-
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ ロググループ %s の削除に失敗しました: %v\n", groupName, err)
-				results[idx] = common.ProcessResult{Item: groupName, Success: false, Error: err}
-			} else {
-				fmt.Printf("✅ ロググループ %s を削除しました\n", groupName)
-				results[idx] = common.ProcessResult{Item: groupName, Success: true}
-			}
-			resultsMutex.Unlock()
-		})
+	results := deleteLogGroups(ctx, client, logGroupNames)
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ ロググループ %s の削除に失敗しました: %v\n", logGroupNames[i], r.Err)
+		} else {
+			common.Progressf("✅ ロググループ %s を削除しました\n", logGroupNames[i])
+		}
 	}
 
-	executor.Wait()
-
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	successCount, failCount := common.CountResults(results)
+	common.Progressf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
 
-	return nil
+	return common.CollectFailures("ロググループの削除", logGroupNames, results)
+}
+
+// maxDeleteWorkers はロググループ削除の既定の同時実行数
+const maxDeleteWorkers = 20
+
+// resolveDeleteWorkers はロググループ削除の実際の同時実行数を返します
+func resolveDeleteWorkers(count int) int {
+	return min(common.ResolveConcurrency(maxDeleteWorkers), count)
+}
+
+// deleteLogGroups はロググループを並列で削除し、logGroupNames と同じ順序で結果を返します
+func deleteLogGroups(ctx context.Context, client API, logGroupNames []string) []common.Result[struct{}] {
+	return common.Run(ctx, logGroupNames, func(ctx context.Context, groupName string) (struct{}, error) {
+		_, err := client.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{
+			LogGroupName: &groupName,
+		})
+		return struct{}{}, err
+	}, &common.RunOptions{Concurrency: maxDeleteWorkers, Progress: "ロググループ削除中"})
 }
@@ -0,0 +1,117 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+)
+
+var _ API = (*fakeaws.Logs)(nil)
+
+func TestDeleteLogGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       DeleteOptions
+		failId     string
+		wantExit   int // common.ExitCode(err) の期待値
+		wantExists map[string]bool
+	}{
+		{
+			name: "フィルターに一致するロググループを削除",
+			opts: DeleteOptions{Filter: "/aws/lambda/dev-*"},
+			wantExists: map[string]bool{
+				"/aws/lambda/dev-api":   false,
+				"/aws/lambda/dev-batch": false,
+				"/aws/lambda/prod-api":  true,
+				"/ecs/dev-web":          true,
+			},
+		},
+		{
+			name: "空のロググループのみ削除",
+			opts: DeleteOptions{Filter: "dev", EmptyOnly: true},
+			wantExists: map[string]bool{
+				"/aws/lambda/dev-api":   true,
+				"/aws/lambda/dev-batch": false,
+				"/aws/lambda/prod-api":  true,
+				"/ecs/dev-web":          true,
+			},
+		},
+		{
+			name: "保存期間未設定のロググループのみ削除",
+			opts: DeleteOptions{Filter: "dev", NoRetention: true},
+			wantExists: map[string]bool{
+				"/aws/lambda/dev-api":   true,
+				"/aws/lambda/dev-batch": false,
+				"/aws/lambda/prod-api":  true,
+				"/ecs/dev-web":          false,
+			},
+		},
+		{
+			name: "位置引数とフィルターの重複は1回だけ削除",
+			opts: DeleteOptions{LogGroups: []string{"/ecs/dev-web"}, Filter: "/ecs/"},
+			wantExists: map[string]bool{
+				"/aws/lambda/dev-api":   true,
+				"/aws/lambda/dev-batch": true,
+				"/aws/lambda/prod-api":  true,
+				"/ecs/dev-web":          false,
+			},
+		},
+		{
+			name:     "条件に一致するロググループがなければ対象なし",
+			opts:     DeleteOptions{Filter: "staging"},
+			wantExit: common.ExitNotFound,
+			wantExists: map[string]bool{
+				"/ecs/dev-web": true,
+			},
+		},
+		{
+			name:     "存在しないロググループの指定は対象なしとしてエラーを返す",
+			opts:     DeleteOptions{LogGroups: []string{"/not/found"}},
+			wantExit: common.ExitNotFound,
+			wantExists: map[string]bool{
+				"/ecs/dev-web": true,
+			},
+		},
+		{
+			name:     "一部の削除に失敗したら部分失敗を返す",
+			opts:     DeleteOptions{Filter: "dev"},
+			failId:   "/aws/lambda/dev-api",
+			wantExit: common.ExitPartialFailure,
+			wantExists: map[string]bool{
+				"/aws/lambda/dev-api":   true,
+				"/aws/lambda/dev-batch": false,
+				"/ecs/dev-web":          false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewLogs(
+				fakeaws.LogGroup("/aws/lambda/dev-api", 2048, 14),
+				fakeaws.LogGroup("/aws/lambda/dev-batch", 0, 0),
+				fakeaws.LogGroup("/aws/lambda/prod-api", 0, 0),
+				fakeaws.LogGroup("/ecs/dev-web", 4096, 0),
+			)
+			fake.PageSize = 2
+			if tt.failId != "" {
+				fake.Fail("DeleteLogGroup", tt.failId, errors.New("access denied"))
+			}
+
+			err := DeleteLogGroups(t.Context(), fake, tt.opts)
+			if got := common.ExitCode(err); got != tt.wantExit {
+				t.Fatalf("ExitCode(DeleteLogGroups()) = %d, want %d (error = %v)", got, tt.wantExit, err)
+			}
+			for name, want := range tt.wantExists {
+				if got := fake.HasLogGroup(name); got != want {
+					t.Errorf("log group %s exists = %v, want %v", name, got, want)
+				}
+			}
+			if got := fake.CallCount("DeleteLogGroup:/ecs/dev-web"); got > 1 {
+				t.Errorf("DeleteLogGroup called %d times for the same group", got)
+			}
+		})
+	}
+}
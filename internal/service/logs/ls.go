@@ -1,16 +1,18 @@
 package logs
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
 // ListLogGroups はCloudWatch Logsグループの一覧を取得する関数
-func ListLogGroups(client *cloudwatchlogs.Client) ([]types.LogGroup, error) {
+func ListLogGroups(ctx context.Context, client API) ([]types.LogGroup, error) {
 	var logGroups []types.LogGroup
 	var nextToken *string
 
@@ -19,7 +21,7 @@ func ListLogGroups(client *cloudwatchlogs.Client) ([]types.LogGroup, error) {
 			NextToken: nextToken,
 		}
 
-		result, err := client.DescribeLogGroups(context.Background(), input)
+		result, err := client.DescribeLogGroups(ctx, input)
 		if err != nil {
 			return nil, fmt.Errorf("ログループ一覧取得エラー: %w", err)
 		}
@@ -35,6 +37,79 @@ func ListLogGroups(client *cloudwatchlogs.Client) ([]types.LogGroup, error) {
 	return logGroups, nil
 }
 
+// GetLogGroupInfos はロググループ一覧を取得し、opts の条件で絞り込んだ結果を出力用の構造体で返す
+func GetLogGroupInfos(ctx context.Context, client API, opts ListOptions) ([]LogGroupInfo, error) {
+	logGroups, err := ListLogGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if opts.EmptyOnly {
+		logGroups = FilterEmptyLogGroups(logGroups)
+	}
+	if opts.NoRetention {
+		logGroups = FilterNoRetentionLogGroups(logGroups)
+	}
+	return ToLogGroupInfos(logGroups), nil
+}
+
+// ListLogGroupsInRegions は複数リージョンのロググループを並列取得し、リージョン列付きで表示する
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListLogGroupsInRegions(ctx context.Context, regions []string, clientFor func(region string) API, opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]LogGroupInfo, error) {
+		return GetLogGroupInfos(ctx, clientFor(region), opts)
+	})
+
+	var conditions []string
+	if opts.EmptyOnly {
+		conditions = append(conditions, i18n.T("condition.empty"))
+	}
+	if opts.NoRetention {
+		conditions = append(conditions, i18n.T("condition.no_retention"))
+	}
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.log_group"), conditions...),
+		func(infos []LogGroupInfo) ([]common.TableColumn, [][]string) {
+			return logGroupsToTableData(infos, opts.ShowDetails)
+		},
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.log_group")),
+		},
+	)
+}
+
+// logGroupsToTableData はロググループ情報をテーブルデータに変換する
+func logGroupsToTableData(infos []LogGroupInfo, showDetails bool) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{{Header: i18n.T("header.log_group_name")}}
+	if showDetails {
+		columns = append(columns,
+			common.TableColumn{Header: i18n.T("header.size")},
+			common.TableColumn{Header: i18n.T("header.created")},
+			common.TableColumn{Header: i18n.T("header.retention")},
+		)
+	}
+
+	data := make([][]string, len(infos))
+	for i, info := range infos {
+		row := []string{info.LogGroupName}
+		if showDetails {
+			retention := "無期限"
+			if info.RetentionInDays != nil {
+				retention = fmt.Sprintf("%d日", *info.RetentionInDays)
+			}
+			row = append(row,
+				common.FormatBytes(info.StoredBytes),
+				common.FormatTimestamp(&info.CreationTime),
+				retention,
+			)
+		}
+		data[i] = row
+	}
+	return columns, data
+}
+
 // FilterEmptyLogGroups は空のログループのみを返す関数
 func FilterEmptyLogGroups(logGroups []types.LogGroup) []types.LogGroup {
 	var emptyGroups []types.LogGroup
@@ -61,6 +136,21 @@ func FilterNoRetentionLogGroups(logGroups []types.LogGroup) []types.LogGroup {
 	return noRetentionGroups
 }
 
+// ToLogGroupInfos はログループ一覧を出力用の構造体に変換する関数
+func ToLogGroupInfos(logGroups []types.LogGroup) []LogGroupInfo {
+	infos := make([]LogGroupInfo, 0, len(logGroups))
+	for _, group := range logGroups {
+		info := LogGroupInfo{
+			LogGroupName:    aws.ToString(group.LogGroupName),
+			StoredBytes:     aws.ToInt64(group.StoredBytes),
+			CreationTime:    aws.ToInt64(group.CreationTime),
+			RetentionInDays: group.RetentionInDays,
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
 // isLogGroupEmpty はログループが空かどうかを判定する関数
 func isLogGroupEmpty(group types.LogGroup) bool {
 	// StoredBytesが0または存在しない場合は空と判定
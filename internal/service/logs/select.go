@@ -0,0 +1,39 @@
+package logs
+
+import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// SelectLogGroups はロググループの一覧から削除対象を複数選択させます
+// opts の EmptyOnly / NoRetention が指定されている場合は、条件に一致するロググループのみを選択肢にします
+func SelectLogGroups(ctx context.Context, client API, opts DeleteOptions) ([]string, error) {
+	logGroups, err := ListLogGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if opts.EmptyOnly {
+		logGroups = FilterEmptyLogGroups(logGroups)
+	}
+	if opts.NoRetention {
+		logGroups = FilterNoRetentionLogGroups(logGroups)
+	}
+	if len(logGroups) == 0 {
+		return nil, common.NotFoundf("ロググループが見つかりませんでした")
+	}
+
+	items := make([]picker.Item, len(logGroups))
+	for i, group := range logGroups {
+		items[i] = picker.Item{
+			Label:  aws.ToString(group.LogGroupName),
+			Detail: common.FormatBytes(aws.ToInt64(group.StoredBytes)),
+		}
+	}
+	return picker.SelectMany(items, &picker.Options{
+		Prompt: "削除するロググループを選択してください",
+		Hint:   "ロググループ名または --filter",
+	})
+}
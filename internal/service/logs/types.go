@@ -1,9 +1,18 @@
 package logs
 
 import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
+// API はlogsパッケージが利用するCloudWatch Logs APIのインターフェース
+type API interface {
+	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	DeleteLogGroup(ctx context.Context, params *cloudwatchlogs.DeleteLogGroupInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error)
+}
+
 // LogGroupInfo はログループの情報を保持する構造体
 type LogGroupInfo struct {
 	LogGroupName    string
@@ -20,10 +29,17 @@ type LogGroupDetail struct {
 	IsEmpty     bool
 }
 
+// ListOptions はロググループ一覧表示のオプション
+type ListOptions struct {
+	EmptyOnly   bool `json:"emptyOnly,omitempty" desc:"空のロググループのみを対象にする"`          // 空のロググループのみを表示
+	NoRetention bool `json:"noRetention,omitempty" desc:"保存期間が未設定のロググループのみを対象にする"` // 保存期間未設定のロググループのみを表示
+	ShowDetails bool `json:"-"`                                                    // 詳細情報を表示
+}
+
 // DeleteOptions はログ削除時のオプション
 type DeleteOptions struct {
-	Filter      string   // フィルターパターン
-	LogGroups   []string // 削除対象のロググループ名
-	EmptyOnly   bool     // 空のロググループのみ削除
-	NoRetention bool     // 保存期間未設定のロググループのみ削除
+	Filter      string   `json:"filter,omitempty" desc:"削除対象のフィルターパターン（ワイルドカード対応）"`   // フィルターパターン
+	LogGroups   []string `json:"logGroups,omitempty" desc:"削除対象のロググループ名"`             // 削除対象のロググループ名
+	EmptyOnly   bool     `json:"emptyOnly,omitempty" desc:"空のロググループのみを削除する"`          // 空のロググループのみ削除
+	NoRetention bool     `json:"noRetention,omitempty" desc:"保存期間が未設定のロググループのみを削除する"` // 保存期間未設定のロググループのみ削除
 }
@@ -1,51 +1,88 @@
 package rds
 
 import (
+	"awstk/internal/i18n"
 	"context"
 	"fmt"
+	"slices"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 
 	"awstk/internal/service/cfn"
 	"awstk/internal/service/common"
+	"awstk/internal/service/tagging"
 )
 
 // ListRdsInstances cmdから呼ばれるメイン関数（Get + Display）
-func ListRdsInstances(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) error {
+func ListRdsInstances(ctx context.Context, clients ClientSet, opts ListOptions) error {
 	// Get: データ取得
-	instances, err := getRdsInstances(rdsClient, cfnClient, stackName)
+	instances, err := getRdsInstances(ctx, clients, opts)
 	if err != nil {
-		if stackName != "" {
+		if opts.StackName != "" {
 			return fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
 		}
-		return common.FormatListError("RDSインスタンス", err)
+		return common.FormatListError(i18n.T("resource.rds_instance"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		instances,
-		"RDSインスタンス一覧",
+		common.GenerateFilteredTitle(i18n.T("resource.rds_instance")),
 		rdsInstancesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "RDSインスタンスが見つかりませんでした",
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.rds_instance")),
+		},
+	)
+}
+
+// ListRdsInstancesInRegions 複数リージョンのRDSインスタンスを並列取得し、リージョン列付きで表示
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListRdsInstancesInRegions(ctx context.Context, regions []string, clientsFor func(region string) ClientSet, opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Instance, error) {
+		return getRdsInstances(ctx, clientsFor(region), opts)
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("resource.rds_instance")),
+		rdsInstancesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.rds_instance")),
 		},
 	)
 }
 
 // getRdsInstances データ取得内部関数
-func getRdsInstances(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	if stackName != "" {
-		return getRdsInstancesByStackName(rdsClient, cfnClient, stackName)
+func getRdsInstances(ctx context.Context, clients ClientSet, opts ListOptions) ([]Instance, error) {
+	var instances []Instance
+	var err error
+	if opts.StackName != "" {
+		instances, err = getRdsInstancesByStackName(ctx, clients.RdsClient, clients.CfnClient, opts.StackName)
+	} else {
+		instances, err = GetAllRdsInstances(ctx, clients.RdsClient)
+	}
+	if err != nil || len(opts.Tags) == 0 {
+		return instances, err
+	}
+
+	// タグで絞り込む
+	ids, err := opts.Tags.Names(ctx, clients.TagClient, tagging.ResourceRdsInstance)
+	if err != nil {
+		return nil, err
+	}
+	ids, err = ExcludeClusterMembers(ctx, clients.RdsClient, ids)
+	if err != nil {
+		return nil, err
 	}
-	return getAllRdsInstances(rdsClient)
+	return slices.DeleteFunc(instances, func(ins Instance) bool { return !slices.Contains(ids, ins.InstanceId) }), nil
 }
 
-// getAllRdsInstances 現在のリージョンの全RDSインスタンスを取得
-func getAllRdsInstances(rdsClient *rds.Client) ([]Instance, error) {
-	resp, err := rdsClient.DescribeDBInstances(context.Background(), &rds.DescribeDBInstancesInput{})
+// GetAllRdsInstances 現在のリージョンの全RDSインスタンスを取得
+func GetAllRdsInstances(ctx context.Context, rdsClient API) ([]Instance, error) {
+	resp, err := rdsClient.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{})
 	if err != nil {
 		return nil, fmt.Errorf("RDSインスタンス一覧の取得に失敗: %w", err)
 	}
@@ -62,9 +99,31 @@ func getAllRdsInstances(rdsClient *rds.Client) ([]Instance, error) {
 	return instances, nil
 }
 
+// ExcludeClusterMembers は ids からAuroraクラスターのメンバーのインスタンスを除外します
+// クラスターのメンバーはインスタンス単位で起動・停止できないため、タグで選んだインスタンスの絞り込みに使います
+func ExcludeClusterMembers(ctx context.Context, rdsClient API, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return ids, nil
+	}
+	members := make(map[string]bool)
+	paginator := rds.NewDescribeDBInstancesPaginator(rdsClient, &rds.DescribeDBInstancesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("RDSインスタンス一覧の取得に失敗: %w", err)
+		}
+		for _, db := range page.DBInstances {
+			if awssdk.ToString(db.DBClusterIdentifier) != "" {
+				members[awssdk.ToString(db.DBInstanceIdentifier)] = true
+			}
+		}
+	}
+	return slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return members[id] }), nil
+}
+
 // getRdsInstancesByStackName 指定されたCloudFormationスタック名でフィルタリングしたRDSインスタンス一覧を取得
-func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	ids, err := cfn.GetAllRdsFromStack(cfnClient, stackName)
+func getRdsInstancesByStackName(ctx context.Context, rdsClient API, cfnClient cfn.API, stackName string) ([]Instance, error) {
+	ids, err := cfn.GetAllRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +132,7 @@ func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation
 		return []Instance{}, nil
 	}
 
-	all, err := getAllRdsInstances(rdsClient)
+	all, err := GetAllRdsInstances(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +155,9 @@ func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation
 // rdsInstancesToTableData RDSインスタンス情報をテーブルデータに変換
 func rdsInstancesToTableData(instances []Instance) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "インスタンスID"},
-		{Header: "エンジン"},
-		{Header: "ステータス"},
+		{Header: i18n.T("header.instance_id")},
+		{Header: i18n.T("header.engine")},
+		{Header: i18n.T("header.status")},
 	}
 
 	data := make([][]string, len(instances))
@@ -0,0 +1,45 @@
+package rds
+
+import (
+	"slices"
+	"testing"
+
+	"awstk/internal/service/tagging"
+	"awstk/internal/testutil/fakeaws"
+)
+
+func TestGetRdsInstancesByTagExcludesClusterMembers(t *testing.T) {
+	rdsClient := fakeaws.NewRds(map[string]string{
+		"dev-db":            "available",
+		"dev-aurora-writer": "available",
+		"prd-db":            "available",
+	}, map[string]string{"dev-aurora": "available"})
+	rdsClient.ClusterMembers = map[string]string{"dev-aurora-writer": "dev-aurora"}
+	tagClient := fakeaws.NewTagging(
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:dev-db", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:dev-aurora-writer", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:rds:ap-northeast-1:123456789012:db:prd-db", Tags: map[string]string{"env": "prd"}},
+	)
+
+	instances, err := getRdsInstances(t.Context(), ClientSet{RdsClient: rdsClient, TagClient: tagClient}, ListOptions{
+		Tags: tagging.Selector{{Key: "env", Value: "dev", Operator: tagging.OperatorEquals}},
+	})
+	if err != nil {
+		t.Fatalf("getRdsInstances() error = %v", err)
+	}
+	var got []string
+	for _, ins := range instances {
+		got = append(got, ins.InstanceId)
+	}
+	if want := []string{"dev-db"}; !slices.Equal(got, want) {
+		t.Errorf("getRdsInstances() = %v, want %v", got, want)
+	}
+
+	ids, err := ExcludeClusterMembers(t.Context(), rdsClient, []string{"dev-aurora-writer", "prd-db"})
+	if err != nil {
+		t.Fatalf("ExcludeClusterMembers() error = %v", err)
+	}
+	if want := []string{"prd-db"}; !slices.Equal(ids, want) {
+		t.Errorf("ExcludeClusterMembers() = %v, want %v", ids, want)
+	}
+}
@@ -8,12 +8,12 @@ import (
 )
 
 // StartRdsInstance RDSインスタンスを起動する
-func StartRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func StartRdsInstance(ctx context.Context, rdsClient API, instanceId string) error {
 	input := &rds.StartDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StartDBInstance(context.Background(), input)
+	_, err := rdsClient.StartDBInstance(ctx, input)
 	if err != nil {
 		return fmt.Errorf("RDSインスタンス起動エラー: %w", err)
 	}
@@ -8,12 +8,12 @@ import (
 )
 
 // StopRdsInstance RDSインスタンスを停止する
-func StopRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func StopRdsInstance(ctx context.Context, rdsClient API, instanceId string) error {
 	input := &rds.StopDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StopDBInstance(context.Background(), input)
+	_, err := rdsClient.StopDBInstance(ctx, input)
 	if err != nil {
 		return fmt.Errorf("RDSインスタンス停止エラー: %w", err)
 	}
@@ -0,0 +1,53 @@
+package rds
+
+import (
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+)
+
+var _ API = (*fakeaws.Rds)(nil)
+
+func TestStopRdsInstance(t *testing.T) {
+	tests := []struct {
+		name       string
+		instanceId string
+		status     string
+		wantErr    bool
+		wantStatus string
+	}{
+		{
+			name:       "利用可能なインスタンスを停止",
+			instanceId: "db-1",
+			status:     "available",
+			wantStatus: "stopping",
+		},
+		{
+			name:       "停止済みのインスタンスはエラー",
+			instanceId: "db-1",
+			status:     "stopped",
+			wantErr:    true,
+			wantStatus: "stopped",
+		},
+		{
+			name:       "存在しないインスタンスはエラー",
+			instanceId: "db-missing",
+			status:     "available",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewRds(map[string]string{"db-1": tt.status}, nil)
+
+			err := StopRdsInstance(t.Context(), fake, tt.instanceId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StopRdsInstance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := fake.InstanceStatus(tt.instanceId); got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+		})
+	}
+}
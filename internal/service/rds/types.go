@@ -1,5 +1,33 @@
 package rds
 
+import (
+	"awstk/internal/service/cfn"
+	"awstk/internal/service/tagging"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// API はrdsパッケージが利用するRDS APIのインターフェース
+type API interface {
+	DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+	StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error)
+}
+
+// ClientSet はRDSインスタンス一覧の取得に必要なクライアントをまとめた構造体
+type ClientSet struct {
+	RdsClient API
+	CfnClient cfn.API
+	TagClient tagging.API
+}
+
+// ListOptions はRDSインスタンス一覧取得のオプション
+type ListOptions struct {
+	StackName string           // CloudFormationスタック名で絞り込む
+	Tags      tagging.Selector // タグで絞り込む
+}
+
 // Instance RdsInstance RDSインスタンスの情報を格納する構造体
 type Instance struct {
 	InstanceId string
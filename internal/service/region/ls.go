@@ -8,8 +8,8 @@ import (
 )
 
 // ListRegions はAWSリージョンの一覧を取得する関数 (公開)
-func ListRegions(ec2Client *ec2.Client, showAllRegions bool) ([]AwsRegion, error) {
-	regions, err := listRegions(ec2Client, showAllRegions)
+func ListRegions(ctx context.Context, ec2Client API, showAllRegions bool) ([]AwsRegion, error) {
+	regions, err := listRegions(ctx, ec2Client, showAllRegions)
 	if err != nil {
 		return nil, err
 	}
@@ -43,12 +43,12 @@ func GroupRegions(regions []AwsRegion) ([]AwsRegion, []AwsRegion) {
 }
 
 // listRegions retrieves all AWS regions (private)
-func listRegions(ec2Client *ec2.Client, showAllRegions bool) ([]awsRegion, error) {
+func listRegions(ctx context.Context, ec2Client API, showAllRegions bool) ([]awsRegion, error) {
 	input := &ec2.DescribeRegionsInput{
 		AllRegions: &showAllRegions,
 	}
 
-	result, err := ec2Client.DescribeRegions(context.Background(), input)
+	result, err := ec2Client.DescribeRegions(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("リージョン一覧の取得に失敗: %w", err)
 	}
@@ -1,5 +1,16 @@
 package region
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+// API is the subset of the EC2 API used to list regions.
+type API interface {
+	DescribeRegions(ctx context.Context, params *ec2.DescribeRegionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeRegionsOutput, error)
+}
+
 // awsRegion represents an AWS region (private)
 type awsRegion struct {
 	regionName  string
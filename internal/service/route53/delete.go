@@ -1,19 +1,17 @@
 package route53
 
 import (
-	"bufio"
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
-// DeleteHostedZone DeleteHostedZoneはRoute53のホストゾーンとすべてのレコードを削除します
-func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOptions) error {
-	ctx := context.Background()
+// DeleteActions はホストゾーン削除の実行計画アクションを生成します
+func DeleteActions(ctx context.Context, client API, identifier string, opts DeleteOptions) ([]common.Action, error) {
 	var zoneId string
 	var zoneName string
 	var err error
@@ -22,9 +20,9 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 	if opts.UseId {
 		zoneId = identifier
 		// ゾーン詳細を取得して名前を取得
-		zone, err := getHostedZoneDetails(client, zoneId)
+		zone, err := getHostedZoneDetails(ctx, client, zoneId)
 		if err != nil {
-			return fmt.Errorf("ホストゾーン詳細の取得エラー: %w", err)
+			return nil, fmt.Errorf("ホストゾーン詳細の取得エラー: %w", err)
 		}
 		zoneName = *zone.Name
 	} else {
@@ -33,65 +31,68 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 		if !strings.HasSuffix(zoneName, ".") {
 			zoneName += "."
 		}
-		zoneId, err = getHostedZoneIdByName(client, zoneName)
+		zoneId, err = getHostedZoneIdByName(ctx, client, zoneName)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	fmt.Printf("🔍 ホストゾーンが見つかりました: %s (ID: %s)\n", zoneName, zoneId)
+	common.Progressf("🔍 ホストゾーンが見つかりました: %s (ID: %s)\n", zoneName, zoneId)
 
-	// すべてのレコードを一覧取得
-	records, err := listAllRecords(client, zoneId)
+	records, err := listDeletableRecords(ctx, client, zoneId)
 	if err != nil {
-		return fmt.Errorf("レコード一覧の取得エラー: %w", err)
+		return nil, err
 	}
 
-	// NSとSOAレコードを除外
-	var recordsToDelete []RecordSetInfo
-	for _, record := range records {
-		if record.Type != types.RRTypeNs && record.Type != types.RRTypeSoa {
-			recordsToDelete = append(recordsToDelete, record)
-		}
-	}
+	return []common.Action{{
+		ResourceType: common.ResourceHostedZone,
+		ResourceId:   zoneId,
+		Operation:    common.OperationDelete,
+		Risk:         common.RiskHigh,
+		Detail:       fmt.Sprintf("%s、%d個のリソースレコードセットも削除（NS・SOAを除く）", zoneName, len(records)),
+	}}, nil
+}
 
-	if opts.DryRun {
-		fmt.Println("\n[ドライラン] 以下を削除します:")
-		fmt.Printf("- ホストゾーン: %s (ID: %s)\n", zoneName, zoneId)
-		fmt.Printf("- %d個のリソースレコードセット:\n", len(recordsToDelete))
-		for _, record := range recordsToDelete {
-			fmt.Printf("  - %s (%s)\n", record.Name, record.Type)
+// DeleteHostedZones は指定したホストゾーンをすべてのレコードごと順に削除します
+func DeleteHostedZones(ctx context.Context, client API, zoneIds []string) error {
+	failCount := 0
+	results := make([]common.ItemResult, 0, len(zoneIds))
+	for _, zoneId := range zoneIds {
+		err := deleteHostedZone(ctx, client, zoneId)
+		results = append(results, common.ItemResult{Item: zoneId, Err: err})
+		if err != nil {
+			common.Warnf("❌ ホストゾーン (%s) の削除に失敗しました: %v\n", zoneId, err)
+			failCount++
 		}
-		return nil
 	}
+	if failCount > 0 {
+		return &common.PartialFailureError{Operation: "ホストゾーンの削除", Results: results}
+	}
+	return nil
+}
 
-	// 削除確認
-	if !opts.Force {
-		fmt.Printf("\n⚠️  以下を完全に削除します:\n")
-		fmt.Printf("- ホストゾーン: %s (ID: %s)\n", zoneName, zoneId)
-		fmt.Printf("- %d個のリソースレコードセット\n", len(recordsToDelete))
-
-		if !confirmPrompt("\n本当に続行しますか？") {
-			fmt.Println("削除がキャンセルされました。")
-			return nil
-		}
+// deleteHostedZone はホストゾーンのレコード（NSとSOAを除く）を削除したうえでホストゾーンを削除します
+func deleteHostedZone(ctx context.Context, client API, zoneId string) error {
+	recordsToDelete, err := listDeletableRecords(ctx, client, zoneId)
+	if err != nil {
+		return err
 	}
 
 	// レコード削除
 	if len(recordsToDelete) > 0 {
-		fmt.Printf("\n🗑️  %d個のレコードを削除中...\n", len(recordsToDelete))
-		deletedCount, failedCount := deleteRecords(client, zoneId, recordsToDelete)
+		common.Progressf("\n🗑️  %d個のレコードを削除中...\n", len(recordsToDelete))
+		deletedCount, failedCount := deleteRecords(ctx, client, zoneId, recordsToDelete)
 
 		if failedCount > 0 {
-			fmt.Printf("⚠️  %d個のレコードの削除に失敗しました\n", failedCount)
+			common.Warnf("⚠️  %d個のレコードの削除に失敗しました\n", failedCount)
 		}
 		if deletedCount > 0 {
-			fmt.Printf("✅ %d個のレコードを削除しました\n", deletedCount)
+			common.Progressf("✅ %d個のレコードを削除しました\n", deletedCount)
 		}
 	}
 
 	// ホストゾーン削除
-	fmt.Printf("\n🗑️  ホストゾーンを削除中...\n")
+	common.Progressf("\n🗑️  ホストゾーンを削除中...\n")
 	_, err = client.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{
 		Id: &zoneId,
 	})
@@ -99,13 +100,29 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 		return fmt.Errorf("ホストゾーンの削除エラー: %w", err)
 	}
 
-	fmt.Printf("✅ ホストゾーンを正常に削除しました: %s (ID: %s)\n", zoneName, zoneId)
+	common.Progressf("✅ ホストゾーンを正常に削除しました: %s\n", zoneId)
 	return nil
 }
 
+// listDeletableRecords はホストゾーン内の削除対象のレコード（NSとSOAを除く）を一覧取得します
+func listDeletableRecords(ctx context.Context, client API, zoneId string) ([]RecordSetInfo, error) {
+	records, err := listAllRecords(ctx, client, zoneId)
+	if err != nil {
+		return nil, fmt.Errorf("レコード一覧の取得エラー: %w", err)
+	}
+
+	// NSとSOAレコードを除外
+	var recordsToDelete []RecordSetInfo
+	for _, record := range records {
+		if record.Type != types.RRTypeNs && record.Type != types.RRTypeSoa {
+			recordsToDelete = append(recordsToDelete, record)
+		}
+	}
+	return recordsToDelete, nil
+}
+
 // listAllRecordsはホストゾーン内のすべてのリソースレコードセットを一覧取得します
-func listAllRecords(client *route53.Client, zoneId string) ([]RecordSetInfo, error) {
-	ctx := context.Background()
+func listAllRecords(ctx context.Context, client API, zoneId string) ([]RecordSetInfo, error) {
 	var records []RecordSetInfo
 	paginator := route53.NewListResourceRecordSetsPaginator(client, &route53.ListResourceRecordSetsInput{
 		HostedZoneId: &zoneId,
@@ -145,8 +162,7 @@ func listAllRecords(client *route53.Client, zoneId string) ([]RecordSetInfo, err
 }
 
 // deleteRecordsは複数のリソースレコードセットを削除します
-func deleteRecords(client *route53.Client, zoneId string, records []RecordSetInfo) (deleted, failed int) {
-	ctx := context.Background()
+func deleteRecords(ctx context.Context, client API, zoneId string, records []RecordSetInfo) (deleted, failed int) {
 	// レコードをバッチ処理（Route53は1リクエストあたり最大1000変更までサポート）
 	batchSize := 100
 
@@ -197,26 +213,12 @@ func deleteRecords(client *route53.Client, zoneId string, records []RecordSetInf
 
 		if err != nil {
 			failed += len(batch)
-			fmt.Printf("  ❌ %d個のレコードのバッチ削除に失敗: %v\n", len(batch), err)
+			common.Warnf("  ❌ %d個のレコードのバッチ削除に失敗: %v\n", len(batch), err)
 		} else {
 			deleted += len(batch)
-			fmt.Printf("  ✓ %d個のレコードをバッチ削除\n", len(batch))
+			common.Progressf("  ✓ %d個のレコードをバッチ削除\n", len(batch))
 		}
 	}
 
 	return deleted, failed
 }
-
-// confirmPromptはユーザーに確認を求めます
-func confirmPrompt(message string) bool {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("%s [y/N]: ", message)
-
-	response, err := reader.ReadString('\n')
-	if err != nil {
-		return false
-	}
-
-	response = strings.ToLower(strings.TrimSpace(response))
-	return response == "y" || response == "yes"
-}
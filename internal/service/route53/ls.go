@@ -1,6 +1,8 @@
 package route53
 
 import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
@@ -10,8 +12,7 @@ import (
 )
 
 // ListHostedZones ListHostedZonesはRoute53のホストゾーンを一覧表示します
-func ListHostedZones(client *route53.Client) error {
-	ctx := context.Background()
+func ListHostedZones(ctx context.Context, client API) error {
 	var zones []HostedZoneInfo
 	paginator := route53.NewListHostedZonesPaginator(client, &route53.ListHostedZonesInput{})
 
@@ -41,8 +42,13 @@ func ListHostedZones(client *route53.Client) error {
 		}
 	}
 
+	// 機械可読形式の場合はホストゾーン情報をそのまま出力
+	if common.IsMachineReadable() {
+		return common.RenderRecords(zones)
+	}
+
 	if len(zones) == 0 {
-		fmt.Println("ホストゾーンが見つかりませんでした。")
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.hosted_zone")))
 		return nil
 	}
 
@@ -94,8 +100,7 @@ func ListHostedZones(client *route53.Client) error {
 }
 
 // getHostedZoneIdByNameはドメイン名からホストゾーンIDを取得します
-func getHostedZoneIdByName(client *route53.Client, domainName string) (string, error) {
-	ctx := context.Background()
+func getHostedZoneIdByName(ctx context.Context, client API, domainName string) (string, error) {
 	// Ensure domain name ends with a dot
 	if !strings.HasSuffix(domainName, ".") {
 		domainName += "."
@@ -130,8 +135,7 @@ func extractZoneId(fullId string) string {
 }
 
 // getHostedZoneDetailsは特定のホストゾーンの詳細情報を取得します
-func getHostedZoneDetails(client *route53.Client, zoneId string) (*types.HostedZone, error) {
-	ctx := context.Background()
+func getHostedZoneDetails(ctx context.Context, client API, zoneId string) (*types.HostedZone, error) {
 	output, err := client.GetHostedZone(ctx, &route53.GetHostedZoneInput{
 		Id: &zoneId,
 	})
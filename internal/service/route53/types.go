@@ -1,11 +1,22 @@
 package route53
 
 import (
+	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
+// API はroute53パッケージが利用するRoute53 APIのインターフェース
+type API interface {
+	ListHostedZones(ctx context.Context, params *route53.ListHostedZonesInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesOutput, error)
+	GetHostedZone(ctx context.Context, params *route53.GetHostedZoneInput, optFns ...func(*route53.Options)) (*route53.GetHostedZoneOutput, error)
+	ListResourceRecordSets(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+	ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
+	DeleteHostedZone(ctx context.Context, params *route53.DeleteHostedZoneInput, optFns ...func(*route53.Options)) (*route53.DeleteHostedZoneOutput, error)
+}
+
 // HostedZoneInfo HostedZoneInfoはRoute53ホストゾーンの情報を保持します
 type HostedZoneInfo struct {
 	Id          string
@@ -33,9 +44,7 @@ type RecordSetInfo struct {
 
 // DeleteOptions DeleteOptionsは削除操作のオプションを保持します
 type DeleteOptions struct {
-	UseId  bool
-	Force  bool
-	DryRun bool
+	UseId bool
 }
 
 // DeleteResult DeleteResultは削除操作の結果を保持します
@@ -11,8 +11,7 @@ import (
 )
 
 // checkS3BucketAvailability は指定バケット名の利用可否判定・メッセージ生成まで行う
-func checkS3BucketAvailability(s3Client *s3.Client, bucketName string) BucketAvailabilityResult {
-	ctx := context.Background()
+func checkS3BucketAvailability(ctx context.Context, s3Client API, bucketName string) BucketAvailabilityResult {
 	input := &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	}
@@ -53,17 +52,17 @@ func checkS3BucketAvailability(s3Client *s3.Client, bucketName string) BucketAva
 }
 
 // CheckS3BucketsAvailability 複数バケットの利用可否をまとめて判定
-func CheckS3BucketsAvailability(s3Client *s3.Client, buckets []string) []BucketAvailabilityResult {
+func CheckS3BucketsAvailability(ctx context.Context, s3Client API, buckets []string) []BucketAvailabilityResult {
 	results := make([]BucketAvailabilityResult, 0, len(buckets))
 	for _, bucket := range buckets {
-		results = append(results, checkS3BucketAvailability(s3Client, bucket))
+		results = append(results, checkS3BucketAvailability(ctx, s3Client, bucket))
 	}
 	return results
 }
 
 // CheckAndDisplayBucketsAvailability 複数バケットの利用可否を判定して表示する
-func CheckAndDisplayBucketsAvailability(s3Client *s3.Client, buckets []string) error {
-	results := CheckS3BucketsAvailability(s3Client, buckets)
+func CheckAndDisplayBucketsAvailability(ctx context.Context, s3Client API, buckets []string) error {
+	results := CheckS3BucketsAvailability(ctx, s3Client, buckets)
 	for _, r := range results {
 		icon := "❌"
 		if r.StatusCode == 404 {
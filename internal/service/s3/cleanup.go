@@ -4,7 +4,6 @@ import (
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
-	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -12,9 +11,9 @@ import (
 )
 
 // GetS3BucketsByFilter はフィルターに一致するS3バケット名の一覧を取得します
-func GetS3BucketsByFilter(s3Client *s3.Client, searchString string) ([]string, error) {
+func GetS3BucketsByFilter(ctx context.Context, s3Client API, searchString string) ([]string, error) {
 	// バケット一覧を取得
-	listBucketsOutput, err := s3Client.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+	listBucketsOutput, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, fmt.Errorf("s3バケット一覧取得エラー: %w", err)
 	}
@@ -23,76 +22,67 @@ func GetS3BucketsByFilter(s3Client *s3.Client, searchString string) ([]string, e
 	for _, bucket := range listBucketsOutput.Buckets {
 		if common.MatchesFilter(*bucket.Name, searchString) {
 			foundBuckets = append(foundBuckets, *bucket.Name)
-			fmt.Printf("🔍 検出されたS3バケット: %s\n", *bucket.Name)
+			common.Progressf("🔍 検出されたS3バケット: %s\n", *bucket.Name)
 		}
 	}
 
 	return foundBuckets, nil
 }
 
+// CleanupActions はS3バケット削除の実行計画アクションを生成します
+func CleanupActions(bucketNames []string) []common.Action {
+	actions := make([]common.Action, 0, len(bucketNames))
+	for _, name := range bucketNames {
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceS3Bucket,
+			ResourceId:   name,
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+			Detail:       "全オブジェクト・バージョンを削除後にバケットを削除",
+		})
+	}
+	return actions
+}
+
 // CleanupS3Buckets は指定したS3バケット一覧を削除します
-func CleanupS3Buckets(s3Client *s3.Client, bucketNames []string) error {
+func CleanupS3Buckets(ctx context.Context, s3Client API, bucketNames []string) error {
 	if len(bucketNames) == 0 {
 		return nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(bucketNames) < maxWorkers {
-		maxWorkers = len(bucketNames)
-	}
+	maxWorkers := min(common.ResolveConcurrency(common.DefaultConcurrency), len(bucketNames))
+	common.Progressf("🚀 %d個のバケットを最大%d並列で削除します...\n\n", len(bucketNames), maxWorkers)
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(bucketNames))
-	resultsMutex := &sync.Mutex{}
-
-	fmt.Printf("🚀 %d個のバケットを最大%d並列で削除します...\n\n", len(bucketNames), maxWorkers)
-
-	for i, bucket := range bucketNames {
-		idx := i
-		bucketName := bucket
-		executor.Execute(func() {
-			fmt.Printf("バケット %s を空にして削除中...\n", bucketName)
-
-			// バケットを空にする (バージョン管理対応)
-			err := emptyS3Bucket(s3Client, bucketName)
-			if err != nil {
-				fmt.Printf("❌ バケット %s を空にするのに失敗しました: %v\n", bucketName, err)
-				resultsMutex.Lock()
-				results[idx] = common.ProcessResult{Item: bucketName, Success: false, Error: err}
-				resultsMutex.Unlock()
-				return
-			}
+	results := common.Run(ctx, bucketNames, func(ctx context.Context, bucketName string) (struct{}, error) {
+		// バケットを空にする (バージョン管理対応)
+		if err := emptyS3Bucket(ctx, s3Client, bucketName); err != nil {
+			return struct{}{}, fmt.Errorf("バケットを空にするのに失敗しました: %w", err)
+		}
 
-			// バケットの削除
-			fmt.Printf("  バケット削除中: %s\n", bucketName)
-			_, err = s3Client.DeleteBucket(context.Background(), &s3.DeleteBucketInput{
-				Bucket: aws.String(bucketName),
-			})
-
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ バケット %s の削除に失敗しました: %v\n", bucketName, err)
-				results[idx] = common.ProcessResult{Item: bucketName, Success: false, Error: err}
-			} else {
-				fmt.Printf("✅ バケット %s を削除しました\n", bucketName)
-				results[idx] = common.ProcessResult{Item: bucketName, Success: true}
-			}
-			resultsMutex.Unlock()
+		// バケットの削除
+		_, err := s3Client.DeleteBucket(ctx, &s3.DeleteBucketInput{
+			Bucket: aws.String(bucketName),
 		})
+		return struct{}{}, err
+	}, &common.RunOptions{Progress: "バケット削除中"})
+
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ バケット %s の削除に失敗しました: %v\n", bucketNames[i], r.Err)
+		} else {
+			common.Progressf("✅ バケット %s を削除しました\n", bucketNames[i])
+		}
 	}
 
-	executor.Wait()
-
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	successCount, failCount := common.CountResults(results)
+	common.Progressf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
 
-	return nil
+	return common.CollectFailures("S3バケットの削除", bucketNames, results)
 }
 
 // emptyS3Bucket は指定したS3バケットの中身をすべて削除します (バージョン管理対応)
-func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
+func emptyS3Bucket(ctx context.Context, s3Client API, bucketName string) error {
 	// ページネーション対応のループ
 	var keyMarker *string
 	var versionIdMarker *string
@@ -107,7 +97,7 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 			listVersionsInput.VersionIdMarker = versionIdMarker
 		}
 
-		listVersionsOutput, err := s3Client.ListObjectVersions(context.Background(), listVersionsInput)
+		listVersionsOutput, err := s3Client.ListObjectVersions(ctx, listVersionsInput)
 		if err != nil {
 			return fmt.Errorf("バケット内のオブジェクトバージョン一覧取得エラー: %w", err)
 		}
@@ -142,8 +132,8 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 				}
 				batch := deleteObjects[i:end]
 
-				fmt.Printf("  %d件のオブジェクトを削除中...\n", len(batch))
-				deleteOutput, err := s3Client.DeleteObjects(context.Background(), &s3.DeleteObjectsInput{
+				common.Progressf("  %d件のオブジェクトを削除中...\n", len(batch))
+				deleteOutput, err := s3Client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
 					Bucket: aws.String(bucketName),
 					Delete: &types.Delete{
 						Objects: batch,
@@ -157,7 +147,7 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 				// 削除エラーがあった場合は警告を表示
 				if len(deleteOutput.Errors) > 0 {
 					for _, deleteErr := range deleteOutput.Errors {
-						fmt.Printf("  ⚠️  オブジェクト削除エラー: %s (バージョンID: %s) - %s\n",
+						common.Warnf("  ⚠️  オブジェクト削除エラー: %s (バージョンID: %s) - %s\n",
 							*deleteErr.Key,
 							aws.ToString(deleteErr.VersionId),
 							aws.ToString(deleteErr.Message))
@@ -176,6 +166,6 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 		versionIdMarker = listVersionsOutput.NextVersionIdMarker
 	}
 
-	fmt.Println("  バケットを空にしました。")
+	common.Progressln("  バケットを空にしました。")
 	return nil
 }
@@ -0,0 +1,179 @@
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"awstk/internal/service/common"
+	"awstk/internal/testutil/fakeaws"
+)
+
+var _ API = (*fakeaws.S3)(nil)
+
+// versionedBucket は objects 個のキーそれぞれに versions 個のバージョンと1つの削除マーカーを持つバケットを作成する
+func versionedBucket(name string, objects, versions int) *fakeaws.Bucket {
+	b := &fakeaws.Bucket{Name: name}
+	for i := 0; i < objects; i++ {
+		key := fmt.Sprintf("logs/%04d.gz", i)
+		for v := 0; v < versions; v++ {
+			b.Versions = append(b.Versions, fakeaws.ObjectVersion{Key: key, VersionId: fmt.Sprintf("v%d", v)})
+		}
+		b.Versions = append(b.Versions, fakeaws.ObjectVersion{Key: key, VersionId: fmt.Sprintf("v%d", versions), DeleteMarker: true})
+	}
+	return b
+}
+
+func TestEmptyS3Bucket(t *testing.T) {
+	tests := []struct {
+		name          string
+		bucket        *fakeaws.Bucket
+		pageSize      int
+		failOp        string
+		failId        string
+		wantErr       bool
+		wantRemaining int
+		wantListCalls int
+	}{
+		{
+			name:          "空のバケット",
+			bucket:        &fakeaws.Bucket{Name: "b"},
+			wantListCalls: 1,
+		},
+		{
+			name:          "バージョンと削除マーカーをすべて削除",
+			bucket:        versionedBucket("b", 3, 2),
+			wantListCalls: 1,
+		},
+		{
+			name:          "ページネーションをまたいで削除",
+			bucket:        versionedBucket("b", 5, 1),
+			pageSize:      3,
+			wantListCalls: 4,
+		},
+		{
+			name:          "1000件を超えるバージョンはチャンクに分けて削除",
+			bucket:        versionedBucket("b", 1200, 1),
+			wantListCalls: 1,
+		},
+		{
+			name:          "オブジェクト単位の削除エラーは警告のみ",
+			bucket:        versionedBucket("b", 2, 1),
+			failOp:        "DeleteObject",
+			failId:        "b/logs/0000.gz",
+			wantRemaining: 2,
+			wantListCalls: 1,
+		},
+		{
+			name:          "一括削除APIのエラーは中断",
+			bucket:        versionedBucket("b", 2, 1),
+			failOp:        "DeleteObjects",
+			wantErr:       true,
+			wantRemaining: 4,
+			wantListCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewS3(tt.bucket)
+			fake.PageSize = tt.pageSize
+			if tt.failOp != "" {
+				fake.Fail(tt.failOp, tt.failId, errors.New("access denied"))
+			}
+
+			err := emptyS3Bucket(t.Context(), fake, "b")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("emptyS3Bucket() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := fake.VersionCount("b"); got != tt.wantRemaining {
+				t.Errorf("remaining versions = %d, want %d", got, tt.wantRemaining)
+			}
+			if got := fake.CallCount("ListObjectVersions"); got != tt.wantListCalls {
+				t.Errorf("ListObjectVersions calls = %d, want %d", got, tt.wantListCalls)
+			}
+		})
+	}
+}
+
+func TestCleanupS3Buckets(t *testing.T) {
+	tests := []struct {
+		name       string
+		targets    []string
+		failOp     string
+		failId     string
+		wantFailed []string
+		wantExists map[string]bool
+	}{
+		{
+			name:    "フィルターで取得したバケットを削除",
+			targets: []string{"dev-logs", "dev-assets"},
+			wantExists: map[string]bool{
+				"dev-logs":   false,
+				"dev-assets": false,
+				"prod-logs":  true,
+			},
+		},
+		{
+			name:       "一部のバケット削除に失敗しても他は削除",
+			targets:    []string{"dev-logs", "dev-assets"},
+			failOp:     "DeleteBucket",
+			failId:     "dev-assets",
+			wantFailed: []string{"dev-assets"},
+			wantExists: map[string]bool{
+				"dev-logs":   false,
+				"dev-assets": true,
+				"prod-logs":  true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := fakeaws.NewS3(
+				versionedBucket("dev-logs", 3, 2),
+				versionedBucket("dev-assets", 1, 1),
+				versionedBucket("prod-logs", 1, 1),
+			)
+			if tt.failOp != "" {
+				fake.Fail(tt.failOp, tt.failId, errors.New("access denied"))
+			}
+
+			buckets, err := GetS3BucketsByFilter(t.Context(), fake, "dev-")
+			if err != nil {
+				t.Fatalf("GetS3BucketsByFilter() error = %v", err)
+			}
+			if len(buckets) != len(tt.targets) {
+				t.Fatalf("GetS3BucketsByFilter() = %v, want %v", buckets, tt.targets)
+			}
+
+			err = CleanupS3Buckets(t.Context(), fake, buckets)
+			var partial *common.PartialFailureError
+			if len(tt.wantFailed) == 0 {
+				if err != nil {
+					t.Fatalf("CleanupS3Buckets() error = %v", err)
+				}
+			} else if !errors.As(err, &partial) {
+				t.Fatalf("CleanupS3Buckets() error = %v, want PartialFailureError", err)
+			} else {
+				var failed []string
+				for _, r := range partial.Failed() {
+					failed = append(failed, r.Item)
+				}
+				if !slices.Equal(failed, tt.wantFailed) {
+					t.Errorf("failed buckets = %v, want %v", failed, tt.wantFailed)
+				}
+				if len(partial.Results) != len(tt.targets) {
+					t.Errorf("len(Results) = %d, want %d", len(partial.Results), len(tt.targets))
+				}
+			}
+
+			for name, want := range tt.wantExists {
+				if got := fake.HasBucket(name); got != want {
+					t.Errorf("bucket %s exists = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
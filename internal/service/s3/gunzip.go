@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"awstk/internal/service/common"
 	"compress/gzip"
 	"context"
 	"fmt"
@@ -13,8 +14,7 @@ import (
 )
 
 // DownloadAndExtractGzFiles 指定S3パス配下の.gzファイルを一括ダウンロード＆解凍
-func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error {
-	ctx := context.Background()
+func DownloadAndExtractGzFiles(ctx context.Context, s3Client API, s3url, outDir string) error {
 	bucket, prefix, err := parseS3Url(s3url)
 	if err != nil {
 		return err
@@ -46,7 +46,7 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		}
 		gzCount++
 
-		fmt.Printf("📦 %s をダウンロード中...\n", key)
+		common.Progressf("📦 %s をダウンロード中...\n", key)
 		// ダウンロード
 		getObjectInput := &s3.GetObjectInput{
 			Bucket: &bucket,
@@ -54,7 +54,7 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		}
 		getResp, err := s3Client.GetObject(ctx, getObjectInput)
 		if err != nil {
-			fmt.Printf("❌ %s のダウンロードに失敗: %v\n", key, err)
+			common.Warnf("❌ %s のダウンロードに失敗: %v\n", key, err)
 			continue
 		}
 
@@ -65,9 +65,9 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// gzip解凍
 		gzr, err := gzip.NewReader(getResp.Body)
 		if err != nil {
-			fmt.Printf("❌ %s のgzip解凍に失敗: %v\n", key, err)
+			common.Warnf("❌ %s のgzip解凍に失敗: %v\n", key, err)
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
 			}
 			continue
 		}
@@ -75,12 +75,12 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// ファイル作成
 		outFile, err := os.Create(outPath)
 		if err != nil {
-			fmt.Printf("❌ %s のファイル作成に失敗: %v\n", outPath, err)
+			common.Warnf("❌ %s のファイル作成に失敗: %v\n", outPath, err)
 			if closeErr := gzr.Close(); closeErr != nil {
-				fmt.Printf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
+				common.Warnf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
 			}
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
 			}
 			continue
 		}
@@ -88,27 +88,27 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// 解凍データをファイルに書き込み
 		_, err = io.Copy(outFile, gzr)
 		if closeErr := gzr.Close(); closeErr != nil {
-			fmt.Printf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
+			common.Warnf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
 		}
 		if closeErr := outFile.Close(); closeErr != nil {
-			fmt.Printf("⚠️  %s のファイルクローズに失敗: %v\n", outPath, closeErr)
+			common.Warnf("⚠️  %s のファイルクローズに失敗: %v\n", outPath, closeErr)
 		}
 		if err != nil {
-			fmt.Printf("❌ %s の書き込みに失敗: %v\n", outPath, err)
+			common.Warnf("❌ %s の書き込みに失敗: %v\n", outPath, err)
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
 			}
 			continue
 		}
 		if closeErr := getResp.Body.Close(); closeErr != nil {
-			fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+			common.Warnf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
 		}
-		fmt.Printf("✅ %s → %s\n", key, outPath)
+		common.Progressf("✅ %s → %s\n", key, outPath)
 	}
 
 	if gzCount == 0 {
 		return fmt.Errorf("指定されたパス配下に .gz ファイルが見つかりませんでした")
 	}
-	fmt.Printf("🎉 %d個の .gz ファイルの処理が完了しました\n", gzCount)
+	common.Progressf("🎉 %d個の .gz ファイルの処理が完了しました\n", gzCount)
 	return nil
 }
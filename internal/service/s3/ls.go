@@ -10,8 +10,8 @@ import (
 )
 
 // ListS3Buckets はS3バケット名の一覧を返す関数
-func ListS3Buckets(s3Client *s3.Client) ([]string, error) {
-	result, err := s3Client.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+func ListS3Buckets(ctx context.Context, s3Client API) ([]string, error) {
+	result, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -24,12 +24,12 @@ func ListS3Buckets(s3Client *s3.Client) ([]string, error) {
 }
 
 // FilterEmptyBuckets は指定されたバケットの中から空のバケットのみを返す関数
-func FilterEmptyBuckets(s3Client *s3.Client, buckets []string) ([]string, error) {
+func FilterEmptyBuckets(ctx context.Context, s3Client API, buckets []string) ([]string, error) {
 	var emptyBuckets []string
 
 	for _, bucket := range buckets {
 		// バケットが空かどうかをチェック
-		isEmpty, err := isBucketEmpty(s3Client, bucket)
+		isEmpty, err := isBucketEmpty(ctx, s3Client, bucket)
 		if err != nil {
 			// エラーが発生してもスキップして続行
 			continue
@@ -43,9 +43,9 @@ func FilterEmptyBuckets(s3Client *s3.Client, buckets []string) ([]string, error)
 }
 
 // isBucketEmpty はバケットが空かどうかをチェックする関数
-func isBucketEmpty(s3Client *s3.Client, bucketName string) (bool, error) {
+func isBucketEmpty(ctx context.Context, s3Client API, bucketName string) (bool, error) {
 	// MaxKeys=1で最初のオブジェクトのみ取得を試みる
-	result, err := s3Client.ListObjectsV2(context.Background(), &s3.ListObjectsV2Input{
+	result, err := s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
 		Bucket:  aws.String(bucketName),
 		MaxKeys: aws.Int32(1),
 	})
@@ -58,7 +58,7 @@ func isBucketEmpty(s3Client *s3.Client, bucketName string) (bool, error) {
 }
 
 // listS3Objects はS3バケット内のオブジェクト一覧を取得します
-func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3Object, error) {
+func listS3Objects(ctx context.Context, s3Client API, bucketName string, prefix string) ([]S3Object, error) {
 	var objects []S3Object
 
 	// ListObjectsV2を使用してオブジェクト一覧を取得
@@ -68,7 +68,7 @@ func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3O
 	})
 
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("s3オブジェクト一覧取得エラー: %w", err)
 		}
@@ -86,14 +86,14 @@ func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3O
 }
 
 // ListS3TreeView 指定されたS3パスをツリー形式で表示します
-func ListS3TreeView(s3Client *s3.Client, s3Path string, showTime bool) error {
+func ListS3TreeView(ctx context.Context, s3Client API, s3Path string, showTime bool) error {
 	bucket, prefix, err := parseS3Url(s3Path)
 	if err != nil {
 		return err
 	}
 
 	// S3オブジェクト一覧を取得
-	objects, err := listS3Objects(s3Client, bucket, prefix)
+	objects, err := listS3Objects(ctx, s3Client, bucket, prefix)
 	if err != nil {
 		return err
 	}
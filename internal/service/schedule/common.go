@@ -1,22 +1,29 @@
 package schedule
 
 import (
+	"awstk/internal/i18n"
 	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 )
 
 // DisplaySchedules はスケジュール一覧を表示する
-func DisplaySchedules(schedules []Schedule) {
+func DisplaySchedules(schedules []Schedule) error {
+	// 機械可読形式の場合はスケジュール情報をそのまま出力
+	if common.IsMachineReadable() {
+		return common.RenderRecords(schedules)
+	}
+
 	// タイトル表示
-	fmt.Printf("\n📅 スケジュール一覧\n")
+	fmt.Printf("\n📅 %s\n", common.GenerateFilteredTitle(i18n.T("resource.schedule")))
 
 	if len(schedules) == 0 {
-		fmt.Println("スケジュールが見つかりませんでした")
-		return
+		fmt.Println(common.FormatEmptyMessage(i18n.T("resource.schedule")))
+		return nil
 	}
 
 	// テーブル列定義（Widthは未使用になるが互換性のため残す）
@@ -32,16 +39,7 @@ func DisplaySchedules(schedules []Schedule) {
 	var schedulerData [][]string
 
 	for _, s := range schedules {
-		// Stateに絵文字を付ける
-		stateWithEmoji := s.State
-		switch s.State {
-		case "ENABLED":
-			stateWithEmoji = "🟢 " + s.State
-		case "DISABLED":
-			stateWithEmoji = "🔴 " + s.State
-		}
-
-		row := []string{s.Name, s.Expression, stateWithEmoji, s.Target}
+		row := []string{s.Name, s.Expression, stateWithEmoji(s.State), s.Target}
 		if s.Type == "rule" {
 			ruleData = append(ruleData, row)
 		} else {
@@ -68,11 +66,22 @@ func DisplaySchedules(schedules []Schedule) {
 		fmt.Printf(" (Rules: %d, Scheduler: %d)", len(ruleData), len(schedulerData))
 	}
 	fmt.Println()
+	return nil
+}
+
+// stateWithEmoji はStateに絵文字を付ける
+func stateWithEmoji(state string) string {
+	switch state {
+	case "ENABLED":
+		return "🟢 " + state
+	case "DISABLED":
+		return "🔴 " + state
+	}
+	return state
 }
 
 // listEventBridgeRulesWithFilter はフィルターにマッチするEventBridge Rulesを取得する
-func listEventBridgeRulesWithFilter(client *eventbridge.Client, filter string) ([]*eventbridge.DescribeRuleOutput, error) {
-	ctx := context.Background()
+func listEventBridgeRulesWithFilter(ctx context.Context, client EventBridgeAPI, filter string) ([]*eventbridge.DescribeRuleOutput, error) {
 	var matchedRules []*eventbridge.DescribeRuleOutput
 
 	// 全ルールを取得
@@ -106,8 +115,7 @@ func listEventBridgeRulesWithFilter(client *eventbridge.Client, filter string) (
 }
 
 // listEventBridgeSchedulersWithFilter はフィルターにマッチするEventBridge Schedulersを取得する
-func listEventBridgeSchedulersWithFilter(client *scheduler.Client, filter string) ([]*scheduler.GetScheduleOutput, error) {
-	ctx := context.Background()
+func listEventBridgeSchedulersWithFilter(ctx context.Context, client SchedulerAPI, filter string) ([]*scheduler.GetScheduleOutput, error) {
 	var matchedSchedules []*scheduler.GetScheduleOutput
 
 	// 全スケジュールを取得
@@ -139,3 +147,24 @@ func listEventBridgeSchedulersWithFilter(client *scheduler.Client, filter string
 
 	return matchedSchedules, nil
 }
+
+// GetScheduleState はスケジュールの状態（ENABLED / DISABLED）を取得する
+func GetScheduleState(ctx context.Context, eventBridgeClient EventBridgeAPI, schedulerClient SchedulerAPI, name string) (string, error) {
+	scheduleType, err := detectScheduleType(ctx, eventBridgeClient, schedulerClient, name)
+	if err != nil {
+		return "", err
+	}
+
+	if scheduleType == "rule" {
+		rule, err := eventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{Name: aws.String(name)})
+		if err != nil {
+			return "", err
+		}
+		return string(rule.State), nil
+	}
+	schedule, err := schedulerClient.GetSchedule(ctx, &scheduler.GetScheduleInput{Name: aws.String(name)})
+	if err != nil {
+		return "", err
+	}
+	return string(schedule.State), nil
+}
@@ -1,6 +1,8 @@
 package schedule
 
 import (
+	"awstk/internal/i18n"
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
@@ -12,9 +14,8 @@ import (
 )
 
 // ListSchedules はスケジュール一覧を取得する
-func ListSchedules(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, opts ListOptions) ([]Schedule, error) {
+func ListSchedules(ctx context.Context, eventBridgeClient EventBridgeAPI, schedulerClient SchedulerAPI, opts ListOptions) ([]Schedule, error) {
 	var schedules []Schedule
-	ctx := context.Background()
 
 	// EventBridge Rulesを取得
 	if opts.Type == "all" || opts.Type == "rule" {
@@ -37,8 +38,44 @@ func ListSchedules(eventBridgeClient *eventbridge.Client, schedulerClient *sched
 	return schedules, nil
 }
 
+// ListSchedulesInRegions は複数リージョンのスケジュールを並列取得し、リージョン列付きで表示する
+// 取得に失敗したリージョンは警告を表示してスキップする
+func ListSchedulesInRegions(ctx context.Context, regions []string, clientsFor func(region string) (EventBridgeAPI, SchedulerAPI), opts ListOptions) error {
+	results := common.FetchRegions(ctx, regions, func(ctx context.Context, region string) ([]Schedule, error) {
+		eventBridgeClient, schedulerClient := clientsFor(region)
+		return ListSchedules(ctx, eventBridgeClient, schedulerClient, opts)
+	})
+
+	return common.DisplayRegionalList(
+		results,
+		"📅 "+common.GenerateFilteredTitle(i18n.T("resource.schedule")),
+		schedulesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("resource.schedule")),
+		},
+	)
+}
+
+// schedulesToTableData はスケジュール情報をテーブルデータに変換する
+func schedulesToTableData(schedules []Schedule) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: "Name"},
+		{Header: "Type"},
+		{Header: "Schedule"},
+		{Header: "State"},
+		{Header: "Target"},
+	}
+
+	data := make([][]string, len(schedules))
+	for i, s := range schedules {
+		data[i] = []string{s.Name, s.Type, s.Expression, stateWithEmoji(s.State), s.Target}
+	}
+	return columns, data
+}
+
 // listEventBridgeRules はEventBridge Rules（スケジュールタイプ）を取得
-func listEventBridgeRules(ctx context.Context, client *eventbridge.Client) ([]Schedule, error) {
+func listEventBridgeRules(ctx context.Context, client EventBridgeAPI) ([]Schedule, error) {
 	var schedules []Schedule
 
 	// ルール一覧を取得
@@ -78,7 +115,7 @@ func listEventBridgeRules(ctx context.Context, client *eventbridge.Client) ([]Sc
 }
 
 // listEventBridgeSchedulers はEventBridge Schedulerを取得
-func listEventBridgeSchedulers(ctx context.Context, client *scheduler.Client) ([]Schedule, error) {
+func listEventBridgeSchedulers(ctx context.Context, client SchedulerAPI) ([]Schedule, error) {
 	var schedules []Schedule
 
 	// スケジュール一覧を取得
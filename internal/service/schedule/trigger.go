@@ -1,11 +1,10 @@
 package schedule
 
 import (
-	"bufio"
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
 	"context"
 	"fmt"
-	"os"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,11 +20,9 @@ type TriggerOptions struct {
 }
 
 // TriggerSchedule はスケジュールを手動実行する
-func TriggerSchedule(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string, opts TriggerOptions) error {
-	ctx := context.Background()
-
+func TriggerSchedule(ctx context.Context, eventBridgeClient EventBridgeAPI, schedulerClient SchedulerAPI, name string, opts TriggerOptions) error {
 	// スケジュールタイプの判別
-	scheduleType, err := detectScheduleType(eventBridgeClient, schedulerClient, name)
+	scheduleType, err := detectScheduleType(ctx, eventBridgeClient, schedulerClient, name)
 	if err != nil {
 		return err
 	}
@@ -38,8 +35,7 @@ func TriggerSchedule(eventBridgeClient *eventbridge.Client, schedulerClient *sch
 }
 
 // detectScheduleType はスケジュールのタイプを自動判別する
-func detectScheduleType(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string) (string, error) {
-	ctx := context.Background()
+func detectScheduleType(ctx context.Context, eventBridgeClient EventBridgeAPI, schedulerClient SchedulerAPI, name string) (string, error) {
 	// 並列でチェック
 	type result struct {
 		scheduleType string
@@ -103,34 +99,22 @@ func detectScheduleType(eventBridgeClient *eventbridge.Client, schedulerClient *
 
 // selectScheduleTypeInteractive は対話的にスケジュールタイプを選択する
 func selectScheduleTypeInteractive(name string) (string, error) {
-	fmt.Printf("\n⚠️  '%s' はEventBridge RuleとSchedulerの両方に存在します。\n", name)
-	fmt.Println("どちらを操作しますか？")
-	fmt.Println()
-	fmt.Println("  1) EventBridge Rule")
-	fmt.Println("  2) EventBridge Scheduler")
-	fmt.Println()
-	fmt.Print("選択してください (1 or 2): ")
-
-	reader := bufio.NewReader(os.Stdin)
-	choice, _ := reader.ReadString('\n')
-	choice = strings.TrimSpace(choice)
-
-	switch choice {
-	case "1":
-		fmt.Println("→ EventBridge Rule を選択しました")
-		return "rule", nil
-	case "2":
-		fmt.Println("→ EventBridge Scheduler を選択しました")
-		return "scheduler", nil
-	default:
-		return "", fmt.Errorf("無効な選択です: %s", choice)
+	common.Warnf("\n⚠️  '%s' はEventBridge RuleとSchedulerの両方に存在します。\n", name)
+	scheduleType, err := picker.Select([]picker.Item{
+		{Label: "EventBridge Rule", Value: "rule"},
+		{Label: "EventBridge Scheduler", Value: "scheduler"},
+	}, &picker.Options{Prompt: "どちらを操作しますか？"})
+	if err != nil {
+		return "", err
 	}
+	common.Progressf("→ %s を選択しました\n", scheduleType)
+	return scheduleType, nil
 }
 
 // triggerEventBridgeRule はEventBridge Ruleを手動実行する
-func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, name string, opts TriggerOptions) error {
+func triggerEventBridgeRule(ctx context.Context, client EventBridgeAPI, name string, opts TriggerOptions) error {
 	// 1. 現在のルール情報を取得
-	fmt.Printf("📝 現在のスケジュール設定を取得中...\n")
+	common.Progressf("📝 現在のスケジュール設定を取得中...\n")
 	describeOutput, err := client.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 		Name: aws.String(name),
 	})
@@ -144,7 +128,7 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 	}
 
 	originalSchedule := *describeOutput.ScheduleExpression
-	fmt.Printf("  └─ 現在の設定: %s\n", originalSchedule)
+	common.Progressf("  └─ 現在の設定: %s\n", originalSchedule)
 
 	// 元の状態を保存
 	originalState := describeOutput.State
@@ -153,7 +137,7 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 	shouldRestore := false
 	defer func() {
 		if shouldRestore && !opts.NoWait {
-			fmt.Println("\n🔄 元のスケジュールに復元中...")
+			common.Progressln("\n🔄 元のスケジュールに復元中...")
 			putRuleInput := &eventbridge.PutRuleInput{
 				Name:               aws.String(name),
 				ScheduleExpression: aws.String(originalSchedule),
@@ -166,16 +150,19 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 				putRuleInput.EventBusName = describeOutput.EventBusName
 			}
 
-			if _, err := client.PutRule(ctx, putRuleInput); err != nil {
-				fmt.Printf("⚠️  スケジュールの復元に失敗: %v\n", err)
+			// Ctrl-Cで中断された場合でも復元できるよう、キャンセルされないコンテキストを使う
+			restoreCtx, cancel := common.DetachedContext(ctx)
+			defer cancel()
+			if _, err := client.PutRule(restoreCtx, putRuleInput); err != nil {
+				common.Warnf("⚠️  スケジュールの復元に失敗: %v\n", err)
 			} else {
-				fmt.Printf("  └─ 復元後: %s\n", originalSchedule)
+				common.Progressf("  └─ 復元後: %s\n", originalSchedule)
 			}
 		}
 	}()
 
 	// 3. スケジュールを rate(1 minute) に変更
-	fmt.Println("\n🔄 スケジュールを1分後実行に変更中...")
+	common.Progressln("\n🔄 スケジュールを1分後実行に変更中...")
 	newSchedule := "rate(1 minute)"
 	putRuleInput := &eventbridge.PutRuleInput{
 		Name:               aws.String(name),
@@ -192,27 +179,27 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 	if _, err := client.PutRule(ctx, putRuleInput); err != nil {
 		return fmt.Errorf("スケジュール変更に失敗: %w", err)
 	}
-	fmt.Printf("  └─ 新しい設定: %s\n", newSchedule)
+	common.Progressf("  └─ 新しい設定: %s\n", newSchedule)
 	shouldRestore = true
 
 	// 4. 実行待機
 	if !opts.NoWait {
-		if err := waitForExecution(name, opts.Timeout); err != nil {
+		if err := waitForExecution(ctx, name, opts.Timeout); err != nil {
 			return err
 		}
 	} else {
-		fmt.Println("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
+		common.Warnln("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
 		shouldRestore = false
 	}
 
-	fmt.Println("\n✅ 処理が完了しました")
+	common.Progressln("\n✅ 処理が完了しました")
 	return nil
 }
 
 // triggerEventBridgeScheduler はEventBridge Schedulerを手動実行する
-func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client, name string, opts TriggerOptions) error {
+func triggerEventBridgeScheduler(ctx context.Context, client SchedulerAPI, name string, opts TriggerOptions) error {
 	// 1. 現在のスケジュール情報を取得
-	fmt.Printf("📝 現在のスケジュール設定を取得中...\n")
+	common.Progressf("📝 現在のスケジュール設定を取得中...\n")
 	getOutput, err := client.GetSchedule(ctx, &scheduler.GetScheduleInput{
 		Name: aws.String(name),
 	})
@@ -221,7 +208,7 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 	}
 
 	originalSchedule := *getOutput.ScheduleExpression
-	fmt.Printf("  └─ 現在の設定: %s\n", originalSchedule)
+	common.Progressf("  └─ 現在の設定: %s\n", originalSchedule)
 
 	// 元の状態を保存
 	originalState := getOutput.State
@@ -230,7 +217,7 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 	shouldRestore := false
 	defer func() {
 		if shouldRestore && !opts.NoWait {
-			fmt.Println("\n🔄 元のスケジュールに復元中...")
+			common.Progressln("\n🔄 元のスケジュールに復元中...")
 			updateInput := &scheduler.UpdateScheduleInput{
 				Name:               aws.String(name),
 				ScheduleExpression: aws.String(originalSchedule),
@@ -245,16 +232,19 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 				updateInput.GroupName = getOutput.GroupName
 			}
 
-			if _, err := client.UpdateSchedule(ctx, updateInput); err != nil {
-				fmt.Printf("⚠️  スケジュールの復元に失敗: %v\n", err)
+			// Ctrl-Cで中断された場合でも復元できるよう、キャンセルされないコンテキストを使う
+			restoreCtx, cancel := common.DetachedContext(ctx)
+			defer cancel()
+			if _, err := client.UpdateSchedule(restoreCtx, updateInput); err != nil {
+				common.Warnf("⚠️  スケジュールの復元に失敗: %v\n", err)
 			} else {
-				fmt.Printf("  └─ 復元後: %s\n", originalSchedule)
+				common.Progressf("  └─ 復元後: %s\n", originalSchedule)
 			}
 		}
 	}()
 
 	// 3. スケジュールを rate(1 minute) に変更
-	fmt.Println("\n🔄 スケジュールを1分後実行に変更中...")
+	common.Progressln("\n🔄 スケジュールを1分後実行に変更中...")
 	newSchedule := "rate(1 minute)"
 	updateInput := &scheduler.UpdateScheduleInput{
 		Name:               aws.String(name),
@@ -273,34 +263,34 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 	if _, err := client.UpdateSchedule(ctx, updateInput); err != nil {
 		return fmt.Errorf("スケジュール変更に失敗: %w", err)
 	}
-	fmt.Printf("  └─ 新しい設定: %s\n", newSchedule)
+	common.Progressf("  └─ 新しい設定: %s\n", newSchedule)
 	shouldRestore = true
 
 	// 4. 実行待機
 	if !opts.NoWait {
-		if err := waitForExecution(name, opts.Timeout); err != nil {
+		if err := waitForExecution(ctx, name, opts.Timeout); err != nil {
 			return err
 		}
 	} else {
-		fmt.Println("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
+		common.Warnln("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
 		shouldRestore = false
 	}
 
-	fmt.Println("\n✅ 処理が完了しました")
+	common.Progressln("\n✅ 処理が完了しました")
 	return nil
 }
 
 // waitForExecution は実行を待機する
-func waitForExecution(name string, timeout int) error {
+func waitForExecution(ctx context.Context, name string, timeout int) error {
 	// EventBridgeがスケジュール変更を認識するまでの時間 + rate(1 minute)の実行時間を考慮
 	minWaitTime := 70
 	actualWaitTime := timeout
 	if actualWaitTime < minWaitTime {
 		actualWaitTime = minWaitTime
-		fmt.Printf("\n⚠️  最低待機時間%d秒に調整しました\n", minWaitTime)
+		common.Warnf("\n⚠️  最低待機時間%d秒に調整しました\n", minWaitTime)
 	}
 
-	fmt.Printf("\n⏳ スケジュール実行を待機中（%d秒）...\n", actualWaitTime)
+	common.Progressf("\n⏳ スケジュール実行を待機中（%d秒）...\n", actualWaitTime)
 
 	// プログレスバー表示
 	bar := progressbar.NewOptions(actualWaitTime,
@@ -320,16 +310,19 @@ func waitForExecution(name string, timeout int) error {
 	)
 
 	for i := 0; i < actualWaitTime; i++ {
-		time.Sleep(1 * time.Second)
+		if err := common.Sleep(ctx, 1*time.Second); err != nil {
+			common.Progressln()
+			return err
+		}
 		if err := bar.Add(1); err != nil {
-			fmt.Printf("⚠️  プログレスバー更新エラー: %v\n", err)
+			common.Warnf("⚠️  プログレスバー更新エラー: %v\n", err)
 		}
 	}
 
 	if err := bar.Finish(); err != nil {
-		fmt.Printf("⚠️  プログレスバー終了エラー: %v\n", err)
+		common.Warnf("⚠️  プログレスバー終了エラー: %v\n", err)
 	}
-	fmt.Println("\n✓ 実行待機完了")
+	common.Progressln("\n✓ 実行待機完了")
 
 	return nil
 }
@@ -1,5 +1,29 @@
 package schedule
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+)
+
+// EventBridgeAPI はscheduleパッケージが利用するEventBridge APIのインターフェース
+type EventBridgeAPI interface {
+	ListRules(ctx context.Context, params *eventbridge.ListRulesInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListRulesOutput, error)
+	DescribeRule(ctx context.Context, params *eventbridge.DescribeRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DescribeRuleOutput, error)
+	ListTargetsByRule(ctx context.Context, params *eventbridge.ListTargetsByRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.ListTargetsByRuleOutput, error)
+	PutRule(ctx context.Context, params *eventbridge.PutRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.PutRuleOutput, error)
+	EnableRule(ctx context.Context, params *eventbridge.EnableRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.EnableRuleOutput, error)
+	DisableRule(ctx context.Context, params *eventbridge.DisableRuleInput, optFns ...func(*eventbridge.Options)) (*eventbridge.DisableRuleOutput, error)
+}
+
+// SchedulerAPI はscheduleパッケージが利用するEventBridge Scheduler APIのインターフェース
+type SchedulerAPI interface {
+	ListSchedules(ctx context.Context, params *scheduler.ListSchedulesInput, optFns ...func(*scheduler.Options)) (*scheduler.ListSchedulesOutput, error)
+	GetSchedule(ctx context.Context, params *scheduler.GetScheduleInput, optFns ...func(*scheduler.Options)) (*scheduler.GetScheduleOutput, error)
+	UpdateSchedule(ctx context.Context, params *scheduler.UpdateScheduleInput, optFns ...func(*scheduler.Options)) (*scheduler.UpdateScheduleOutput, error)
+}
+
 // Schedule はスケジュール情報を表す構造体
 type Schedule struct {
 	Name       string // スケジュール名
@@ -12,5 +36,5 @@ type Schedule struct {
 
 // ListOptions はスケジュール一覧取得のオプション
 type ListOptions struct {
-	Type string // "all", "rule", "scheduler"
+	Type string `json:"type,omitempty" desc:"取得するスケジュールの種類（省略時は all）" enum:"all,rule,scheduler"` // "all", "rule", "scheduler"
 }
@@ -1,6 +1,7 @@
 package secretsmanager
 
 import (
+	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -8,18 +9,52 @@ import (
 )
 
 // DeleteSecret deletes a secret immediately and without a recovery window.
-// It depends on the concrete client type from AWS SDK.
-func DeleteSecret(client *awsSecretsManager.Client, secretId string) error {
+// It accepts any client that satisfies API, so it can be tested with a fake.
+func DeleteSecret(ctx context.Context, client API, secretId string) error {
 	input := &awsSecretsManager.DeleteSecretInput{
 		SecretId:                   aws.String(secretId),
 		ForceDeleteWithoutRecovery: aws.Bool(true), // 復旧期間なしで即時削除
 	}
 
-	// Use context.Background() as this is a background task not tied to a specific request.
-	_, err := client.DeleteSecret(context.Background(), input)
+	_, err := client.DeleteSecret(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to delete secret %s: %w", secretId, err)
 	}
 
 	return nil
 }
+
+// DeleteActions はシークレット即時削除の実行計画アクションを生成します
+func DeleteActions(secretIds []string) []common.Action {
+	actions := make([]common.Action, 0, len(secretIds))
+	for _, secretId := range secretIds {
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceSecret,
+			ResourceId:   secretId,
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+			Detail:       "復旧期間なしで即時削除",
+		})
+	}
+	return actions
+}
+
+// DeleteSecrets は指定したシークレットを順に即時削除します
+func DeleteSecrets(ctx context.Context, client API, secretIds []string) error {
+	failCount := 0
+	results := make([]common.ItemResult, 0, len(secretIds))
+	for _, secretId := range secretIds {
+		err := DeleteSecret(ctx, client, secretId)
+		results = append(results, common.ItemResult{Item: secretId, Err: err})
+		if err != nil {
+			common.Warnf("❌ %v\n", err)
+			failCount++
+			continue
+		}
+		common.Progressf("シークレット %s は正常に削除されました。\n", secretId)
+	}
+	if failCount > 0 {
+		return &common.PartialFailureError{Operation: "シークレットの削除", Results: results}
+	}
+	return nil
+}
@@ -10,12 +10,12 @@ import (
 )
 
 // GetSecretValues Secrets Managerからシークレット値を取得してMapで返す
-func GetSecretValues(secretsClient *secretsmanager.Client, secretName string) (map[string]interface{}, error) {
+func GetSecretValues(ctx context.Context, secretsClient API, secretName string) (map[string]interface{}, error) {
 	input := &secretsmanager.GetSecretValueInput{
 		SecretId: aws.String(secretName),
 	}
 
-	result, err := secretsClient.GetSecretValue(context.Background(), input)
+	result, err := secretsClient.GetSecretValue(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("シークレット取得に失敗: %w", err)
 	}
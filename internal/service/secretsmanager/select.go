@@ -0,0 +1,54 @@
+package secretsmanager
+
+import (
+	"awstk/internal/service/common"
+	"awstk/internal/ui/picker"
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// ListSecrets はシークレットの一覧を取得します
+func ListSecrets(ctx context.Context, client API) ([]types.SecretListEntry, error) {
+	var secrets []types.SecretListEntry
+	paginator := secretsmanager.NewListSecretsPaginator(client, &secretsmanager.ListSecretsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("シークレット一覧の取得に失敗: %w", err)
+		}
+		secrets = append(secrets, page.SecretList...)
+	}
+	return secrets, nil
+}
+
+// SelectSecret はシークレットの一覧から1件を選択させ、シークレット名を返します
+func SelectSecret(ctx context.Context, client API) (string, error) {
+	secrets, err := ListSecrets(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	items := make([]picker.Item, len(secrets))
+	for i, secret := range secrets {
+		items[i] = picker.Item{
+			Label:  aws.ToString(secret.Name),
+			Detail: aws.ToString(secret.Description),
+		}
+	}
+	if len(items) == 0 {
+		return "", common.NotFoundf("シークレットが見つかりませんでした")
+	}
+
+	secretName, err := picker.Select(items, &picker.Options{
+		Prompt: "シークレットを選択してください",
+		Hint:   "シークレット名",
+	})
+	if err != nil {
+		return "", err
+	}
+	common.Progressf("✅ 選択されたシークレット: %s\n", secretName)
+	return secretName, nil
+}
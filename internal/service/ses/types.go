@@ -1,10 +1,19 @@
 package ses
 
-import "github.com/aws/aws-sdk-go-v2/service/ses"
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+)
+
+// API はsesパッケージが利用するSES APIのインターフェース
+type API interface {
+	VerifyEmailIdentity(ctx context.Context, params *ses.VerifyEmailIdentityInput, optFns ...func(*ses.Options)) (*ses.VerifyEmailIdentityOutput, error)
+}
 
 // VerifyOptions はメールアドレス検証のオプション
 type VerifyOptions struct {
-	SesClient *ses.Client
+	SesClient API
 	FilePath  string
 }
 
@@ -7,14 +7,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
-	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 )
 
 // VerifyEmailsFromFile はファイルからメールアドレスを読み込んで検証する
-func VerifyEmailsFromFile(opts VerifyOptions) (*VerifyResult, error) {
+func VerifyEmailsFromFile(ctx context.Context, opts VerifyOptions) (*VerifyResult, error) {
 	// ファイルからメールアドレスを読み込み
 	emails, err := readEmailsFromFile(opts.FilePath)
 	if err != nil {
@@ -32,7 +31,7 @@ func VerifyEmailsFromFile(opts VerifyOptions) (*VerifyResult, error) {
 	duplicateRemoved := originalCount - len(emails)
 
 	// メールアドレスを検証
-	failedEmails, details, err := verifySesEmails(opts.SesClient, emails)
+	failedEmails, details, err := verifySesEmails(ctx, opts.SesClient, emails)
 	if err != nil {
 		return nil, fmt.Errorf("SES検証エラー: %w", err)
 	}
@@ -56,7 +55,7 @@ func readEmailsFromFile(filename string) ([]string, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			common.Warnf("⚠️  ファイルのクローズに失敗: %v\n", err)
 		}
 	}()
 
@@ -101,53 +100,34 @@ func removeDuplicateEmails(emails []string) []string {
 }
 
 // verifySesEmails 指定されたメールアドレス一覧をSESで検証する
-func verifySesEmails(sesClient *ses.Client, emails []string) ([]string, []EmailVerificationDetail, error) {
+func verifySesEmails(ctx context.Context, sesClient API, emails []string) ([]string, []EmailVerificationDetail, error) {
 	if len(emails) == 0 {
 		return nil, nil, nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(emails) < maxWorkers {
-		maxWorkers = len(emails)
-	}
+	maxWorkers := min(common.ResolveConcurrency(common.DefaultConcurrency), len(emails))
+	common.Progressf("🚀 %d件のメールアドレスを最大%d並列で検証します...\n\n", len(emails), maxWorkers)
+
+	results := common.Run(ctx, emails, func(ctx context.Context, email string) (struct{}, error) {
+		_, err := sesClient.VerifyEmailIdentity(ctx, &ses.VerifyEmailIdentityInput{
+			EmailAddress: aws.String(email),
+		})
+		return struct{}{}, err
+	}, &common.RunOptions{Progress: "メールアドレス検証中"})
 
-	executor := common.NewParallelExecutor(maxWorkers)
 	details := make([]EmailVerificationDetail, len(emails))
-	detailsMutex := &sync.Mutex{}
-	failedEmailsMutex := &sync.Mutex{}
 	var failedEmails []string
-
-	fmt.Printf("🚀 %d件のメールアドレスを最大%d並列で検証します...\n\n", len(emails), maxWorkers)
-
-	for i, email := range emails {
-		idx := i
-		emailAddr := email
-		executor.Execute(func() {
-			_, err := sesClient.VerifyEmailIdentity(context.Background(), &ses.VerifyEmailIdentityInput{
-				EmailAddress: aws.String(emailAddr),
-			})
-
-			detail := EmailVerificationDetail{
-				Email:   emailAddr,
-				Success: err == nil,
-				Error:   err,
-			}
-
-			detailsMutex.Lock()
-			details[idx] = detail
-			detailsMutex.Unlock()
-
-			if err != nil {
-				failedEmailsMutex.Lock()
-				failedEmails = append(failedEmails, emailAddr)
-				failedEmailsMutex.Unlock()
-			}
-		})
+	for i, r := range results {
+		details[i] = EmailVerificationDetail{
+			Email:   emails[i],
+			Success: r.Err == nil,
+			Error:   r.Err,
+		}
+		if r.Err != nil {
+			failedEmails = append(failedEmails, emails[i])
+		}
 	}
 
-	executor.Wait()
-
 	return failedEmails, details, nil
 }
 
@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"awstk/internal/service/common"
 	"bufio"
 	"context"
 	"fmt"
@@ -10,82 +11,64 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 )
 
-// DeleteParametersFromFile はファイルからパラメータ名を読み込んでParameter Storeから削除する
-func DeleteParametersFromFile(ssmClient *ssm.Client, opts DeleteParamsOptions) error {
+// DeleteActions はファイルから読み込んだパラメータ削除の実行計画アクションを生成します
+func DeleteActions(opts DeleteParamsOptions) ([]common.Action, error) {
 	// ファイルの存在確認
 	if _, err := os.Stat(opts.FilePath); os.IsNotExist(err) {
-		return fmt.Errorf("ファイルが見つかりません: %s", opts.FilePath)
+		return nil, fmt.Errorf("ファイルが見つかりません: %s", opts.FilePath)
 	}
 
 	// パラメータ名の読み込み
 	paramNames, err := loadParameterNamesFromFile(opts.FilePath)
 	if err != nil {
-		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+		return nil, fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
 	}
 
 	if len(paramNames) == 0 {
-		return fmt.Errorf("削除するパラメータが見つかりません")
+		return nil, fmt.Errorf("削除するパラメータが見つかりません")
 	}
 
-	// プレフィックスの適用
-	if opts.Prefix != "" {
-		for i := range paramNames {
-			paramNames[i] = normalizeParameterName(opts.Prefix, paramNames[i])
-		}
-	}
-
-	// ドライランの場合は内容を表示して終了
-	if opts.DryRun {
-		fmt.Println("🗑️  以下のパラメータが削除されます:")
-		fmt.Println(strings.Repeat("-", 80))
-		for _, name := range paramNames {
-			fmt.Printf("  %s\n", name)
-		}
-		fmt.Println(strings.Repeat("-", 80))
-		fmt.Printf("📊 合計: %d 件\n", len(paramNames))
-		return nil
-	}
-
-	// 確認プロンプト（--forceでない場合）
-	if !opts.Force {
-		fmt.Printf("⚠️  %d 件のパラメータを削除しようとしています。\n", len(paramNames))
-		fmt.Print("本当に削除しますか？ [y/N]: ")
-
-		var response string
-		if _, err := fmt.Scanln(&response); err != nil {
-			fmt.Printf("⚠️  入力エラー: %v\n", err)
-			fmt.Println("削除をキャンセルしました。")
-			return nil
-		}
-		if strings.ToLower(response) != "y" {
-			fmt.Println("削除をキャンセルしました。")
-			return nil
-		}
+	actions := make([]common.Action, 0, len(paramNames))
+	for _, name := range paramNames {
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceSsmParameter,
+			ResourceId:   normalizeParameterName(opts.Prefix, name),
+			Operation:    common.OperationDelete,
+			Risk:         common.RiskHigh,
+		})
 	}
+	return actions, nil
+}
 
-	// パラメータの削除
+// DeleteParameters は指定したパラメータをParameter Storeから順に削除します
+// 存在しないパラメータはスキップします
+func DeleteParameters(ctx context.Context, ssmClient API, paramNames []string) error {
 	var successCount, failCount, notFoundCount int
+	results := make([]common.ItemResult, 0, len(paramNames))
 	for _, name := range paramNames {
-		err := deleteParameter(ssmClient, name)
+		err := deleteParameter(ctx, ssmClient, name)
 		if err != nil {
 			if strings.Contains(err.Error(), "ParameterNotFound") {
-				fmt.Printf("⚠️  %s は存在しません（スキップ）\n", name)
+				common.Warnf("⚠️  %s は存在しません（スキップ）\n", name)
 				notFoundCount++
+				results = append(results, common.ItemResult{Item: name})
 			} else {
-				fmt.Printf("❌ %s の削除に失敗しました: %v\n", name, err)
+				common.Warnf("❌ %s の削除に失敗しました: %v\n", name, err)
 				failCount++
+				results = append(results, common.ItemResult{Item: name, Err: err})
 			}
 		} else {
-			fmt.Printf("✅ %s を削除しました\n", name)
+			common.Progressf("✅ %s を削除しました\n", name)
 			successCount++
+			results = append(results, common.ItemResult{Item: name})
 		}
 	}
 
-	fmt.Printf("\n📊 削除結果: 成功 %d / 失敗 %d / 存在しない %d / 合計 %d\n",
+	common.Progressf("\n📊 削除結果: 成功 %d / 失敗 %d / 存在しない %d / 合計 %d\n",
 		successCount, failCount, notFoundCount, len(paramNames))
 
 	if failCount > 0 {
-		return fmt.Errorf("%d 件のパラメータ削除に失敗しました", failCount)
+		return &common.PartialFailureError{Operation: "パラメータの削除", Results: results}
 	}
 
 	return nil
@@ -99,7 +82,7 @@ func loadParameterNamesFromFile(filePath string) ([]string, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			common.Warnf("⚠️  ファイルのクローズに失敗: %v\n", err)
 		}
 	}()
 
@@ -118,7 +101,7 @@ func loadParameterNamesFromFile(filePath string) ([]string, error) {
 
 		// パラメータ名の妥当性チェック
 		if !isValidParameterName(line) {
-			fmt.Printf("⚠️  行 %d: 無効なパラメータ名をスキップ: %s\n", lineNum, line)
+			common.Warnf("⚠️  行 %d: 無効なパラメータ名をスキップ: %s\n", lineNum, line)
 			continue
 		}
 
@@ -153,11 +136,11 @@ func isValidParameterName(name string) bool {
 }
 
 // deleteParameter は単一のパラメータをParameter Storeから削除する
-func deleteParameter(client *ssm.Client, name string) error {
+func deleteParameter(ctx context.Context, client API, name string) error {
 	input := &ssm.DeleteParameterInput{
 		Name: &name,
 	}
 
-	_, err := client.DeleteParameter(context.Background(), input)
+	_, err := client.DeleteParameter(ctx, input)
 	return err
 }
@@ -10,99 +10,111 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 )
 
-// PutParametersFromFile はファイルからパラメータを読み込んでParameter Storeに登録する
-func PutParametersFromFile(ssmClient *ssm.Client, opts PutParamsOptions) error {
-	// ファイルの存在確認
-	if _, err := os.Stat(opts.FilePath); os.IsNotExist(err) {
-		return fmt.Errorf("ファイルが見つかりません: %s", opts.FilePath)
-	}
-
-	// パラメータの読み込み
-	params, err := loadParametersFromFile(opts.FilePath)
+// PutActions はファイルから読み込んだパラメータ登録の実行計画アクションを生成します
+// 計画ファイルから登録できるよう、パラメータの値は Params に保持します
+func PutActions(opts PutParamsOptions) ([]common.Action, error) {
+	params, err := loadParameters(opts.FilePath, opts.Prefix)
 	if err != nil {
-		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+		return nil, err
 	}
 
-	if len(params) == 0 {
-		return fmt.Errorf("登録するパラメータが見つかりません")
-	}
-
-	// プレフィックスの適用
-	if opts.Prefix != "" {
-		for i := range params {
-			params[i].Name = normalizeParameterName(opts.Prefix, params[i].Name)
+	actions := make([]common.Action, 0, len(params))
+	for _, param := range params {
+		detail := "Type: " + param.Type
+		if param.Type != "SecureString" {
+			detail += ", Value: " + param.Value
 		}
+		if param.Description != "" {
+			detail += ", Description: " + param.Description
+		}
+		actions = append(actions, common.Action{
+			ResourceType: common.ResourceSsmParameter,
+			ResourceId:   param.Name,
+			Operation:    common.OperationPut,
+			Risk:         common.RiskMedium,
+			Detail:       detail,
+			Params: map[string]string{
+				"value":       param.Value,
+				"type":        param.Type,
+				"description": param.Description,
+			},
+		})
 	}
+	return actions, nil
+}
 
-	// ドライランの場合は内容を表示して終了
-	if opts.DryRun {
-		fmt.Println("📋 以下のパラメータが登録されます:")
-		fmt.Println(strings.Repeat("-", 80))
-		for _, param := range params {
-			fmt.Printf("Name: %s\n", param.Name)
-			fmt.Printf("Type: %s\n", param.Type)
-			if param.Type != "SecureString" {
-				fmt.Printf("Value: %s\n", param.Value)
-			} else {
-				fmt.Printf("Value: ****** (SecureString)\n")
-			}
-			if param.Description != "" {
-				fmt.Printf("Description: %s\n", param.Description)
-			}
-			fmt.Println(strings.Repeat("-", 80))
+// PutParameters は実行計画の登録アクションのパラメータをParameter Storeに並列で登録します（既存の値は上書きします）
+func PutParameters(ctx context.Context, ssmClient API, actions []common.Action) error {
+	params := make([]parameter, len(actions))
+	for i, action := range actions {
+		params[i] = parameter{
+			Name:        action.ResourceId,
+			Value:       action.Params["value"],
+			Type:        action.Params["type"],
+			Description: action.Params["description"],
+		}
+		if err := validateParameter(params[i]); err != nil {
+			return common.InvalidInputf("パラメータ %s が不正です: %v", action.ResourceId, err)
 		}
-		return nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(params) < maxWorkers {
-		maxWorkers = len(params)
-	}
+	maxWorkers := min(common.ResolveConcurrency(common.DefaultConcurrency), len(params))
+	common.Progressf("🚀 %d個のパラメータを最大%d並列で登録します...\n\n", len(params), maxWorkers)
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(params))
-	resultsMutex := &sync.Mutex{}
+	// パラメータの登録
+	results := common.Run(ctx, params, func(ctx context.Context, p parameter) (struct{}, error) {
+		return struct{}{}, putParameter(ctx, ssmClient, p)
+	}, &common.RunOptions{Progress: "パラメータ登録中"})
+
+	for i, r := range results {
+		if r.Err != nil {
+			common.Warnf("❌ %s の登録に失敗しました: %v\n", params[i].Name, r.Err)
+		} else {
+			common.Progressf("✅ %s を登録しました\n", params[i].Name)
+		}
+	}
 
-	fmt.Printf("🚀 %d個のパラメータを最大%d並列で登録します...\n\n", len(params), maxWorkers)
+	// 結果の集計
+	successCount, failCount := common.CountResults(results)
+	common.Progressf("\n📊 登録結果: 成功 %d / 失敗 %d / 合計 %d\n", successCount, failCount, len(params))
 
-	// パラメータの登録
-	for i, param := range params {
-		idx := i
-		p := param
-		executor.Execute(func() {
-			err := putParameter(ssmClient, p)
-
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ %s の登録に失敗しました: %v\n", p.Name, err)
-				results[idx] = common.ProcessResult{Item: p.Name, Success: false, Error: err}
-			} else {
-				fmt.Printf("✅ %s を登録しました\n", p.Name)
-				results[idx] = common.ProcessResult{Item: p.Name, Success: true}
-			}
-			resultsMutex.Unlock()
-		})
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = p.Name
 	}
+	return common.CollectFailures("パラメータの登録", names, results)
+}
 
-	executor.Wait()
+// loadParameters はファイルからパラメータを読み込み、プレフィックスを適用する
+func loadParameters(filePath, prefix string) ([]parameter, error) {
+	// ファイルの存在確認
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("ファイルが見つかりません: %s", filePath)
+	}
 
-	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n📊 登録結果: 成功 %d / 失敗 %d / 合計 %d\n", successCount, failCount, len(params))
+	// パラメータの読み込み
+	params, err := loadParametersFromFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+	}
 
-	if failCount > 0 {
-		return fmt.Errorf("%d 件のパラメータ登録に失敗しました", failCount)
+	if len(params) == 0 {
+		return nil, fmt.Errorf("登録するパラメータが見つかりません")
 	}
 
-	return nil
+	// プレフィックスの適用
+	if prefix != "" {
+		for i := range params {
+			params[i].Name = normalizeParameterName(prefix, params[i].Name)
+		}
+	}
+	return params, nil
 }
 
 // loadParametersFromFile はファイルからパラメータを読み込む
@@ -127,7 +139,7 @@ func loadParametersFromJSON(filePath string) ([]parameter, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			common.Warnf("⚠️  ファイルのクローズに失敗: %v\n", err)
 		}
 	}()
 
@@ -155,7 +167,7 @@ func loadParametersFromCSV(filePath string) ([]parameter, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			common.Warnf("⚠️  ファイルのクローズに失敗: %v\n", err)
 		}
 	}()
 
@@ -250,7 +262,7 @@ func validateParameter(param parameter) error {
 }
 
 // putParameter は単一のパラメータをParameter Storeに登録する
-func putParameter(client *ssm.Client, param parameter) error {
+func putParameter(ctx context.Context, client API, param parameter) error {
 	input := &ssm.PutParameterInput{
 		Name:      aws.String(param.Name),
 		Value:     aws.String(param.Value),
@@ -262,6 +274,6 @@ func putParameter(client *ssm.Client, param parameter) error {
 		input.Description = aws.String(param.Description)
 	}
 
-	_, err := client.PutParameter(context.Background(), input)
+	_, err := client.PutParameter(ctx, input)
 	return err
 }
@@ -3,10 +3,10 @@ package ssm
 import (
 	"awstk/internal/aws"
 	"awstk/internal/cli"
+	"awstk/internal/service/common"
 	ec2svc "awstk/internal/service/ec2"
+	"context"
 	"fmt"
-
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
 // StartSsmSession 指定したEC2インスタンスIDにSSMセッションで接続する
@@ -22,19 +22,17 @@ func StartSsmSession(awsCtx aws.Context, opts SessionOptions) error {
 }
 
 // SelectAndStartSession はインスタンスを選択してSSMセッションを開始する
-func SelectAndStartSession(awsCtx aws.Context, ec2Client *ec2.Client, instanceId string) error {
+func SelectAndStartSession(ctx context.Context, awsCtx aws.Context, ec2Client ec2svc.API, instanceId string) error {
 	// インスタンスIDが指定されていない場合は、インタラクティブに選択
 	if instanceId == "" {
-		fmt.Println("🖥️  利用可能なEC2インスタンスから選択してください:")
-
-		selectedInstanceId, err := ec2svc.SelectInstanceInteractively(ec2Client)
+		selectedInstanceId, err := ec2svc.SelectInstanceInteractively(ctx, ec2Client)
 		if err != nil {
 			return fmt.Errorf("❌ インスタンス選択でエラー: %w", err)
 		}
 		instanceId = selectedInstanceId
 	}
 
-	fmt.Printf("EC2インスタンス (%s) にSSMで接続します...\n", instanceId)
+	common.Progressf("EC2インスタンス (%s) にSSMで接続します...\n", instanceId)
 
 	opts := SessionOptions{
 		InstanceId: instanceId,
@@ -45,6 +43,6 @@ func SelectAndStartSession(awsCtx aws.Context, ec2Client *ec2.Client, instanceId
 		return fmt.Errorf("❌ SSMセッションの開始に失敗しました: %w", err)
 	}
 
-	fmt.Println("✅ SSMセッションを開始しました。")
+	common.Progressln("✅ SSMセッションを開始しました。")
 	return nil
 }
@@ -1,5 +1,17 @@
 package ssm
 
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+// API はssmパッケージが利用するSSM APIのインターフェース
+type API interface {
+	PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+	DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
+}
+
 // SessionOptions SsmSessionOptions はSSMセッション開始のパラメータを格納する構造体
 type SessionOptions struct {
 	InstanceId string
@@ -9,7 +21,6 @@ type SessionOptions struct {
 type PutParamsOptions struct {
 	FilePath string // 必須: JSONファイルのパス
 	Prefix   string // オプション: パラメータ名のプレフィックス
-	DryRun   bool   // オプション: ドライラン実行
 }
 
 // parameter はSSMパラメータを表す構造体
@@ -29,6 +40,4 @@ type parametersFile struct {
 type DeleteParamsOptions struct {
 	FilePath string // 必須: JSONファイルのパス
 	Prefix   string // オプション: パラメータ名のプレフィックス
-	DryRun   bool   // オプション: ドライラン実行
-	Force    bool   // オプション: 強制削除フラグ
 }
@@ -0,0 +1,188 @@
+// Package tagging はタグの条件でリソースを選択する機能を提供します
+//
+// --tag env=dev のような条件を Resource Groups Tagging API で検索し、
+// 各サービスのコマンドが扱うリソース名（インスタンスID・バケット名など）に変換します。
+package tagging
+
+import (
+	"awstk/internal/service/common"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// ParseFilter は key=value / key!=value / key 形式の文字列をタグ条件に変換します
+func ParseFilter(expr string) (Filter, error) {
+	var f Filter
+	if key, value, ok := strings.Cut(expr, "!="); ok {
+		f = Filter{Key: key, Value: value, Operator: OperatorNotEquals}
+	} else if key, value, ok := strings.Cut(expr, "="); ok {
+		f = Filter{Key: key, Value: value, Operator: OperatorEquals}
+	} else {
+		f = Filter{Key: expr, Operator: OperatorExists}
+	}
+	f.Key = strings.TrimSpace(f.Key)
+	if f.Key == "" {
+		return Filter{}, fmt.Errorf("タグの条件 '%s' にキーがありません（key=value, key!=value, key のいずれかの形式で指定してください）", expr)
+	}
+	return f, nil
+}
+
+// ParseSelector は複数のタグ条件を変換します（指定がない場合は空のセレクターを返します）
+func ParseSelector(exprs []string) (Selector, error) {
+	var s Selector
+	for _, expr := range exprs {
+		f, err := ParseFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, f)
+	}
+	return s, nil
+}
+
+// String は key=value 形式の文字列を返します
+func (f Filter) String() string {
+	if f.Operator == OperatorExists {
+		return f.Key
+	}
+	return f.Key + string(f.Operator) + f.Value
+}
+
+// Match はタグが条件に一致するかを判定します
+func (f Filter) Match(tags map[string]string) bool {
+	value, ok := tags[f.Key]
+	switch f.Operator {
+	case OperatorEquals:
+		return ok && value == f.Value
+	case OperatorNotEquals:
+		return !ok || value != f.Value
+	default:
+		return ok
+	}
+}
+
+// String はタグ条件をカンマ区切りで返します
+func (s Selector) String() string {
+	exprs := make([]string, len(s))
+	for i, f := range s {
+		exprs[i] = f.String()
+	}
+	return strings.Join(exprs, ", ")
+}
+
+// Match はタグがすべての条件に一致するかを判定します
+func (s Selector) Match(tags map[string]string) bool {
+	for _, f := range s {
+		if !f.Match(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// tagFilters は API 側で絞り込める条件（key=value と key）を GetResources の TagFilters に変換する
+// 同じキーへの条件は1つにまとめ、!= を含む最終的な判定は Match で行う
+func (s Selector) tagFilters() []types.TagFilter {
+	var filters []types.TagFilter
+	var keys []string
+	for _, f := range s {
+		if f.Operator == OperatorNotEquals || slices.Contains(keys, f.Key) {
+			continue
+		}
+		keys = append(keys, f.Key)
+		filter := types.TagFilter{Key: aws.String(f.Key)}
+		if f.Operator == OperatorEquals {
+			filter.Values = []string{f.Value}
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// Find はタグ条件に一致する指定した種類のリソースを取得します
+// Resource Groups Tagging API はタグが1つもないリソースを返さないため、key!=value のみの条件でもタグのないリソースは対象外になります
+func (s Selector) Find(ctx context.Context, client API, resourceType string) ([]Resource, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{resourceType},
+		TagFilters:          s.tagFilters(),
+	}
+	var resources []Resource
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("タグによるリソース検索に失敗: %w", err)
+		}
+		for _, m := range page.ResourceTagMappingList {
+			tags := make(map[string]string, len(m.Tags))
+			for _, t := range m.Tags {
+				tags[aws.ToString(t.Key)] = aws.ToString(t.Value)
+			}
+			if !s.Match(tags) {
+				continue
+			}
+			resourceARN := aws.ToString(m.ResourceARN)
+			resources = append(resources, Resource{ARN: resourceARN, Name: ResourceName(resourceARN), Tags: tags})
+		}
+	}
+	return resources, nil
+}
+
+// Names はタグ条件に一致する指定した種類のリソース名を取得します
+func (s Selector) Names(ctx context.Context, client API, resourceType string) ([]string, error) {
+	resources, err := s.Find(ctx, client, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(resources))
+	for i, r := range resources {
+		names[i] = r.Name
+	}
+	return common.RemoveDuplicates(names), nil
+}
+
+// Filter は names のうちタグ条件に一致するものだけを、元の順序のまま返します
+// セレクターが空の場合は names をそのまま返します
+func (s Selector) Filter(ctx context.Context, client API, resourceType string, names []string) ([]string, error) {
+	if len(s) == 0 {
+		return names, nil
+	}
+	tagged, err := s.Names(ctx, client, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	var matched []string
+	for _, name := range names {
+		if slices.Contains(tagged, name) {
+			matched = append(matched, name)
+		}
+	}
+	return matched, nil
+}
+
+// ResourceName はARNから各コマンドで扱うリソース名を取り出します
+// リソース部分の先頭の種類（instance/・db: など）を除き、ECSサービスは "クラスター名/サービス名" を返します
+//
+//	arn:aws:ec2:ap-northeast-1:123456789012:instance/i-0123 → i-0123
+//	arn:aws:rds:ap-northeast-1:123456789012:db:my-db → my-db
+//	arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/my-service → my-cluster/my-service
+//	arn:aws:logs:ap-northeast-1:123456789012:log-group:/aws/lambda/fn:* → /aws/lambda/fn
+//	arn:aws:s3:::my-bucket → my-bucket
+func ResourceName(resourceARN string) string {
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return resourceARN
+	}
+	resource := parsed.Resource
+	if i := strings.IndexAny(resource, "/:"); i >= 0 && parsed.Service != "s3" {
+		resource = resource[i+1:]
+	}
+	return strings.TrimSuffix(resource, ":*")
+}
@@ -0,0 +1,136 @@
+package tagging
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"awstk/internal/testutil/fakeaws"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		exprs   []string
+		want    Selector
+		wantErr bool
+	}{
+		{name: "指定なし", exprs: nil, want: nil},
+		{
+			name:  "3つの形式",
+			exprs: []string{"env=dev", "owner!=team-a", "backup"},
+			want: Selector{
+				{Key: "env", Value: "dev", Operator: OperatorEquals},
+				{Key: "owner", Value: "team-a", Operator: OperatorNotEquals},
+				{Key: "backup", Operator: OperatorExists},
+			},
+		},
+		{name: "値は空でもよい", exprs: []string{"env="}, want: Selector{{Key: "env", Operator: OperatorEquals}}},
+		{name: "値に=を含む", exprs: []string{"query=a=b"}, want: Selector{{Key: "query", Value: "a=b", Operator: OperatorEquals}}},
+		{name: "キーがない", exprs: []string{"=dev"}, wantErr: true},
+		{name: "空文字", exprs: []string{""}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSelector(tt.exprs)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ParseSelector() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSelector() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseSelector() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:ec2:ap-northeast-1:123456789012:instance/i-0123":                    "i-0123",
+		"arn:aws:rds:ap-northeast-1:123456789012:db:my-db":                           "my-db",
+		"arn:aws:rds:ap-northeast-1:123456789012:cluster:my-cluster":                 "my-cluster",
+		"arn:aws:ecs:ap-northeast-1:123456789012:service/my-cluster/my-service":      "my-cluster/my-service",
+		"arn:aws:ecr:ap-northeast-1:123456789012:repository/team/api":                "team/api",
+		"arn:aws:logs:ap-northeast-1:123456789012:log-group:/aws/lambda/my-function": "/aws/lambda/my-function",
+		"arn:aws:logs:ap-northeast-1:123456789012:log-group:/ecs/api:*":              "/ecs/api",
+		"arn:aws:synthetics:ap-northeast-1:123456789012:canary:my-canary":            "my-canary",
+		"arn:aws:events:ap-northeast-1:123456789012:rule/nightly-batch":              "nightly-batch",
+		"arn:aws:s3:::my-bucket": "my-bucket",
+		"not-an-arn":             "not-an-arn",
+	}
+	for in, want := range tests {
+		if got := ResourceName(in); got != want {
+			t.Errorf("ResourceName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSelectorFind(t *testing.T) {
+	client := fakeaws.NewTagging(
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev-a", Tags: map[string]string{"env": "dev", "owner": "team-a"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev-b", Tags: map[string]string{"env": "dev", "owner": "team-b"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev-c", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:instance/i-prd", Tags: map[string]string{"env": "prd", "owner": "team-a"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:ec2:ap-northeast-1:123456789012:volume/vol-dev", Tags: map[string]string{"env": "dev"}},
+	)
+	client.PageSize = 2
+
+	tests := []struct {
+		name  string
+		exprs []string
+		want  []string
+	}{
+		{name: "key=value", exprs: []string{"env=dev"}, want: []string{"i-dev-a", "i-dev-b", "i-dev-c"}},
+		{name: "key!=value はキーがないものも含む", exprs: []string{"env=dev", "owner!=team-a"}, want: []string{"i-dev-b", "i-dev-c"}},
+		{name: "key の存在", exprs: []string{"owner"}, want: []string{"i-dev-a", "i-dev-b", "i-prd"}},
+		{name: "複数条件はすべてに一致", exprs: []string{"owner=team-a", "env=prd"}, want: []string{"i-prd"}},
+		{name: "同じキーへの矛盾する条件", exprs: []string{"env=dev", "env=prd"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSelector(tt.exprs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := s.Names(t.Context(), client, ResourceEc2Instance)
+			if err != nil {
+				t.Fatalf("Names() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Names() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectorFilter(t *testing.T) {
+	client := fakeaws.NewTagging(
+		&fakeaws.TaggedResource{ARN: "arn:aws:s3:::dev-assets", Tags: map[string]string{"env": "dev"}},
+		&fakeaws.TaggedResource{ARN: "arn:aws:s3:::dev-logs", Tags: map[string]string{"env": "prd"}},
+	)
+	names := []string{"dev-logs", "dev-assets", "dev-untagged"}
+
+	got, err := Selector(nil).Filter(t.Context(), client, ResourceS3Bucket, names)
+	if err != nil || !slices.Equal(got, names) {
+		t.Errorf("空のセレクターの Filter() = %v, %v, want %v", got, err, names)
+	}
+	if client.CallCount("GetResources") != 0 {
+		t.Errorf("空のセレクターで GetResources が呼ばれた: %v", client.Calls())
+	}
+
+	s := Selector{{Key: "env", Value: "dev", Operator: OperatorEquals}}
+	got, err = s.Filter(t.Context(), client, ResourceS3Bucket, names)
+	if err != nil || !slices.Equal(got, []string{"dev-assets"}) {
+		t.Errorf("Filter() = %v, %v, want [dev-assets]", got, err)
+	}
+
+	client.Fail("GetResources", "", errors.New("AccessDenied"))
+	if _, err := s.Filter(t.Context(), client, ResourceS3Bucket, names); err == nil {
+		t.Error("Filter() error = nil, want error")
+	}
+}
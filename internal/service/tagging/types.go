@@ -0,0 +1,51 @@
+package tagging
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+)
+
+// API はtaggingパッケージが利用するResource Groups Tagging APIのインターフェース
+type API interface {
+	GetResources(ctx context.Context, params *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+}
+
+// リソースの種類（GetResources の ResourceTypeFilters に指定する値）
+const (
+	ResourceEc2Instance      = "ec2:instance"
+	ResourceRdsInstance      = "rds:db"
+	ResourceRdsCluster       = "rds:cluster"
+	ResourceEcsService       = "ecs:service"
+	ResourceS3Bucket         = "s3"
+	ResourceEcrRepository    = "ecr:repository"
+	ResourceLogGroup         = "logs:log-group"
+	ResourceSyntheticsCanary = "synthetics:canary"
+	ResourceEventsRule       = "events:rule"
+)
+
+// Operator はタグ条件の種類
+type Operator string
+
+const (
+	OperatorEquals    Operator = "="  // key=value: キーが存在し値が一致する
+	OperatorNotEquals Operator = "!=" // key!=value: キーが存在しないか値が一致しない
+	OperatorExists    Operator = ""   // key: キーが存在する
+)
+
+// Filter は1つのタグ条件
+type Filter struct {
+	Key      string
+	Value    string
+	Operator Operator
+}
+
+// Selector はタグ条件の一覧（すべての条件に一致するリソースを選択する）
+type Selector []Filter
+
+// Resource はタグ条件に一致したリソース
+type Resource struct {
+	ARN  string
+	Name string // ARNから取り出したリソース名 (e.g., i-0123..., my-bucket, my-cluster/my-service)
+	Tags map[string]string
+}
@@ -0,0 +1,77 @@
+package fakeaws
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+)
+
+// Capacity はスケーラブルターゲットの最小・最大キャパシティ
+type Capacity struct {
+	Min int32
+	Max int32
+}
+
+// AutoScaling はApplication Auto Scaling APIのインメモリフェイク
+// Targets はリソースID（service/<cluster>/<service>）からキャパシティへのマップです
+type AutoScaling struct {
+	recorder
+	Targets map[string]Capacity
+}
+
+// NewAutoScaling は空のフェイクを作成します
+func NewAutoScaling() *AutoScaling {
+	return &AutoScaling{Targets: map[string]Capacity{}}
+}
+
+// Target はリソースIDに登録されたキャパシティを返します
+func (f *AutoScaling) Target(resourceId string) (Capacity, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c, ok := f.Targets[resourceId]
+	return c, ok
+}
+
+func (f *AutoScaling) RegisterScalableTarget(_ context.Context, in *applicationautoscaling.RegisterScalableTargetInput, _ ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := aws.ToString(in.ResourceId)
+	if err := f.record("RegisterScalableTarget", id); err != nil {
+		return nil, err
+	}
+	f.Targets[id] = Capacity{Min: aws.ToInt32(in.MinCapacity), Max: aws.ToInt32(in.MaxCapacity)}
+	return &applicationautoscaling.RegisterScalableTargetOutput{}, nil
+}
+
+func (f *AutoScaling) DescribeScalableTargets(_ context.Context, in *applicationautoscaling.DescribeScalableTargetsInput, _ ...func(*applicationautoscaling.Options)) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("DescribeScalableTargets", ""); err != nil {
+		return nil, err
+	}
+	ids := in.ResourceIds
+	if len(ids) == 0 {
+		for id := range f.Targets {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+	out := &applicationautoscaling.DescribeScalableTargetsOutput{}
+	for _, id := range ids {
+		c, ok := f.Targets[id]
+		if !ok {
+			continue
+		}
+		out.ScalableTargets = append(out.ScalableTargets, types.ScalableTarget{
+			ResourceId:        aws.String(id),
+			ServiceNamespace:  in.ServiceNamespace,
+			ScalableDimension: types.ScalableDimensionECSServiceDesiredCount,
+			MinCapacity:       aws.Int32(c.Min),
+			MaxCapacity:       aws.Int32(c.Max),
+		})
+	}
+	return out, nil
+}
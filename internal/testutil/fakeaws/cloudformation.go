@@ -0,0 +1,510 @@
+package fakeaws
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+)
+
+// Stack はフェイクCloudFormationが保持するスタック
+type Stack struct {
+	Name                  string
+	Status                types.StackStatus
+	TerminationProtection bool
+	Resources             []types.StackResource
+	DriftStatus           types.StackDriftStatus // 空の場合は NOT_CHECKED
+	Events                []types.StackEvent     // 古い順（DescribeStackEvents は新しい順に返す）
+	Outputs               []types.Output         // ExportName を持つ出力値は ListExports で返す
+	Imports               []string               // このスタックがインポートしているエクスポート名
+	ResourceDrifts        []types.StackResourceDrift
+	ChangeSets            []*ChangeSet
+}
+
+// ChangeSet はフェイクCloudFormationが保持するチェンジセット
+type ChangeSet struct {
+	Name            string
+	Status          types.ChangeSetStatus // 空の場合は CREATE_COMPLETE
+	ExecutionStatus types.ExecutionStatus // 空の場合は AVAILABLE
+	Changes         []types.Change
+	Parent          string // ネストしたスタックのチェンジセットの場合は親のチェンジセットID
+}
+
+// CloudFormation はCloudFormation APIのインメモリフェイク
+type CloudFormation struct {
+	recorder
+	Stacks   []*Stack
+	PageSize int // ListStacks の1ページあたりの件数（0の場合は全件）
+
+	// DriftDetectionPolls はドリフト検出が完了するまでに DETECTION_IN_PROGRESS を返す回数
+	DriftDetectionPolls int
+	driftPolls          map[string]int
+}
+
+// NewCloudFormation は指定したスタックを持つフェイクを作成します
+func NewCloudFormation(stacks ...*Stack) *CloudFormation {
+	return &CloudFormation{Stacks: stacks}
+}
+
+// StackResource はテスト用のスタックリソースを作成します
+// ネストしたスタックは resourceType に AWS::CloudFormation::Stack、physicalId にそのスタック名を指定します
+func StackResource(resourceType, logicalId, physicalId string) types.StackResource {
+	return types.StackResource{
+		ResourceType:       aws.String(resourceType),
+		LogicalResourceId:  aws.String(logicalId),
+		PhysicalResourceId: aws.String(physicalId),
+		ResourceStatus:     types.ResourceStatusCreateComplete,
+	}
+}
+
+// StackEvent はテスト用のスタックイベントを作成します
+// フェイクではスタック名をスタックIDとして扱うため、physicalId にスタック名を指定するとスタック自身のイベントになります
+func StackEvent(stackName, logicalId, physicalId, resourceType string, status types.ResourceStatus, at time.Time) types.StackEvent {
+	return types.StackEvent{
+		EventId:            aws.String(fmt.Sprintf("%s-%s-%s-%d", stackName, logicalId, status, at.UnixNano())),
+		StackId:            aws.String(stackName),
+		StackName:          aws.String(stackName),
+		LogicalResourceId:  aws.String(logicalId),
+		PhysicalResourceId: aws.String(physicalId),
+		ResourceType:       aws.String(resourceType),
+		ResourceStatus:     status,
+		Timestamp:          aws.Time(at),
+	}
+}
+
+// Output はテスト用の出力値を作成します（exportName が空の場合はエクスポートしない）
+func Output(key, value, exportName string) types.Output {
+	output := types.Output{OutputKey: aws.String(key), OutputValue: aws.String(value)}
+	if exportName != "" {
+		output.ExportName = aws.String(exportName)
+	}
+	return output
+}
+
+// ResourceDrift はテスト用のリソースのドリフト情報を作成します
+func ResourceDrift(logicalId, resourceType string, status types.StackResourceDriftStatus, diffs ...types.PropertyDifference) types.StackResourceDrift {
+	return types.StackResourceDrift{
+		LogicalResourceId:        aws.String(logicalId),
+		PhysicalResourceId:       aws.String(logicalId),
+		ResourceType:             aws.String(resourceType),
+		StackResourceDriftStatus: status,
+		PropertyDifferences:      diffs,
+		Timestamp:                aws.Time(time.Now()),
+	}
+}
+
+// ChangeSetId はフェイクでのチェンジセットIDを返します
+func ChangeSetId(stackName, changeSetName string) string {
+	return "changeset/" + stackName + "/" + changeSetName
+}
+
+// ResourceChange はテスト用のリソースの変更を作成します
+func ResourceChange(action types.ChangeAction, logicalId, resourceType string, replacement types.Replacement) types.Change {
+	return types.Change{
+		Type: types.ChangeTypeResource,
+		ResourceChange: &types.ResourceChange{
+			Action:            action,
+			LogicalResourceId: aws.String(logicalId),
+			ResourceType:      aws.String(resourceType),
+			Replacement:       replacement,
+		},
+	}
+}
+
+// Stack は名前が一致するスタックを返します（存在しない場合はnil）
+func (f *CloudFormation) Stack(name string) *Stack {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.find(name)
+}
+
+func (f *CloudFormation) find(name string) *Stack {
+	for _, s := range f.Stacks {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// findLive は削除済みでないスタックを返す
+func (f *CloudFormation) findLive(name string) (*Stack, error) {
+	s := f.find(name)
+	if s == nil || s.Status == types.StackStatusDeleteComplete {
+		return nil, fmt.Errorf("Stack with id %s does not exist", name)
+	}
+	return s, nil
+}
+
+func (f *CloudFormation) ListStackResources(_ context.Context, in *cloudformation.ListStackResourcesInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListStackResourcesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("ListStackResources", name); err != nil {
+		return nil, err
+	}
+	s, err := f.findLive(name)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]types.StackResourceSummary, len(s.Resources))
+	for i, r := range s.Resources {
+		summaries[i] = types.StackResourceSummary{
+			LogicalResourceId:  r.LogicalResourceId,
+			PhysicalResourceId: r.PhysicalResourceId,
+			ResourceType:       r.ResourceType,
+			ResourceStatus:     r.ResourceStatus,
+		}
+	}
+	page, next := paginate(summaries, in.NextToken, f.PageSize)
+	return &cloudformation.ListStackResourcesOutput{StackResourceSummaries: page, NextToken: next}, nil
+}
+
+func (f *CloudFormation) ListStacks(_ context.Context, in *cloudformation.ListStacksInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListStacksOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListStacks", ""); err != nil {
+		return nil, err
+	}
+	var summaries []types.StackSummary
+	for _, s := range f.Stacks {
+		if len(in.StackStatusFilter) > 0 && !slices.Contains(in.StackStatusFilter, s.Status) {
+			continue
+		}
+		summaries = append(summaries, types.StackSummary{
+			StackName:   aws.String(s.Name),
+			StackStatus: s.Status,
+		})
+	}
+	page, next := paginate(summaries, in.NextToken, f.PageSize)
+	return &cloudformation.ListStacksOutput{StackSummaries: page, NextToken: next}, nil
+}
+
+func (f *CloudFormation) DescribeStacks(_ context.Context, in *cloudformation.DescribeStacksInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("DescribeStacks", name); err != nil {
+		return nil, err
+	}
+	var targets []*Stack
+	if name == "" {
+		for _, s := range f.Stacks {
+			if s.Status != types.StackStatusDeleteComplete {
+				targets = append(targets, s)
+			}
+		}
+	} else {
+		s, err := f.findLive(name)
+		if err != nil {
+			return nil, err
+		}
+		targets = []*Stack{s}
+	}
+	stacks := make([]types.Stack, len(targets))
+	for i, s := range targets {
+		driftStatus := s.DriftStatus
+		if driftStatus == "" {
+			driftStatus = types.StackDriftStatusNotChecked
+		}
+		stacks[i] = types.Stack{
+			StackId:                     aws.String(s.Name),
+			StackName:                   aws.String(s.Name),
+			StackStatus:                 s.Status,
+			EnableTerminationProtection: aws.Bool(s.TerminationProtection),
+			DriftInformation:            &types.StackDriftInformation{StackDriftStatus: driftStatus},
+			Outputs:                     s.Outputs,
+		}
+	}
+	return &cloudformation.DescribeStacksOutput{Stacks: stacks}, nil
+}
+
+func (f *CloudFormation) DeleteStack(_ context.Context, in *cloudformation.DeleteStackInput, _ ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("DeleteStack", name); err != nil {
+		return nil, err
+	}
+	s := f.find(name)
+	if s == nil {
+		// 実際のAPIと同様、存在しないスタックの削除は成功扱い
+		return &cloudformation.DeleteStackOutput{}, nil
+	}
+	if s.TerminationProtection {
+		return nil, fmt.Errorf("Stack [%s] cannot be deleted while TerminationProtection is enabled", name)
+	}
+	s.Status = types.StackStatusDeleteComplete
+	return &cloudformation.DeleteStackOutput{}, nil
+}
+
+func (f *CloudFormation) UpdateTerminationProtection(_ context.Context, in *cloudformation.UpdateTerminationProtectionInput, _ ...func(*cloudformation.Options)) (*cloudformation.UpdateTerminationProtectionOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("UpdateTerminationProtection", name); err != nil {
+		return nil, err
+	}
+	s, err := f.findLive(name)
+	if err != nil {
+		return nil, err
+	}
+	s.TerminationProtection = aws.ToBool(in.EnableTerminationProtection)
+	return &cloudformation.UpdateTerminationProtectionOutput{StackId: aws.String(s.Name)}, nil
+}
+
+func (f *CloudFormation) DetectStackDrift(_ context.Context, in *cloudformation.DetectStackDriftInput, _ ...func(*cloudformation.Options)) (*cloudformation.DetectStackDriftOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("DetectStackDrift", name); err != nil {
+		return nil, err
+	}
+	if _, err := f.findLive(name); err != nil {
+		return nil, err
+	}
+	return &cloudformation.DetectStackDriftOutput{StackDriftDetectionId: aws.String("drift-" + name)}, nil
+}
+
+func (f *CloudFormation) DescribeStackDriftDetectionStatus(_ context.Context, in *cloudformation.DescribeStackDriftDetectionStatusInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := aws.ToString(in.StackDriftDetectionId)
+	if err := f.record("DescribeStackDriftDetectionStatus", id); err != nil {
+		return nil, err
+	}
+	name, ok := strings.CutPrefix(id, "drift-")
+	s := f.find(name)
+	if !ok || s == nil {
+		return nil, fmt.Errorf("Drift detection %s does not exist", id)
+	}
+	if f.driftPolls == nil {
+		f.driftPolls = map[string]int{}
+	}
+	f.driftPolls[id]++
+	if f.driftPolls[id] <= f.DriftDetectionPolls {
+		return &cloudformation.DescribeStackDriftDetectionStatusOutput{
+			StackDriftDetectionId: aws.String(id),
+			StackId:               aws.String(s.Name),
+			DetectionStatus:       types.StackDriftDetectionStatusDetectionInProgress,
+		}, nil
+	}
+
+	// 検出が完了したら、リソースのドリフト情報からスタックのドリフト状態を決める
+	drifted := 0
+	for _, d := range s.ResourceDrifts {
+		if d.StackResourceDriftStatus == types.StackResourceDriftStatusModified || d.StackResourceDriftStatus == types.StackResourceDriftStatusDeleted {
+			drifted++
+		}
+	}
+	s.DriftStatus = types.StackDriftStatusInSync
+	if drifted > 0 {
+		s.DriftStatus = types.StackDriftStatusDrifted
+	}
+	return &cloudformation.DescribeStackDriftDetectionStatusOutput{
+		StackDriftDetectionId:     aws.String(id),
+		StackId:                   aws.String(s.Name),
+		DetectionStatus:           types.StackDriftDetectionStatusDetectionComplete,
+		StackDriftStatus:          s.DriftStatus,
+		DriftedStackResourceCount: aws.Int32(int32(drifted)),
+	}, nil
+}
+
+func (f *CloudFormation) DescribeStackResourceDrifts(_ context.Context, in *cloudformation.DescribeStackResourceDriftsInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("DescribeStackResourceDrifts", name); err != nil {
+		return nil, err
+	}
+	s, err := f.findLive(name)
+	if err != nil {
+		return nil, err
+	}
+	var drifts []types.StackResourceDrift
+	for _, d := range s.ResourceDrifts {
+		if len(in.StackResourceDriftStatusFilters) > 0 && !slices.Contains(in.StackResourceDriftStatusFilters, d.StackResourceDriftStatus) {
+			continue
+		}
+		d.StackId = aws.String(s.Name)
+		drifts = append(drifts, d)
+	}
+	page, next := paginate(drifts, in.NextToken, f.PageSize)
+	return &cloudformation.DescribeStackResourceDriftsOutput{StackResourceDrifts: page, NextToken: next}, nil
+}
+
+// findChangeSet はチェンジセットIDまたはスタック名とチェンジセット名からチェンジセットを探す
+func (f *CloudFormation) findChangeSet(stackName, changeSetName string) (*Stack, int, error) {
+	if rest, ok := strings.CutPrefix(changeSetName, "changeset/"); ok {
+		stackName, changeSetName, _ = strings.Cut(rest, "/")
+	}
+	if s := f.find(stackName); s != nil {
+		for i, cs := range s.ChangeSets {
+			if cs.Name == changeSetName {
+				return s, i, nil
+			}
+		}
+	}
+	return nil, 0, &smithy.GenericAPIError{Code: "ChangeSetNotFound", Message: fmt.Sprintf("ChangeSet [%s] does not exist", changeSetName)}
+}
+
+func (f *CloudFormation) ListChangeSets(_ context.Context, in *cloudformation.ListChangeSetsInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListChangeSetsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("ListChangeSets", name); err != nil {
+		return nil, err
+	}
+	s, err := f.findLive(name)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]types.ChangeSetSummary, len(s.ChangeSets))
+	for i, cs := range s.ChangeSets {
+		summaries[i] = types.ChangeSetSummary{
+			ChangeSetId:     aws.String(ChangeSetId(s.Name, cs.Name)),
+			ChangeSetName:   aws.String(cs.Name),
+			StackName:       aws.String(s.Name),
+			Status:          cmp.Or(cs.Status, types.ChangeSetStatusCreateComplete),
+			ExecutionStatus: cmp.Or(cs.ExecutionStatus, types.ExecutionStatusAvailable),
+		}
+		if cs.Parent != "" {
+			summaries[i].ParentChangeSetId = aws.String(cs.Parent)
+		}
+	}
+	page, next := paginate(summaries, in.NextToken, f.PageSize)
+	return &cloudformation.ListChangeSetsOutput{Summaries: page, NextToken: next}, nil
+}
+
+func (f *CloudFormation) DescribeChangeSet(_ context.Context, in *cloudformation.DescribeChangeSetInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeChangeSetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.ChangeSetName)
+	if err := f.record("DescribeChangeSet", name); err != nil {
+		return nil, err
+	}
+	s, i, err := f.findChangeSet(aws.ToString(in.StackName), name)
+	if err != nil {
+		return nil, err
+	}
+	cs := s.ChangeSets[i]
+	page, next := paginate(cs.Changes, in.NextToken, f.PageSize)
+	return &cloudformation.DescribeChangeSetOutput{
+		ChangeSetId:     aws.String(ChangeSetId(s.Name, cs.Name)),
+		ChangeSetName:   aws.String(cs.Name),
+		StackId:         aws.String(s.Name),
+		StackName:       aws.String(s.Name),
+		Status:          cmp.Or(cs.Status, types.ChangeSetStatusCreateComplete),
+		ExecutionStatus: cmp.Or(cs.ExecutionStatus, types.ExecutionStatusAvailable),
+		Changes:         page,
+		NextToken:       next,
+	}, nil
+}
+
+func (f *CloudFormation) ExecuteChangeSet(_ context.Context, in *cloudformation.ExecuteChangeSetInput, _ ...func(*cloudformation.Options)) (*cloudformation.ExecuteChangeSetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.ChangeSetName)
+	if err := f.record("ExecuteChangeSet", name); err != nil {
+		return nil, err
+	}
+	s, i, err := f.findChangeSet(aws.ToString(in.StackName), name)
+	if err != nil {
+		return nil, err
+	}
+	cs := s.ChangeSets[i]
+	if cmp.Or(cs.ExecutionStatus, types.ExecutionStatusAvailable) != types.ExecutionStatusAvailable {
+		return nil, &smithy.GenericAPIError{Code: "InvalidChangeSetStatus", Message: fmt.Sprintf("ChangeSet [%s] cannot be executed in its current status of [%s]", cs.Name, cs.Status)}
+	}
+	// フェイクでは実行するとすぐに更新が完了したものとして扱う
+	cs.ExecutionStatus = types.ExecutionStatusExecuteComplete
+	s.Status = types.StackStatusUpdateComplete
+	return &cloudformation.ExecuteChangeSetOutput{}, nil
+}
+
+func (f *CloudFormation) DeleteChangeSet(_ context.Context, in *cloudformation.DeleteChangeSetInput, _ ...func(*cloudformation.Options)) (*cloudformation.DeleteChangeSetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.ChangeSetName)
+	if err := f.record("DeleteChangeSet", name); err != nil {
+		return nil, err
+	}
+	s, i, err := f.findChangeSet(aws.ToString(in.StackName), name)
+	if err != nil {
+		return nil, err
+	}
+	s.ChangeSets = slices.Delete(s.ChangeSets, i, i+1)
+	return &cloudformation.DeleteChangeSetOutput{}, nil
+}
+
+func (f *CloudFormation) DescribeStackEvents(_ context.Context, in *cloudformation.DescribeStackEventsInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStackEventsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.StackName)
+	if err := f.record("DescribeStackEvents", name); err != nil {
+		return nil, err
+	}
+	// 実際のAPIと同様、削除済みのスタックもスタックIDで参照できる
+	s := f.find(name)
+	if s == nil {
+		return nil, fmt.Errorf("Stack [%s] does not exist", name)
+	}
+	events := slices.Clone(s.Events)
+	slices.Reverse(events)
+	page, next := paginate(events, in.NextToken, f.PageSize)
+	return &cloudformation.DescribeStackEventsOutput{StackEvents: page, NextToken: next}, nil
+}
+
+func (f *CloudFormation) ListExports(_ context.Context, in *cloudformation.ListExportsInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListExportsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListExports", ""); err != nil {
+		return nil, err
+	}
+	var exports []types.Export
+	for _, s := range f.Stacks {
+		if s.Status == types.StackStatusDeleteComplete {
+			continue
+		}
+		for _, o := range s.Outputs {
+			if o.ExportName == nil {
+				continue
+			}
+			exports = append(exports, types.Export{
+				Name:             o.ExportName,
+				Value:            o.OutputValue,
+				ExportingStackId: aws.String(s.Name),
+			})
+		}
+	}
+	page, next := paginate(exports, in.NextToken, f.PageSize)
+	return &cloudformation.ListExportsOutput{Exports: page, NextToken: next}, nil
+}
+
+func (f *CloudFormation) ListImports(_ context.Context, in *cloudformation.ListImportsInput, _ ...func(*cloudformation.Options)) (*cloudformation.ListImportsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.ExportName)
+	if err := f.record("ListImports", name); err != nil {
+		return nil, err
+	}
+	var imports []string
+	for _, s := range f.Stacks {
+		if s.Status != types.StackStatusDeleteComplete && slices.Contains(s.Imports, name) {
+			imports = append(imports, s.Name)
+		}
+	}
+	// 実際のAPIと同様、インポートしているスタックがない場合はエラーを返す
+	if len(imports) == 0 {
+		return nil, &smithy.GenericAPIError{Code: "ValidationError", Message: fmt.Sprintf("Export '%s' is not imported by any stack.", name)}
+	}
+	page, next := paginate(imports, in.NextToken, f.PageSize)
+	return &cloudformation.ListImportsOutput{Imports: page, NextToken: next}, nil
+}
@@ -0,0 +1,104 @@
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// Ec2 はEC2 APIのインメモリフェイク
+// Instances はインスタンスIDから状態（running/stopped など）へのマップです
+type Ec2 struct {
+	recorder
+	Instances map[string]types.InstanceStateName
+}
+
+// NewEc2 は指定したインスタンスを持つフェイクを作成します
+func NewEc2(instances map[string]types.InstanceStateName) *Ec2 {
+	if instances == nil {
+		instances = map[string]types.InstanceStateName{}
+	}
+	return &Ec2{Instances: instances}
+}
+
+// State はインスタンスの現在の状態を返します
+func (f *Ec2) State(instanceId string) types.InstanceStateName {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.Instances[instanceId]
+}
+
+func notFoundInstance(instanceId string) error {
+	return fmt.Errorf("InvalidInstanceID.NotFound: The instance ID '%s' does not exist", instanceId)
+}
+
+func (f *Ec2) DescribeInstances(_ context.Context, in *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("DescribeInstances", ""); err != nil {
+		return nil, err
+	}
+	ids := in.InstanceIds
+	if len(ids) == 0 {
+		for id := range f.Instances {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+	}
+	var instances []types.Instance
+	for _, id := range ids {
+		state, ok := f.Instances[id]
+		if !ok {
+			return nil, notFoundInstance(id)
+		}
+		instances = append(instances, types.Instance{
+			InstanceId: aws.String(id),
+			State:      &types.InstanceState{Name: state},
+		})
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []types.Reservation{{Instances: instances}}}, nil
+}
+
+func (f *Ec2) StartInstances(_ context.Context, in *ec2.StartInstancesInput, _ ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	changes, err := f.transition("StartInstances", in.InstanceIds, types.InstanceStateNamePending)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2.StartInstancesOutput{StartingInstances: changes}, nil
+}
+
+func (f *Ec2) StopInstances(_ context.Context, in *ec2.StopInstancesInput, _ ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	changes, err := f.transition("StopInstances", in.InstanceIds, types.InstanceStateNameStopping)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2.StopInstancesOutput{StoppingInstances: changes}, nil
+}
+
+// transition は指定したインスタンスの状態を next に遷移させる
+func (f *Ec2) transition(op string, ids []string, next types.InstanceStateName) ([]types.InstanceStateChange, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range ids {
+		if err := f.record(op, id); err != nil {
+			return nil, err
+		}
+		if _, ok := f.Instances[id]; !ok {
+			return nil, notFoundInstance(id)
+		}
+	}
+	changes := make([]types.InstanceStateChange, len(ids))
+	for i, id := range ids {
+		changes[i] = types.InstanceStateChange{
+			InstanceId:    aws.String(id),
+			PreviousState: &types.InstanceState{Name: f.Instances[id]},
+			CurrentState:  &types.InstanceState{Name: next},
+		}
+		f.Instances[id] = next
+	}
+	return changes, nil
+}
@@ -0,0 +1,137 @@
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+// Repository はフェイクECRが保持するリポジトリ
+type Repository struct {
+	Name            string
+	ImageCount      int
+	LifecyclePolicy string
+}
+
+// Ecr はECR APIのインメモリフェイク
+type Ecr struct {
+	recorder
+	Repositories []*Repository
+	PageSize     int // DescribeRepositories の1ページあたりの件数（0の場合は全件）
+}
+
+// NewEcr は指定したリポジトリを持つフェイクを作成します
+func NewEcr(repos ...*Repository) *Ecr {
+	return &Ecr{Repositories: repos}
+}
+
+// HasRepository はリポジトリが存在するかどうかを返します
+func (f *Ecr) HasRepository(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.find(name) != nil
+}
+
+func (f *Ecr) find(name string) *Repository {
+	for _, r := range f.Repositories {
+		if r.Name == name {
+			return r
+		}
+	}
+	return nil
+}
+
+func notFoundRepository(name string) error {
+	return &types.RepositoryNotFoundException{Message: aws.String(fmt.Sprintf("repository %s does not exist", name))}
+}
+
+func (f *Ecr) DescribeRepositories(_ context.Context, in *ecr.DescribeRepositoriesInput, _ ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("DescribeRepositories", ""); err != nil {
+		return nil, err
+	}
+	var repos []types.Repository
+	for _, r := range f.Repositories {
+		if len(in.RepositoryNames) > 0 && !slices.Contains(in.RepositoryNames, r.Name) {
+			continue
+		}
+		repos = append(repos, types.Repository{
+			RepositoryName: aws.String(r.Name),
+			RepositoryUri:  aws.String("123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/" + r.Name),
+		})
+	}
+	if len(in.RepositoryNames) > len(repos) {
+		for _, name := range in.RepositoryNames {
+			if f.find(name) == nil {
+				return nil, notFoundRepository(name)
+			}
+		}
+	}
+	page, next := paginate(repos, in.NextToken, f.PageSize)
+	return &ecr.DescribeRepositoriesOutput{Repositories: page, NextToken: next}, nil
+}
+
+func (f *Ecr) DescribeImages(_ context.Context, in *ecr.DescribeImagesInput, _ ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.RepositoryName)
+	if err := f.record("DescribeImages", name); err != nil {
+		return nil, err
+	}
+	r := f.find(name)
+	if r == nil {
+		return nil, notFoundRepository(name)
+	}
+	images := make([]types.ImageDetail, r.ImageCount)
+	for i := range images {
+		images[i] = types.ImageDetail{
+			RepositoryName:   aws.String(name),
+			ImageDigest:      aws.String(fmt.Sprintf("sha256:%064d", i)),
+			ImageSizeInBytes: aws.Int64(1024),
+		}
+	}
+	return &ecr.DescribeImagesOutput{ImageDetails: images}, nil
+}
+
+func (f *Ecr) GetLifecyclePolicy(_ context.Context, in *ecr.GetLifecyclePolicyInput, _ ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.RepositoryName)
+	if err := f.record("GetLifecyclePolicy", name); err != nil {
+		return nil, err
+	}
+	r := f.find(name)
+	if r == nil {
+		return nil, notFoundRepository(name)
+	}
+	if r.LifecyclePolicy == "" {
+		return nil, &types.LifecyclePolicyNotFoundException{Message: aws.String("lifecycle policy does not exist")}
+	}
+	return &ecr.GetLifecyclePolicyOutput{
+		RepositoryName:      aws.String(name),
+		LifecyclePolicyText: aws.String(r.LifecyclePolicy),
+	}, nil
+}
+
+func (f *Ecr) DeleteRepository(_ context.Context, in *ecr.DeleteRepositoryInput, _ ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.RepositoryName)
+	if err := f.record("DeleteRepository", name); err != nil {
+		return nil, err
+	}
+	r := f.find(name)
+	if r == nil {
+		return nil, notFoundRepository(name)
+	}
+	if r.ImageCount > 0 && !in.Force {
+		return nil, &types.RepositoryNotEmptyException{Message: aws.String(fmt.Sprintf("repository %s contains images", name))}
+	}
+	f.Repositories = slices.DeleteFunc(f.Repositories, func(x *Repository) bool { return x.Name == name })
+	return &ecr.DeleteRepositoryOutput{Repository: &types.Repository{RepositoryName: aws.String(name)}}, nil
+}
@@ -0,0 +1,83 @@
+// Package fakeaws はサービスパッケージのテストで利用するインメモリのAWSクライアントを提供します。
+//
+// 各フェイクは各サービスパッケージの API インターフェースを満たし、
+// 呼び出しに応じて内部状態（スタック・バケット・インスタンスなど）を更新します。
+// Fail でエラーを注入し、Calls で呼び出し履歴を検証できます。
+package fakeaws
+
+import (
+	"fmt"
+	"sync"
+)
+
+// recorder はフェイク共通のエラー注入・呼び出し記録を提供する
+type recorder struct {
+	mu     sync.Mutex
+	faults map[string]error
+	calls  []string
+}
+
+// Fail は操作 op（例: "DeleteStack"）に対してエラーを注入します
+// id を指定した場合はそのリソースへの呼び出しのみ失敗させ、空文字の場合はすべての呼び出しを失敗させます
+func (r *recorder) Fail(op, id string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.faults == nil {
+		r.faults = map[string]error{}
+	}
+	r.faults[faultKey(op, id)] = err
+}
+
+// Calls は "操作:リソースID" 形式の呼び出し履歴を返します
+func (r *recorder) Calls() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.calls...)
+}
+
+// CallCount は指定した操作の呼び出し回数を返します
+func (r *recorder) CallCount(op string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	prefix := op + ":"
+	for _, c := range r.calls {
+		if len(c) >= len(prefix) && c[:len(prefix)] == prefix {
+			count++
+		}
+	}
+	return count
+}
+
+// record は呼び出しを記録し、注入されたエラーがあれば返す
+// 呼び出し側で r.mu をロックしていること
+func (r *recorder) record(op, id string) error {
+	r.calls = append(r.calls, faultKey(op, id))
+	if err, ok := r.faults[faultKey(op, id)]; ok {
+		return err
+	}
+	if err, ok := r.faults[faultKey(op, "")]; ok {
+		return err
+	}
+	return nil
+}
+
+func faultKey(op, id string) string {
+	return fmt.Sprintf("%s:%s", op, id)
+}
+
+// paginate は items を pageSize ごとに区切り、token が示す位置からの1ページと次のトークンを返す
+func paginate[T any](items []T, token *string, pageSize int) ([]T, *string) {
+	start := 0
+	if token != nil {
+		_, _ = fmt.Sscanf(*token, "%d", &start)
+	}
+	if start > len(items) {
+		start = len(items)
+	}
+	if pageSize <= 0 || start+pageSize >= len(items) {
+		return items[start:], nil
+	}
+	next := fmt.Sprintf("%d", start+pageSize)
+	return items[start : start+pageSize], &next
+}
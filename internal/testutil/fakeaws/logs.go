@@ -0,0 +1,76 @@
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+// Logs はCloudWatch Logs APIのインメモリフェイク
+type Logs struct {
+	recorder
+	LogGroups []types.LogGroup
+	PageSize  int // DescribeLogGroups の1ページあたりの件数（0の場合は全件）
+}
+
+// NewLogs は指定したロググループを持つフェイクを作成します
+func NewLogs(groups ...types.LogGroup) *Logs {
+	return &Logs{LogGroups: groups}
+}
+
+// LogGroup はテスト用のロググループを作成します
+// retentionDays が0の場合は保存期間未設定として扱います
+func LogGroup(name string, storedBytes int64, retentionDays int32) types.LogGroup {
+	group := types.LogGroup{
+		LogGroupName: aws.String(name),
+		StoredBytes:  aws.Int64(storedBytes),
+	}
+	if retentionDays > 0 {
+		group.RetentionInDays = aws.Int32(retentionDays)
+	}
+	return group
+}
+
+// HasLogGroup はロググループが存在するかどうかを返します
+func (f *Logs) HasLogGroup(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.ContainsFunc(f.LogGroups, func(g types.LogGroup) bool { return aws.ToString(g.LogGroupName) == name })
+}
+
+func (f *Logs) DescribeLogGroups(_ context.Context, in *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("DescribeLogGroups", ""); err != nil {
+		return nil, err
+	}
+	prefix := aws.ToString(in.LogGroupNamePrefix)
+	var groups []types.LogGroup
+	for _, g := range f.LogGroups {
+		if strings.HasPrefix(aws.ToString(g.LogGroupName), prefix) {
+			groups = append(groups, g)
+		}
+	}
+	page, next := paginate(groups, in.NextToken, f.PageSize)
+	return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: page, NextToken: next}, nil
+}
+
+func (f *Logs) DeleteLogGroup(_ context.Context, in *cloudwatchlogs.DeleteLogGroupInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.LogGroupName)
+	if err := f.record("DeleteLogGroup", name); err != nil {
+		return nil, err
+	}
+	before := len(f.LogGroups)
+	f.LogGroups = slices.DeleteFunc(f.LogGroups, func(g types.LogGroup) bool { return aws.ToString(g.LogGroupName) == name })
+	if len(f.LogGroups) == before {
+		return nil, &types.ResourceNotFoundException{Message: aws.String(fmt.Sprintf("log group %s does not exist", name))}
+	}
+	return &cloudwatchlogs.DeleteLogGroupOutput{}, nil
+}
@@ -0,0 +1,161 @@
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// Rds はRDS APIのインメモリフェイク
+// DBInstances / DBClusters は識別子からステータス（available/stopped など）へのマップです
+// ClusterMembers はAuroraクラスターのメンバーのインスタンスからクラスター識別子へのマップです
+type Rds struct {
+	recorder
+	DBInstances    map[string]string
+	DBClusters     map[string]string
+	ClusterMembers map[string]string
+}
+
+// NewRds は指定したインスタンスとクラスターを持つフェイクを作成します
+func NewRds(instances, clusters map[string]string) *Rds {
+	if instances == nil {
+		instances = map[string]string{}
+	}
+	if clusters == nil {
+		clusters = map[string]string{}
+	}
+	return &Rds{DBInstances: instances, DBClusters: clusters}
+}
+
+// InstanceStatus はDBインスタンスの現在のステータスを返します
+func (f *Rds) InstanceStatus(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.DBInstances[id]
+}
+
+// ClusterStatus はDBクラスターの現在のステータスを返します
+func (f *Rds) ClusterStatus(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.DBClusters[id]
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (f *Rds) DescribeDBInstances(_ context.Context, in *rds.DescribeDBInstancesInput, _ ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := aws.ToString(in.DBInstanceIdentifier)
+	if err := f.record("DescribeDBInstances", id); err != nil {
+		return nil, err
+	}
+	ids := sortedKeys(f.DBInstances)
+	if id != "" {
+		if _, ok := f.DBInstances[id]; !ok {
+			return nil, &types.DBInstanceNotFoundFault{Message: aws.String(fmt.Sprintf("DBInstance %s not found", id))}
+		}
+		ids = []string{id}
+	}
+	out := &rds.DescribeDBInstancesOutput{}
+	for _, i := range ids {
+		instance := types.DBInstance{
+			DBInstanceIdentifier: aws.String(i),
+			DBInstanceStatus:     aws.String(f.DBInstances[i]),
+			Engine:               aws.String("mysql"),
+		}
+		if cluster, ok := f.ClusterMembers[i]; ok {
+			instance.DBClusterIdentifier = aws.String(cluster)
+			instance.Engine = aws.String("aurora-mysql")
+		}
+		out.DBInstances = append(out.DBInstances, instance)
+	}
+	return out, nil
+}
+
+func (f *Rds) DescribeDBClusters(_ context.Context, in *rds.DescribeDBClustersInput, _ ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := aws.ToString(in.DBClusterIdentifier)
+	if err := f.record("DescribeDBClusters", id); err != nil {
+		return nil, err
+	}
+	ids := sortedKeys(f.DBClusters)
+	if id != "" {
+		if _, ok := f.DBClusters[id]; !ok {
+			return nil, &types.DBClusterNotFoundFault{Message: aws.String(fmt.Sprintf("DBCluster %s not found", id))}
+		}
+		ids = []string{id}
+	}
+	out := &rds.DescribeDBClustersOutput{}
+	for _, c := range ids {
+		out.DBClusters = append(out.DBClusters, types.DBCluster{
+			DBClusterIdentifier: aws.String(c),
+			Status:              aws.String(f.DBClusters[c]),
+			Engine:              aws.String("aurora-mysql"),
+		})
+	}
+	return out, nil
+}
+
+func (f *Rds) StartDBInstance(_ context.Context, in *rds.StartDBInstanceInput, _ ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	id := aws.ToString(in.DBInstanceIdentifier)
+	if err := f.transition("StartDBInstance", f.DBInstances, id, "stopped", "starting"); err != nil {
+		return nil, err
+	}
+	return &rds.StartDBInstanceOutput{DBInstance: &types.DBInstance{DBInstanceIdentifier: aws.String(id)}}, nil
+}
+
+func (f *Rds) StopDBInstance(_ context.Context, in *rds.StopDBInstanceInput, _ ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	id := aws.ToString(in.DBInstanceIdentifier)
+	if err := f.transition("StopDBInstance", f.DBInstances, id, "available", "stopping"); err != nil {
+		return nil, err
+	}
+	return &rds.StopDBInstanceOutput{DBInstance: &types.DBInstance{DBInstanceIdentifier: aws.String(id)}}, nil
+}
+
+func (f *Rds) StartDBCluster(_ context.Context, in *rds.StartDBClusterInput, _ ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	id := aws.ToString(in.DBClusterIdentifier)
+	if err := f.transition("StartDBCluster", f.DBClusters, id, "stopped", "starting"); err != nil {
+		return nil, err
+	}
+	return &rds.StartDBClusterOutput{DBCluster: &types.DBCluster{DBClusterIdentifier: aws.String(id)}}, nil
+}
+
+func (f *Rds) StopDBCluster(_ context.Context, in *rds.StopDBClusterInput, _ ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	id := aws.ToString(in.DBClusterIdentifier)
+	if err := f.transition("StopDBCluster", f.DBClusters, id, "available", "stopping"); err != nil {
+		return nil, err
+	}
+	return &rds.StopDBClusterOutput{DBCluster: &types.DBCluster{DBClusterIdentifier: aws.String(id)}}, nil
+}
+
+// transition は from 状態のリソースを to 状態に遷移させる
+// 実際のAPIと同様、from 以外の状態からの遷移はエラーとする
+func (f *Rds) transition(op string, states map[string]string, id, from, to string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record(op, id); err != nil {
+		return err
+	}
+	current, ok := states[id]
+	if !ok {
+		return fmt.Errorf("%s not found", id)
+	}
+	if current != from {
+		return fmt.Errorf("InvalidStateFault: %s is in %s state", id, current)
+	}
+	states[id] = to
+	return nil
+}
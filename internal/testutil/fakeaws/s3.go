@@ -0,0 +1,273 @@
+package fakeaws
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// ObjectVersion はフェイクS3が保持するオブジェクトのバージョン（または削除マーカー）
+type ObjectVersion struct {
+	Key          string
+	VersionId    string
+	DeleteMarker bool
+	Body         []byte
+}
+
+// Bucket はフェイクS3が保持するバケット
+type Bucket struct {
+	Name     string
+	Versions []ObjectVersion
+}
+
+// S3 はS3 APIのインメモリフェイク
+type S3 struct {
+	recorder
+	Buckets  map[string]*Bucket
+	PageSize int // ListObjectVersions / ListObjectsV2 の1ページあたりの件数（0の場合は全件）
+}
+
+// NewS3 は指定したバケットを持つフェイクを作成します
+func NewS3(buckets ...*Bucket) *S3 {
+	f := &S3{Buckets: map[string]*Bucket{}}
+	for _, b := range buckets {
+		f.Buckets[b.Name] = b
+	}
+	return f
+}
+
+// HasBucket はバケットが存在するかどうかを返します
+func (f *S3) HasBucket(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.Buckets[name]
+	return ok
+}
+
+// VersionCount はバケットに残っているバージョンと削除マーカーの数を返します
+func (f *S3) VersionCount(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if b, ok := f.Buckets[name]; ok {
+		return len(b.Versions)
+	}
+	return 0
+}
+
+func (f *S3) bucket(name string) (*Bucket, error) {
+	b, ok := f.Buckets[name]
+	if !ok {
+		return nil, &types.NoSuchBucket{Message: aws.String(fmt.Sprintf("bucket %s does not exist", name))}
+	}
+	return b, nil
+}
+
+// sortedVersions はキー・バージョンID順に並べたバージョン一覧を返す
+func (b *Bucket) sortedVersions() []ObjectVersion {
+	versions := append([]ObjectVersion(nil), b.Versions...)
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i].Key != versions[j].Key {
+			return versions[i].Key < versions[j].Key
+		}
+		return versions[i].VersionId < versions[j].VersionId
+	})
+	return versions
+}
+
+// latest はキーごとの最新（末尾）バージョンを返す（削除マーカーが最新のキーは除外）
+func (b *Bucket) latest() []ObjectVersion {
+	latest := map[string]ObjectVersion{}
+	var keys []string
+	for _, v := range b.Versions {
+		if _, ok := latest[v.Key]; !ok {
+			keys = append(keys, v.Key)
+		}
+		latest[v.Key] = v
+	}
+	sort.Strings(keys)
+	var objects []ObjectVersion
+	for _, k := range keys {
+		if !latest[k].DeleteMarker {
+			objects = append(objects, latest[k])
+		}
+	}
+	return objects
+}
+
+func (f *S3) ListBuckets(_ context.Context, _ *s3.ListBucketsInput, _ ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListBuckets", ""); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(f.Buckets))
+	for name := range f.Buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buckets := make([]types.Bucket, len(names))
+	for i, name := range names {
+		buckets[i] = types.Bucket{Name: aws.String(name)}
+	}
+	return &s3.ListBucketsOutput{Buckets: buckets}, nil
+}
+
+func (f *S3) HeadBucket(_ context.Context, in *s3.HeadBucketInput, _ ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Bucket)
+	if err := f.record("HeadBucket", name); err != nil {
+		return nil, err
+	}
+	if _, err := f.bucket(name); err != nil {
+		return nil, err
+	}
+	return &s3.HeadBucketOutput{}, nil
+}
+
+func (f *S3) ListObjectsV2(_ context.Context, in *s3.ListObjectsV2Input, _ ...func(*s3.Options)) (*s3.ListObjectsV2Output, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Bucket)
+	if err := f.record("ListObjectsV2", name); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(name)
+	if err != nil {
+		return nil, err
+	}
+	prefix := aws.ToString(in.Prefix)
+	var contents []types.Object
+	for _, v := range b.latest() {
+		if len(v.Key) >= len(prefix) && v.Key[:len(prefix)] == prefix {
+			contents = append(contents, types.Object{Key: aws.String(v.Key), Size: aws.Int64(int64(len(v.Body)))})
+		}
+	}
+	page, next := paginate(contents, in.ContinuationToken, f.PageSize)
+	return &s3.ListObjectsV2Output{
+		Contents:              page,
+		IsTruncated:           aws.Bool(next != nil),
+		NextContinuationToken: next,
+	}, nil
+}
+
+func (f *S3) GetObject(_ context.Context, in *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name, key := aws.ToString(in.Bucket), aws.ToString(in.Key)
+	if err := f.record("GetObject", name+"/"+key); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range b.latest() {
+		if v.Key == key {
+			return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(v.Body))}, nil
+		}
+	}
+	return nil, &types.NoSuchKey{Message: aws.String(fmt.Sprintf("key %s does not exist", key))}
+}
+
+func (f *S3) ListObjectVersions(_ context.Context, in *s3.ListObjectVersionsInput, _ ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Bucket)
+	if err := f.record("ListObjectVersions", name); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// KeyMarker / VersionIdMarker より後ろのエントリから返す
+	versions := b.sortedVersions()
+	start := 0
+	if in.KeyMarker != nil {
+		marker := ObjectVersion{Key: aws.ToString(in.KeyMarker), VersionId: aws.ToString(in.VersionIdMarker)}
+		for start < len(versions) &&
+			(versions[start].Key < marker.Key ||
+				(versions[start].Key == marker.Key && versions[start].VersionId <= marker.VersionId)) {
+			start++
+		}
+	}
+	end := len(versions)
+	truncated := false
+	if f.PageSize > 0 && start+f.PageSize < len(versions) {
+		end = start + f.PageSize
+		truncated = true
+	}
+
+	out := &s3.ListObjectVersionsOutput{IsTruncated: aws.Bool(truncated)}
+	for _, v := range versions[start:end] {
+		if v.DeleteMarker {
+			out.DeleteMarkers = append(out.DeleteMarkers, types.DeleteMarkerEntry{Key: aws.String(v.Key), VersionId: aws.String(v.VersionId)})
+		} else {
+			out.Versions = append(out.Versions, types.ObjectVersion{Key: aws.String(v.Key), VersionId: aws.String(v.VersionId)})
+		}
+	}
+	if truncated {
+		last := versions[end-1]
+		out.NextKeyMarker = aws.String(last.Key)
+		out.NextVersionIdMarker = aws.String(last.VersionId)
+	}
+	return out, nil
+}
+
+func (f *S3) DeleteObjects(_ context.Context, in *s3.DeleteObjectsInput, _ ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Bucket)
+	if err := f.record("DeleteObjects", name); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(name)
+	if err != nil {
+		return nil, err
+	}
+	if in.Delete != nil && len(in.Delete.Objects) > 1000 {
+		return nil, fmt.Errorf("MalformedXML: too many objects (%d)", len(in.Delete.Objects))
+	}
+
+	out := &s3.DeleteObjectsOutput{}
+	for _, obj := range in.Delete.Objects {
+		key, versionId := aws.ToString(obj.Key), aws.ToString(obj.VersionId)
+		// オブジェクト単位の失敗は "DeleteObject" 操作として注入する
+		if err, ok := f.faults[faultKey("DeleteObject", name+"/"+key)]; ok {
+			out.Errors = append(out.Errors, types.Error{Key: obj.Key, VersionId: obj.VersionId, Message: aws.String(err.Error())})
+			continue
+		}
+		b.Versions = slices.DeleteFunc(b.Versions, func(v ObjectVersion) bool {
+			return v.Key == key && v.VersionId == versionId
+		})
+		out.Deleted = append(out.Deleted, types.DeletedObject{Key: obj.Key, VersionId: obj.VersionId})
+	}
+	return out, nil
+}
+
+func (f *S3) DeleteBucket(_ context.Context, in *s3.DeleteBucketInput, _ ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Bucket)
+	if err := f.record("DeleteBucket", name); err != nil {
+		return nil, err
+	}
+	b, err := f.bucket(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.Versions) > 0 {
+		return nil, fmt.Errorf("BucketNotEmpty: the bucket %s you tried to delete is not empty", name)
+	}
+	delete(f.Buckets, name)
+	return &s3.DeleteBucketOutput{}, nil
+}
@@ -0,0 +1,61 @@
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// Ssm はSSM Parameter Store APIのインメモリフェイク
+type Ssm struct {
+	recorder
+	Parameters map[string]types.Parameter // パラメータ名 → パラメータ
+}
+
+// NewSsm は指定したパラメータ（名前 → 値）を String 型で持つフェイクを作成します
+func NewSsm(params map[string]string) *Ssm {
+	f := &Ssm{Parameters: map[string]types.Parameter{}}
+	for name, value := range params {
+		f.Parameters[name] = types.Parameter{Name: aws.String(name), Value: aws.String(value), Type: types.ParameterTypeString}
+	}
+	return f
+}
+
+// Parameter はパラメータを返します
+func (f *Ssm) Parameter(name string) (types.Parameter, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.Parameters[name]
+	return p, ok
+}
+
+func (f *Ssm) PutParameter(_ context.Context, in *ssm.PutParameterInput, _ ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Name)
+	if err := f.record("PutParameter", name); err != nil {
+		return nil, err
+	}
+	if _, ok := f.Parameters[name]; ok && !aws.ToBool(in.Overwrite) {
+		return nil, &types.ParameterAlreadyExists{Message: aws.String(fmt.Sprintf("parameter %s already exists", name))}
+	}
+	f.Parameters[name] = types.Parameter{Name: in.Name, Value: in.Value, Type: in.Type}
+	return &ssm.PutParameterOutput{}, nil
+}
+
+func (f *Ssm) DeleteParameter(_ context.Context, in *ssm.DeleteParameterInput, _ ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(in.Name)
+	if err := f.record("DeleteParameter", name); err != nil {
+		return nil, err
+	}
+	if _, ok := f.Parameters[name]; !ok {
+		return nil, &types.ParameterNotFound{Message: aws.String(fmt.Sprintf("parameter %s not found", name))}
+	}
+	delete(f.Parameters, name)
+	return &ssm.DeleteParameterOutput{}, nil
+}
@@ -0,0 +1,80 @@
+package fakeaws
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// TaggedResource はフェイクResource Groups Tagging APIが保持するリソース
+type TaggedResource struct {
+	ARN  string
+	Tags map[string]string
+}
+
+// Tagging はResource Groups Tagging APIのインメモリフェイク
+type Tagging struct {
+	recorder
+	Resources []*TaggedResource
+	PageSize  int // GetResources の1ページあたりの件数（0の場合は全件）
+}
+
+// NewTagging は指定したリソースを持つフェイクを作成します
+func NewTagging(resources ...*TaggedResource) *Tagging {
+	return &Tagging{Resources: resources}
+}
+
+func (f *Tagging) GetResources(_ context.Context, in *resourcegroupstaggingapi.GetResourcesInput, _ ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("GetResources", ""); err != nil {
+		return nil, err
+	}
+	var mappings []types.ResourceTagMapping
+	for _, r := range f.Resources {
+		if !matchesResourceType(r.ARN, in.ResourceTypeFilters) || !matchesTagFilters(r.Tags, in.TagFilters) {
+			continue
+		}
+		mapping := types.ResourceTagMapping{ResourceARN: aws.String(r.ARN)}
+		for k, v := range r.Tags {
+			mapping.Tags = append(mapping.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		mappings = append(mappings, mapping)
+	}
+	page, next := paginate(mappings, in.PaginationToken, f.PageSize)
+	return &resourcegroupstaggingapi.GetResourcesOutput{ResourceTagMappingList: page, PaginationToken: next}, nil
+}
+
+// matchesResourceType はARNが "service" または "service:type" 形式の種類のいずれかに一致するかを判定する
+func matchesResourceType(resourceARN string, filters []string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	parsed, err := arn.Parse(resourceARN)
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(filters, func(filter string) bool {
+		service, resourceType, ok := strings.Cut(filter, ":")
+		if service != parsed.Service {
+			return false
+		}
+		return !ok || strings.HasPrefix(parsed.Resource, resourceType+"/") || strings.HasPrefix(parsed.Resource, resourceType+":")
+	})
+}
+
+// matchesTagFilters はタグがすべての条件（キーが存在し、値の指定があればいずれかに一致）を満たすかを判定する
+func matchesTagFilters(tags map[string]string, filters []types.TagFilter) bool {
+	for _, filter := range filters {
+		value, ok := tags[aws.ToString(filter.Key)]
+		if !ok || (len(filter.Values) > 0 && !slices.Contains(filter.Values, value)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package picker
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// fuzzyScore は query の各文字が text に順番どおり含まれるか（部分列一致）を大文字小文字を区別せずに判定し、
+// 一致した場合はスコアを返します。連続して一致するほど、単語の先頭で一致するほどスコアが高くなります
+func fuzzyScore(text, query string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+	t := []rune(strings.ToLower(text))
+	q := []rune(strings.ToLower(query))
+
+	score := 0
+	prev := -2 // 直前に一致した位置
+	qi := 0
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score++
+		if ti == prev+1 {
+			score += 3 // 連続一致
+		}
+		if ti == 0 || isBoundary(t[ti-1]) {
+			score += 2 // 単語の先頭
+		}
+		prev = ti
+		qi++
+	}
+	if qi < len(q) {
+		return 0, false
+	}
+	// 完全な部分文字列として含まれる場合を優先する
+	if strings.Contains(string(t), string(q)) {
+		score += len(q)
+	}
+	return score, true
+}
+
+// isBoundary は単語の区切り文字かどうかを判定する
+func isBoundary(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("-_/.:()", r)
+}
+
+// filterItems は query に一致する項目のインデックスをスコアの高い順（同点は元の順）に返します
+func filterItems(items []Item, query string) []int {
+	type match struct {
+		index int
+		score int
+	}
+	var matches []match
+	for i, it := range items {
+		if score, ok := fuzzyScore(it.Label+" "+it.Detail, query); ok {
+			matches = append(matches, match{index: i, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool { return matches[a].score > matches[b].score })
+
+	indexes := make([]int, len(matches))
+	for i, m := range matches {
+		indexes[i] = m.index
+	}
+	return indexes
+}
@@ -0,0 +1,240 @@
+package picker
+
+import (
+	"awstk/internal/i18n"
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// keyKind はキー入力の種類
+type keyKind int
+
+const (
+	keyUnknown keyKind = iota
+	keyRune
+	keyEnter
+	keyBackspace
+	keyClear
+	keyUp
+	keyDown
+	keyPageUp
+	keyPageDown
+	keyToggle
+	keyToggleAll
+	keyAbort
+)
+
+// key はキー入力
+type key struct {
+	kind keyKind
+	r    rune // keyRune の場合の文字
+}
+
+// readKey は raw モードの端末から1キー分の入力を読み取る
+func readKey(r *bufio.Reader) (key, error) {
+	c, _, err := r.ReadRune()
+	if err != nil {
+		return key{}, err
+	}
+	switch c {
+	case '\r', '\n':
+		return key{kind: keyEnter}, nil
+	case 3, 4: // Ctrl-C, Ctrl-D
+		return key{kind: keyAbort}, nil
+	case 127, 8: // Backspace, Ctrl-H
+		return key{kind: keyBackspace}, nil
+	case 21: // Ctrl-U
+		return key{kind: keyClear}, nil
+	case 16: // Ctrl-P
+		return key{kind: keyUp}, nil
+	case 14: // Ctrl-N
+		return key{kind: keyDown}, nil
+	case '\t':
+		return key{kind: keyToggle}, nil
+	case 1: // Ctrl-A
+		return key{kind: keyToggleAll}, nil
+	case 27:
+		return readEscape(r)
+	}
+	if unicode.IsPrint(c) {
+		return key{kind: keyRune, r: c}, nil
+	}
+	return key{kind: keyUnknown}, nil
+}
+
+// readEscape はエスケープシーケンス（矢印キーなど）を読み取る
+// Esc 単独の入力（後続のバイトがない場合）は中止として扱う
+func readEscape(r *bufio.Reader) (key, error) {
+	if r.Buffered() == 0 {
+		return key{kind: keyAbort}, nil
+	}
+	prefix, err := r.ReadByte()
+	if err != nil {
+		return key{}, err
+	}
+	if prefix != '[' && prefix != 'O' {
+		return key{kind: keyUnknown}, nil
+	}
+	// パラメータ部分を読み飛ばし、終端文字までを取得する (e.g., "5~", "A")
+	var seq []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return key{}, err
+		}
+		seq = append(seq, b)
+		if b >= 0x40 && b <= 0x7e {
+			break
+		}
+	}
+	switch string(seq) {
+	case "A":
+		return key{kind: keyUp}, nil
+	case "B":
+		return key{kind: keyDown}, nil
+	case "D", "5~":
+		return key{kind: keyPageUp}, nil
+	case "C", "6~":
+		return key{kind: keyPageDown}, nil
+	}
+	return key{kind: keyUnknown}, nil
+}
+
+// runInteractive は raw モードの端末で、入力のたびに一覧を描き直しながら選択させる
+func runInteractive(m *model, prompt string, r *bufio.Reader, w io.Writer, width int) ([]string, error) {
+	drawn := 0
+	for {
+		drawn = render(w, m, prompt, width, drawn)
+		k, err := readKey(r)
+		if err != nil {
+			clearLines(w, drawn)
+			return nil, err
+		}
+		switch k.kind {
+		case keyEnter:
+			if values := m.result(); len(values) > 0 {
+				clearLines(w, drawn)
+				return values, nil
+			}
+		case keyAbort:
+			clearLines(w, drawn)
+			return nil, ErrAborted
+		case keyRune:
+			m.setQuery(append(m.query, k.r))
+		case keyBackspace:
+			if len(m.query) > 0 {
+				m.setQuery(m.query[:len(m.query)-1])
+			}
+		case keyClear:
+			m.setQuery(nil)
+		case keyUp:
+			m.move(-1)
+		case keyDown:
+			m.move(1)
+		case keyPageUp:
+			m.movePage(-1)
+		case keyPageDown:
+			m.movePage(1)
+		case keyToggle:
+			if m.multi {
+				m.toggle()
+				m.move(1)
+			}
+		case keyToggleAll:
+			if m.multi {
+				m.toggleAll()
+			}
+		}
+	}
+}
+
+// render は前回描いた行を消してから現在の状態を描き、描いた行数を返す
+func render(w io.Writer, m *model, prompt string, width, drawn int) int {
+	lines := view(m, prompt)
+	var b strings.Builder
+	if drawn > 0 {
+		clearSequence(&b, drawn)
+	}
+	for i, line := range lines {
+		if width > 0 {
+			line = runewidth.Truncate(line, width-1, "…")
+		}
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+	}
+	_, _ = io.WriteString(w, b.String())
+	return len(lines)
+}
+
+// view は現在の状態を表示する行の一覧に変換する
+func view(m *model, prompt string) []string {
+	var lines []string
+	if prompt != "" {
+		lines = append(lines, prompt)
+	}
+	lines = append(lines, "> "+string(m.query))
+
+	start, end := m.visible()
+	if len(m.matches) == 0 {
+		lines = append(lines, "  "+i18n.T("picker.no_match"))
+	}
+	for pos := start; pos < end; pos++ {
+		i := m.matches[pos]
+		cursor := "  "
+		if pos == m.cursor {
+			cursor = "❯ "
+		}
+		check := ""
+		if m.multi {
+			check = "[ ] "
+			if m.selected[i] {
+				check = "[x] "
+			}
+		}
+		lines = append(lines, cursor+check+itemText(m.items[i]))
+	}
+
+	status := fmt.Sprintf("  %d/%d", len(m.matches), len(m.items))
+	if pages := (len(m.matches) + m.pageSize - 1) / m.pageSize; pages > 1 {
+		status += " " + i18n.Tf("picker.page", m.cursor/m.pageSize+1, pages)
+	}
+	help := i18n.T("picker.help_single")
+	if m.multi {
+		status += "  " + i18n.Tf("picker.selected", len(m.selected))
+		help = i18n.T("picker.help_multi")
+	}
+	return append(lines, status+"  "+help)
+}
+
+// itemText は項目の表示文字列を返す
+func itemText(it Item) string {
+	if it.Detail == "" {
+		return it.Label
+	}
+	return it.Label + "  " + it.Detail
+}
+
+// clearLines は描いた行を消す
+func clearLines(w io.Writer, drawn int) {
+	if drawn == 0 {
+		return
+	}
+	var b strings.Builder
+	clearSequence(&b, drawn)
+	_, _ = io.WriteString(w, b.String())
+}
+
+// clearSequence はカーソルを描画の先頭行に戻し、そこから下を消すエスケープシーケンスを書き込む
+func clearSequence(b *strings.Builder, drawn int) {
+	if drawn > 1 {
+		fmt.Fprintf(b, "\x1b[%dA", drawn-1)
+	}
+	b.WriteString("\r\x1b[J")
+}
@@ -0,0 +1,118 @@
+package picker
+
+// model は選択UIの状態（検索文字列、絞り込み結果、カーソル位置、選択状態）
+// 表示方法（対話的・番号入力）によらず共通で使う
+type model struct {
+	items    []Item
+	multi    bool
+	pageSize int
+
+	query    []rune
+	matches  []int        // 絞り込み後の項目（items のインデックス）
+	cursor   int          // matches 内のカーソル位置
+	offset   int          // 表示中のページの先頭（matches 内の位置）
+	selected map[int]bool // 複数選択で選択済みの項目（items のインデックス）
+}
+
+func newModel(items []Item, multi bool, pageSize int) *model {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	m := &model{items: items, multi: multi, pageSize: pageSize, selected: map[int]bool{}}
+	m.refilter()
+	return m
+}
+
+// setQuery は検索文字列を変更して絞り込み直す
+func (m *model) setQuery(query []rune) {
+	m.query = query
+	m.refilter()
+}
+
+func (m *model) refilter() {
+	m.matches = filterItems(m.items, string(m.query))
+	m.cursor = 0
+	m.offset = 0
+}
+
+// move はカーソルを delta 行移動する（端を越えた場合は反対側に回り込む）
+func (m *model) move(delta int) {
+	n := len(m.matches)
+	if n == 0 {
+		return
+	}
+	m.cursor = ((m.cursor+delta)%n + n) % n
+	m.scrollToCursor()
+}
+
+// movePage はカーソルを1ページ分移動する（端で止まる）
+func (m *model) movePage(pages int) {
+	n := len(m.matches)
+	if n == 0 {
+		return
+	}
+	m.cursor = min(max(m.cursor+pages*m.pageSize, 0), n-1)
+	m.offset = min(max(m.offset+pages*m.pageSize, 0), max(n-m.pageSize, 0))
+	m.scrollToCursor()
+}
+
+// scrollToCursor はカーソルが表示範囲に入るように表示位置を調整する
+func (m *model) scrollToCursor() {
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.pageSize {
+		m.offset = m.cursor - m.pageSize + 1
+	}
+}
+
+// visible は表示中のページの項目（matches 内の位置の範囲）を返す
+func (m *model) visible() (start, end int) {
+	return m.offset, min(m.offset+m.pageSize, len(m.matches))
+}
+
+// toggle はカーソル位置の項目の選択状態を切り替える
+func (m *model) toggle() {
+	if len(m.matches) == 0 {
+		return
+	}
+	i := m.matches[m.cursor]
+	if m.selected[i] {
+		delete(m.selected, i)
+	} else {
+		m.selected[i] = true
+	}
+}
+
+// toggleAll は絞り込み結果をすべて選択する（すべて選択済みの場合は解除する）
+func (m *model) toggleAll() {
+	all := true
+	for _, i := range m.matches {
+		all = all && m.selected[i]
+	}
+	for _, i := range m.matches {
+		if all {
+			delete(m.selected, i)
+		} else {
+			m.selected[i] = true
+		}
+	}
+}
+
+// result は確定時に返す値を返す
+// 複数選択で何も選択されていない場合は、カーソル位置の項目を選択したものとみなす
+func (m *model) result() []string {
+	if m.multi && len(m.selected) > 0 {
+		var values []string
+		for i, it := range m.items {
+			if m.selected[i] {
+				values = append(values, it.value())
+			}
+		}
+		return values
+	}
+	if len(m.matches) == 0 {
+		return nil
+	}
+	return []string{m.items[m.matches[m.cursor]].value()}
+}
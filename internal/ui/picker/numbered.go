@@ -0,0 +1,124 @@
+package picker
+
+import (
+	"awstk/internal/i18n"
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// runNumbered は番号入力で選択させる（raw モードが使えない端末向けのフォールバック）
+// 数字以外を入力すると検索文字列として絞り込み、"<" / ">" でページを移動する
+func runNumbered(m *model, prompt string, r *bufio.Reader, w io.Writer) ([]string, error) {
+	for {
+		if prompt != "" {
+			fmt.Fprintln(w, prompt)
+		}
+		start, end := m.visible()
+		for pos := start; pos < end; pos++ {
+			fmt.Fprintf(w, "  %d. %s\n", pos+1, itemText(m.items[m.matches[pos]]))
+		}
+		if pages := (len(m.matches) + m.pageSize - 1) / m.pageSize; pages > 1 {
+			fmt.Fprintf(w, "  %s\n", i18n.Tf("picker.page", start/m.pageSize+1, pages))
+		}
+		if m.multi {
+			fmt.Fprint(w, i18n.Tf("picker.number_multi", len(m.matches)))
+		} else {
+			fmt.Fprint(w, i18n.Tf("picker.number_single", len(m.matches)))
+		}
+
+		line, err := r.ReadString('\n')
+		if err != nil && line == "" {
+			if err == io.EOF {
+				return nil, ErrAborted
+			}
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "":
+			continue
+		case line == "<":
+			m.movePage(-1)
+			continue
+		case line == ">":
+			m.movePage(1)
+			continue
+		case isSelectionSyntax(line, m.multi):
+			positions, err := parseSelection(line, len(m.matches), m.multi)
+			if err != nil {
+				fmt.Fprintf(w, "%s\n\n", err)
+				continue
+			}
+			values := make([]string, len(positions))
+			for i, pos := range positions {
+				values[i] = m.items[m.matches[pos]].value()
+			}
+			return values, nil
+		}
+
+		// 数字以外は検索文字列として絞り込む（一致しない場合は元の一覧に戻す）
+		m.setQuery([]rune(line))
+		if len(m.matches) == 0 {
+			fmt.Fprintf(w, "%s\n\n", i18n.T("picker.no_match"))
+			m.setQuery(nil)
+		}
+	}
+}
+
+// isSelectionSyntax は入力が番号指定の形式（数字・カンマ・範囲、複数選択では all も可）かどうかを判定する
+func isSelectionSyntax(s string, multi bool) bool {
+	if multi && (strings.EqualFold(s, "all") || s == "*") {
+		return true
+	}
+	for _, r := range s {
+		if !(r >= '0' && r <= '9') && (!multi || !strings.ContainsRune(", -", r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSelection は "3" や "1,3,5-7"、"all" のような番号指定を 0 始まりの位置に変換する
+func parseSelection(s string, n int, multi bool) ([]int, error) {
+	if multi && (strings.EqualFold(s, "all") || s == "*") {
+		positions := make([]int, n)
+		for i := range positions {
+			positions[i] = i
+		}
+		return positions, nil
+	}
+
+	invalid := fmt.Errorf(i18n.T("picker.invalid_number"), s, n)
+	seen := map[int]bool{}
+	var positions []int
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, invalid
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.Atoi(to); err != nil {
+				return nil, invalid
+			}
+		}
+		if first < 1 || last > n || first > last {
+			return nil, invalid
+		}
+		for num := first; num <= last; num++ {
+			if !seen[num] {
+				seen[num] = true
+				positions = append(positions, num-1)
+			}
+		}
+	}
+	if len(positions) == 0 || (!multi && len(positions) > 1) {
+		return nil, invalid
+	}
+	return positions, nil
+}
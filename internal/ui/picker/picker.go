@@ -0,0 +1,155 @@
+// Package picker は一覧から項目を対話的に選択するためのコンポーネントです
+//
+// 端末では文字入力によるあいまい検索、矢印キーでの移動、ページ送り、複数選択に対応します。
+// 端末が raw モードに対応していない場合（TERM=dumb など）は番号入力による選択にフォールバックし、
+// 標準入力が端末でない場合は NotTerminalError を返します。
+package picker
+
+import (
+	"awstk/internal/i18n"
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// DefaultPageSize は1画面に表示する項目数の既定値
+const DefaultPageSize = 15
+
+// Item は選択肢
+type Item struct {
+	Label  string // 表示名（検索対象）
+	Detail string // 補足情報（検索対象、表示名の後ろに表示）
+	Value  string // 選択時に返す値（空の場合は Label）
+}
+
+// value は選択時に返す値を返す
+func (it Item) value() string {
+	if it.Value != "" {
+		return it.Value
+	}
+	return it.Label
+}
+
+// Options は選択時のオプション
+type Options struct {
+	Prompt   string // 一覧の上に表示する見出し (e.g., "EC2インスタンスを選択してください")
+	PageSize int    // 1画面に表示する項目数 (0は DefaultPageSize)
+	Hint     string // 端末でない場合のエラーに表示する、直接指定するためのフラグ (e.g., "-i (--instance-id)")
+}
+
+// abortedError は選択・確認が中止されたことを表すエラー
+type abortedError struct{}
+
+func (abortedError) Error() string { return i18n.T("error.user_aborted") }
+
+// ErrAborted は Esc / Ctrl-C などで選択が中止された場合に返すエラー
+var ErrAborted error = abortedError{}
+
+// noItemsError は選択肢が1件もないことを表すエラー
+type noItemsError struct{}
+
+func (noItemsError) Error() string { return i18n.T("picker.no_items") }
+
+// ErrNoItems は選択肢が1件もない場合に返すエラー
+var ErrNoItems error = noItemsError{}
+
+// NotTerminalError は標準入力が端末でないため対話的に選択できないことを表すエラー
+type NotTerminalError struct {
+	Hint string
+}
+
+func (e *NotTerminalError) Error() string {
+	if e.Hint == "" {
+		return i18n.T("picker.not_terminal")
+	}
+	return i18n.Tf("picker.not_terminal_hint", e.Hint)
+}
+
+var (
+	// input / output はテストで差し替えられるよう変数にしている
+	// 選択UIは標準出力（JSONなどの出力）を汚さないよう標準エラー出力に表示する
+	input  io.Reader = os.Stdin
+	output io.Writer = os.Stderr
+
+	readerOnce sync.Once
+	reader     *bufio.Reader
+
+	// isTerminal は標準入力が端末かどうかを返す
+	isTerminal = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+	// makeRaw は端末を raw モードにし、元に戻す関数を返す
+	makeRaw = func() (func(), error) {
+		fd := int(os.Stdin.Fd())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return nil, err
+		}
+		return func() { _ = term.Restore(fd, state) }, nil
+	}
+	// termWidth は端末の表示幅を返す（取得できない場合は0）
+	termWidth = func() int {
+		w, _, err := term.GetSize(int(os.Stderr.Fd()))
+		if err != nil {
+			return 0
+		}
+		return w
+	}
+)
+
+// stdinReader は標準入力を読む共有のリーダーを返す
+// プロンプトごとにリーダーを作るとパイプで渡した入力の先読み分が失われるため、1つを使い回す
+func stdinReader() *bufio.Reader {
+	readerOnce.Do(func() { reader = bufio.NewReader(input) })
+	return reader
+}
+
+// Select は一覧から1件を対話的に選択させ、選択された項目の値を返します
+func Select(items []Item, opts *Options) (string, error) {
+	selected, err := pick(items, opts, false)
+	if err != nil {
+		return "", err
+	}
+	return selected[0], nil
+}
+
+// SelectMany は一覧から1件以上を対話的に選択させ、選択された項目の値を一覧の順に返します
+func SelectMany(items []Item, opts *Options) ([]string, error) {
+	return pick(items, opts, true)
+}
+
+// pick は端末の種類に応じた方法で選択させる
+func pick(items []Item, opts *Options, multi bool) ([]string, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if len(items) == 0 {
+		return nil, ErrNoItems
+	}
+	if !isTerminal() {
+		return nil, &NotTerminalError{Hint: opts.Hint}
+	}
+
+	m := newModel(items, multi, opts.PageSize)
+	if os.Getenv("TERM") != "dumb" {
+		if restore, err := makeRaw(); err == nil {
+			defer restore()
+			return runInteractive(m, opts.Prompt, stdinReader(), output, termWidth())
+		}
+	}
+	return runNumbered(m, opts.Prompt, stdinReader(), output)
+}
+
+// Confirm は "[y/N]" 形式の確認プロンプトを表示し、y / yes が入力された場合のみ true を返します
+// パイプでの回答（echo y | awstk ...）も受け付けるため、端末でなくても入力を読み取ります
+func Confirm(message string) bool {
+	_, _ = io.WriteString(output, message+" [y/N]: ")
+	response, err := stdinReader().ReadString('\n')
+	if err != nil && response == "" {
+		return false
+	}
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
@@ -0,0 +1,204 @@
+package picker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var testItems = []Item{
+	{Label: "dev-api", Detail: "i-0001 (running)", Value: "i-0001"},
+	{Label: "dev-web", Detail: "i-0002 (stopped)", Value: "i-0002"},
+	{Label: "prod-api", Detail: "i-0003 (running)", Value: "i-0003"},
+	{Label: "prod-batch", Detail: "i-0004 (running)", Value: "i-0004"},
+}
+
+func TestFilterItems(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []int
+	}{
+		{name: "空の検索文字列は全件", query: "", want: []int{0, 1, 2, 3}},
+		{name: "部分文字列", query: "api", want: []int{0, 2}},
+		{name: "大文字小文字を区別しない", query: "PROD", want: []int{2, 3}},
+		{name: "部分列一致", query: "pdb", want: []int{3}},
+		{name: "補足情報も検索対象", query: "stopped", want: []int{1}},
+		{name: "連続一致を優先", query: "batch", want: []int{3}},
+		{name: "一致なし", query: "staging", want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filterItems(testItems, tt.query); !slices.Equal(got, tt.want) {
+				t.Errorf("filterItems(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuzzyScoreOrder(t *testing.T) {
+	// 単語の先頭・連続した一致ほど上位に並ぶ
+	items := []Item{{Label: "a-p-i-x"}, {Label: "xapi"}, {Label: "api-gateway"}}
+	if got := filterItems(items, "api"); !slices.Equal(got, []int{2, 1, 0}) {
+		t.Errorf("filterItems() = %v, want [2 1 0]", got)
+	}
+}
+
+func TestRunInteractive(t *testing.T) {
+	const (
+		up    = "\x1b[A"
+		down  = "\x1b[B"
+		pgDn  = "\x1b[6~"
+		enter = "\r"
+	)
+
+	tests := []struct {
+		name     string
+		multi    bool
+		pageSize int
+		keys     string
+		want     []string
+		wantErr  error
+	}{
+		{name: "Enterで先頭を選択", keys: enter, want: []string{"i-0001"}},
+		{name: "矢印キーで移動", keys: down + down + up + enter, want: []string{"i-0002"}},
+		{name: "上端から上に移動すると末尾に回り込む", keys: up + enter, want: []string{"i-0004"}},
+		{name: "文字入力で絞り込み", keys: "prod" + down + enter, want: []string{"i-0004"}},
+		{name: "Backspaceで検索文字列を削除", keys: "prodx\x7f" + enter, want: []string{"i-0003"}},
+		{name: "一致なしでEnterは無視", keys: "zzz" + enter + "\x15" + enter, want: []string{"i-0001"}},
+		{name: "ページ送り", pageSize: 2, keys: pgDn + enter, want: []string{"i-0003"}},
+		{name: "Escで中止", keys: "\x1b", wantErr: ErrAborted},
+		{name: "Ctrl-Cで中止", keys: "dev\x03", wantErr: ErrAborted},
+		{name: "複数選択", multi: true, keys: "\t" + down + "\t" + enter, want: []string{"i-0001", "i-0003"}},
+		{name: "複数選択で未選択ならカーソル位置", multi: true, keys: down + enter, want: []string{"i-0002"}},
+		{name: "絞り込み結果を全選択", multi: true, keys: "api\x01" + enter, want: []string{"i-0001", "i-0003"}},
+		{name: "入力の終端", keys: "dev", wantErr: io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel(testItems, tt.multi, tt.pageSize)
+			var out strings.Builder
+			got, err := runInteractive(m, "選択してください", bufio.NewReader(strings.NewReader(tt.keys)), &out, 80)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("runInteractive() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("runInteractive() = %v, want %v", got, tt.want)
+			}
+			// 終了時には描いた一覧を消している
+			if !strings.HasSuffix(out.String(), "\r\x1b[J") {
+				t.Errorf("終了時に一覧が消去されていません: %q", out.String())
+			}
+		})
+	}
+}
+
+func TestRunNumbered(t *testing.T) {
+	tests := []struct {
+		name     string
+		multi    bool
+		pageSize int
+		input    string
+		want     []string
+		wantErr  error
+	}{
+		{name: "番号で選択", input: "2\n", want: []string{"i-0002"}},
+		{name: "範囲外の番号は再入力", input: "9\n3\n", want: []string{"i-0003"}},
+		{name: "文字入力で絞り込んでから選択", input: "prod\n2\n", want: []string{"i-0004"}},
+		{name: "一致しない絞り込みは元に戻す", input: "zzz\n1\n", want: []string{"i-0001"}},
+		{name: "ページを移動しても番号は通し番号", pageSize: 2, input: ">\n4\n", want: []string{"i-0004"}},
+		{name: "単一選択で複数指定は再入力", input: "1-2\n1\n", want: []string{"i-0001"}},
+		{name: "複数選択", multi: true, input: "3, 1\n", want: []string{"i-0003", "i-0001"}},
+		{name: "範囲指定", multi: true, input: "2-4\n", want: []string{"i-0002", "i-0003", "i-0004"}},
+		{name: "絞り込み結果をすべて選択", multi: true, input: "dev\nall\n", want: []string{"i-0001", "i-0002"}},
+		{name: "入力の終端は中止", input: "", wantErr: ErrAborted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newModel(testItems, tt.multi, tt.pageSize)
+			got, err := runNumbered(m, "", bufio.NewReader(strings.NewReader(tt.input)), io.Discard)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("runNumbered() error = %v, want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("runNumbered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// setTerminal はテスト中の端末判定と入出力を差し替える
+func setTerminal(t *testing.T, terminal bool, in string) *strings.Builder {
+	t.Helper()
+	stdinReader() // 共有リーダーを初期化済みにしてから差し替える
+	origTerminal, origRaw, origInput, origOutput, origReader := isTerminal, makeRaw, input, output, reader
+	var out strings.Builder
+	isTerminal = func() bool { return terminal }
+	makeRaw = func() (func(), error) { return nil, fmt.Errorf("raw モード非対応") }
+	input, output = strings.NewReader(in), &out
+	reader = bufio.NewReader(input)
+	t.Cleanup(func() {
+		isTerminal, makeRaw, input, output, reader = origTerminal, origRaw, origInput, origOutput, origReader
+	})
+	return &out
+}
+
+func TestSelect(t *testing.T) {
+	t.Run("端末でなければエラー", func(t *testing.T) {
+		setTerminal(t, false, "1\n")
+		_, err := Select(testItems, &Options{Hint: "-i (--instance-id)"})
+		var notTerminal *NotTerminalError
+		if !errors.As(err, &notTerminal) || !strings.Contains(err.Error(), "-i (--instance-id)") {
+			t.Errorf("Select() error = %v, want NotTerminalError with hint", err)
+		}
+	})
+
+	t.Run("raw モードが使えなければ番号入力", func(t *testing.T) {
+		setTerminal(t, true, "prod-batch\n1\n")
+		got, err := Select(testItems, nil)
+		if err != nil || got != "i-0004" {
+			t.Errorf("Select() = (%q, %v), want i-0004", got, err)
+		}
+	})
+
+	t.Run("選択肢がなければエラー", func(t *testing.T) {
+		setTerminal(t, true, "")
+		if _, err := SelectMany(nil, nil); !errors.Is(err, ErrNoItems) {
+			t.Errorf("SelectMany() error = %v, want ErrNoItems", err)
+		}
+	})
+}
+
+func TestConfirm(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: "y\n", want: true},
+		{input: "YES\n", want: true},
+		{input: "n\n", want: false},
+		{input: "\n", want: false},
+		{input: "y", want: true},
+		{input: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%q", tt.input), func(t *testing.T) {
+			// 確認はパイプでの回答も受け付けるため、端末でなくても入力を読む
+			out := setTerminal(t, false, tt.input)
+			if got := Confirm("続行しますか？"); got != tt.want {
+				t.Errorf("Confirm() = %v, want %v", got, tt.want)
+			}
+			if !strings.Contains(out.String(), "続行しますか？ [y/N]: ") {
+				t.Errorf("プロンプトが表示されていません: %q", out.String())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"awstk/cmd"
+	"awstk/internal/i18n"
 	"bytes"
 	"fmt"
 	"log"
@@ -14,17 +15,36 @@ import (
 	"github.com/spf13/cobra/doc"
 )
 
-func main() {
-	docsDir := "./docs"
+// docsLanguages は言語ごとのドキュメント出力先（既定言語の日本語は docs 直下）
+var docsLanguages = []struct {
+	lang i18n.Lang
+	dir  string
+}{
+	{lang: i18n.Ja, dir: "./docs"},
+	{lang: i18n.En, dir: "./docs/en"},
+}
 
+func main() {
 	// 既存のdocsディレクトリをクリーン
-	if err := os.RemoveAll(docsDir); err != nil {
+	if err := os.RemoveAll("./docs"); err != nil {
 		log.Fatalf("Failed to clean docs directory: %v", err)
 	}
 
+	for _, l := range docsLanguages {
+		cmd.SetLang(l.lang)
+		fileCount, err := generateDocs(l.dir)
+		if err != nil {
+			log.Fatalf("Failed to generate %s documentation: %v", l.lang, err)
+		}
+		fmt.Printf("✅ Documentation generated in %s (%s, %d files)\n", l.dir, l.lang, fileCount)
+	}
+}
+
+// generateDocs は現在の言語のドキュメントを docsDir に生成し、生成したファイル数を返す
+func generateDocs(docsDir string) (int, error) {
 	// ディレクトリ作成
 	if err := os.MkdirAll(docsDir, 0755); err != nil {
-		log.Fatalf("Failed to create docs directory: %v", err)
+		return 0, fmt.Errorf("failed to create docs directory: %w", err)
 	}
 
 	// サービスごとにドキュメントを生成
@@ -32,7 +52,7 @@ func main() {
 
 	// ルートコマンドはdocs/README.mdとして生成
 	if err := genSingleMarkdown(cmd.RootCmd, filepath.Join(docsDir, "README.md")); err != nil {
-		log.Fatalf("Failed to generate root documentation: %v", err)
+		return 0, fmt.Errorf("failed to generate root documentation: %w", err)
 	}
 
 	// サブコマンドをサービスごとにグループ化
@@ -44,12 +64,8 @@ func main() {
 		serviceName := subCmd.Name()
 		serviceCommands[serviceName] = append(serviceCommands[serviceName], subCmd)
 
-		// サブコマンドの子コマンドも収集
-		for _, childCmd := range subCmd.Commands() {
-			if childCmd.IsAvailableCommand() && !childCmd.IsAdditionalHelpTopicCommand() {
-				serviceCommands[serviceName] = append(serviceCommands[serviceName], childCmd)
-			}
-		}
+		// サブコマンドの子孫コマンドも収集（cfn changeset show などの3階層以上のコマンドを含む）
+		serviceCommands[serviceName] = appendChildCommands(serviceCommands[serviceName], subCmd)
 	}
 
 	// サービスごとに単一ファイルを生成
@@ -61,8 +77,18 @@ func main() {
 		}
 	}
 
-	fileCount := len(serviceCommands) + 1 // サービスファイル数 + README.md
-	fmt.Printf("✅ Documentation generated in %s (%d files)\n", docsDir, fileCount)
+	return len(serviceCommands) + 1, nil // サービスファイル数 + README.md
+}
+
+// appendChildCommands は cmd の子孫コマンドを深さ優先で commands に追加する
+func appendChildCommands(commands []*cobra.Command, cmd *cobra.Command) []*cobra.Command {
+	for _, childCmd := range cmd.Commands() {
+		if childCmd.IsAvailableCommand() && !childCmd.IsAdditionalHelpTopicCommand() {
+			commands = append(commands, childCmd)
+			commands = appendChildCommands(commands, childCmd)
+		}
+	}
+	return commands
 }
 
 // shouldRemoveInheritedFlags は継承フラグを削除すべきかチェック